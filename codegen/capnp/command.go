@@ -13,8 +13,14 @@ func GenerateCapnp(apiDef *raml.APIDefinition, dir, lang, pkg string) error {
 
 	structs := []Struct{}
 
+	// the types declared using JSON schema
+	types, err := raml.ExpandJSONSchemaTypes(apiDef.Types)
+	if err != nil {
+		return err
+	}
+
 	// generate types
-	for name, tipe := range apiDef.Types {
+	for name, tipe := range types {
 		s, err := NewStruct(tipe, name, lang, pkg)
 		if err != nil {
 			return err
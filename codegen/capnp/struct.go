@@ -26,7 +26,11 @@ func NewStruct(t raml.Type, name, lang, pkg string) (Struct, error) {
 	fields := make(map[string]field)
 
 	for k, v := range t.Properties {
-		fd := newField(name, raml.ToProperty(k, v), lang, pkg)
+		prop := raml.ToProperty(k, v)
+		if prop.IsPattern() { // pattern properties are not supported
+			continue
+		}
+		fd := newField(name, prop, lang, pkg)
 		fields[fd.Name] = fd
 	}
 
@@ -3,6 +3,8 @@ package capnp
 import (
 	"fmt"
 	"strings"
+
+	"github.com/Jumpscale/go-raml/raml"
 )
 
 var (
@@ -26,14 +28,24 @@ func toCapnpType(t, capnpType string) string {
 		return capnpType
 	}
 
-	if v, ok := typeMap[t]; ok {
-		return v
-	}
-	// other types that need some processing
-	switch {
-	case strings.HasSuffix(t, "[]"): // array
-		return fmt.Sprintf("List(%v)", toCapnpType(t[:len(t)-2], ""))
+	te, err := raml.ParseTypeExpr(t)
+	if err != nil {
+		return t
 	}
+	return typeExprToCapnp(te)
+}
 
-	return t
+// convert from raml type expression to capnp type
+func typeExprToCapnp(te *raml.TypeExpr) string {
+	switch te.Kind {
+	case raml.TypeExprArray:
+		return fmt.Sprintf("List(%v)", typeExprToCapnp(te.Items))
+	case raml.TypeExprName:
+	default:
+		return te.String()
+	}
+	if v, ok := typeMap[te.Name]; ok {
+		return v
+	}
+	return te.Name
 }
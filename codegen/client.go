@@ -10,6 +10,10 @@ import (
 
 // GenerateClient generates client library
 func GenerateClient(apiDef *raml.APIDefinition, dir, packageName, lang, rootImportPath string) error {
+	if err := checkExamples(apiDef); err != nil {
+		return err
+	}
+
 	//check create dir
 	if err := commons.CheckCreateDir(dir); err != nil {
 		return err
@@ -46,6 +46,58 @@ func TestGenerateClientFromRaml(t *testing.T) {
 	})
 }
 
+func TestGenerateClientWithFormBodies(t *testing.T) {
+	Convey("generate client with form bodies", t, func() {
+		apiDef := new(raml.APIDefinition)
+		err := raml.ParseFile("./fixtures/media_types/api.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		err = GenerateClient(apiDef, targetDir, "theclient", "go", "client")
+		So(err, ShouldBeNil)
+
+		s, err := testLoadFile(filepath.Join(targetDir, "avatars_service.go"))
+		So(err, ShouldBeNil)
+
+		tmpl, err := testLoadFile("./fixtures/media_types/avatars_service.txt")
+		So(err, ShouldBeNil)
+
+		So(s, ShouldEqual, tmpl)
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
+
+func TestGenerateClientWithTypedResponses(t *testing.T) {
+	Convey("generate client with typed responses", t, func() {
+		apiDef := new(raml.APIDefinition)
+		err := raml.ParseFile("./fixtures/responses/api.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		err = GenerateClient(apiDef, targetDir, "theclient", "go", "client")
+		So(err, ShouldBeNil)
+
+		s, err := testLoadFile(filepath.Join(targetDir, "users_service.go"))
+		So(err, ShouldBeNil)
+
+		tmpl, err := testLoadFile("./fixtures/responses/users_service.txt")
+		So(err, ShouldBeNil)
+
+		So(s, ShouldEqual, tmpl)
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
+
 func testLoadFile(filename string) (string, error) {
 	b, err := ioutil.ReadFile(filename)
 	return string(b), err
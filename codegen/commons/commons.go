@@ -1,7 +1,9 @@
 package commons
 
 import (
+	"bytes"
 	"errors"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"regexp"
@@ -26,6 +28,15 @@ const (
 	// RespBodySuffix is suffix name for response body object
 	RespBodySuffix = "RespBody"
 
+	// QueryStringSuffix is suffix name for query string object
+	QueryStringSuffix = "QueryString"
+
+	// RespSuffix is suffix name for the responses object of a method
+	RespSuffix = "Resp"
+
+	// HeadersSuffix is suffix name for request headers object
+	HeadersSuffix = "Headers"
+
 	LangGo     = "go"
 	LangPython = "python"
 )
@@ -72,6 +83,15 @@ func GenerateFile(data interface{}, tmplFile, tmplName, filename string, overrid
 		return nil
 	}
 
+	content, err := ExecuteTemplate(data, tmplFile, tmplName)
+	if err != nil {
+		return err
+	}
+	return writeFile(filename, content)
+}
+
+// ExecuteTemplate executes a template and returns the generated content
+func ExecuteTemplate(data interface{}, tmplFile, tmplName string) ([]byte, error) {
 	// pass Go function to template
 	funcMap := template.FuncMap{
 		"ToLower": strings.ToLower,
@@ -83,22 +103,25 @@ func GenerateFile(data interface{}, tmplFile, tmplName, filename string, overrid
 
 	byteData, err := templates.Asset(tmplFile)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	t, err := template.New(tmplName).Funcs(funcMap).Parse(string(byteData))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	f, err := os.Create(filename)
-	if err != nil {
-		return err
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, tmplName, data); err != nil {
+		return nil, err
 	}
-	defer f.Close()
+	return buf.Bytes(), nil
+}
 
+// writeFile writes a generated file, the Go files are formatted
+func writeFile(filename string, content []byte) error {
 	log.Infof("generating file %v", filename)
-	if err := t.ExecuteTemplate(f, tmplName, data); err != nil {
+	if err := ioutil.WriteFile(filename, content, 0644); err != nil {
 		return err
 	}
 
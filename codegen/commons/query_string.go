@@ -0,0 +1,36 @@
+package commons
+
+import (
+	"github.com/Jumpscale/go-raml/raml"
+)
+
+// QueryStringTypeName returns the name of the type of a query string
+// if the query string is only a reference to a declared type, e.g. `queryString: Paging`.
+// It returns empty string otherwise, the generators then create a type
+// from the properties of the query string.
+func QueryStringTypeName(qs *raml.Type) string {
+	if qs == nil || len(qs.Properties) > 0 {
+		return ""
+	}
+	te, err := qs.TypeExpr()
+	if err != nil || te.Kind != raml.TypeExprName || te.Name == "object" {
+		return ""
+	}
+	return te.Name
+}
+
+// WithoutParamFacets removes the facets of the properties of query parameters or headers
+// which are validated by the generated servers before the handler is called,
+// the validators of the generated types would reject the optional parameters which are absent.
+// The values of props are Property.
+func WithoutParamFacets(props map[string]interface{}) map[string]interface{} {
+	stripped := make(map[string]interface{}, len(props))
+	for name, v := range props {
+		if prop, ok := v.(raml.Property); ok {
+			prop.Pattern, prop.MinLength, prop.MaxLength, prop.Minimum, prop.Maximum = nil, nil, nil, nil, nil
+			v = prop
+		}
+		stripped[name] = v
+	}
+	return stripped
+}
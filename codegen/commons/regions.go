@@ -0,0 +1,204 @@
+package commons
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// Protected regions keep the code written in a generated file when the file is regenerated,
+// e.g. the body of the handlers. A region is delimited by the comments
+//
+//	# go-raml:begin GET /users
+//	# go-raml:end
+//
+// the name of a region, here `GET /users`, identifies it across the regenerations.
+const (
+	regionBegin   = "go-raml:begin "
+	regionEnd     = "go-raml:end"
+	removedRegion = "removed" // region of the code of the regions which are not generated anymore
+)
+
+// region is a protected region of a file
+type region struct {
+	name  string
+	lines []string // content of the region, without the delimiters
+}
+
+// RegionsReport is the summary of the regeneration of a file with protected regions
+type RegionsReport struct {
+	Kept    []string // regions whose content is kept
+	Added   []string // regions which are generated for the first time
+	Removed []string // regions which aren't generated anymore, their content is commented out
+}
+
+// GenerateFileWithRegions generates a file from a template which declares protected regions,
+// comment is the line comment of the language of the file, e.g. `#`.
+// If the file already exists, the content of its regions is kept, see MergeRegions,
+// and a summary of the changes is logged.
+// An existing file without regions is not regenerated, an error is returned.
+func GenerateFileWithRegions(data interface{}, tmplFile, tmplName, filename, comment string) error {
+	content, err := ExecuteTemplate(data, tmplFile, tmplName)
+	if err != nil {
+		return err
+	}
+
+	old, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return writeFile(filename, content)
+	}
+	if err != nil {
+		return err
+	}
+
+	oldRegions, err := parseRegions(string(old), comment)
+	if err != nil {
+		return fmt.Errorf("can't regenerate %v: %v", filename, err)
+	}
+	if len(oldRegions) == 0 {
+		return fmt.Errorf("can't regenerate %v: the file doesn't have protected regions, its code would be lost", filename)
+	}
+
+	merged, report, err := MergeRegions(string(content), string(old), comment)
+	if err != nil {
+		return fmt.Errorf("can't regenerate %v: %v", filename, err)
+	}
+	report.log(filename)
+	return writeFile(filename, []byte(merged))
+}
+
+// MergeRegions returns the generated content whose regions are replaced by the regions
+// of the same name of the old content. The regions of the old content which aren't generated
+// anymore are commented out in a `removed` region at the end of the content.
+func MergeRegions(generated, old, comment string) (string, RegionsReport, error) {
+	var report RegionsReport
+
+	oldRegions, err := parseRegions(old, comment)
+	if err != nil {
+		return "", report, err
+	}
+	byName := make(map[string]region, len(oldRegions))
+	for _, r := range oldRegions {
+		byName[r.name] = r
+	}
+
+	var out []string
+	generatedNames := map[string]bool{}
+	lines := strings.Split(generated, "\n")
+	for i := 0; i < len(lines); i++ {
+		out = append(out, lines[i])
+		name, ok := parseRegionBegin(lines[i], comment)
+		if !ok {
+			continue
+		}
+		generatedNames[name] = true
+
+		// skip the generated content of the region
+		j := i + 1
+		for ; j < len(lines) && !isRegionEnd(lines[j], comment); j++ {
+		}
+		if j == len(lines) {
+			return "", report, fmt.Errorf("generated region `%v` isn't closed", name)
+		}
+		if r, ok := byName[name]; ok {
+			out = append(out, r.lines...)
+			report.Kept = append(report.Kept, name)
+		} else {
+			out = append(out, lines[i+1:j]...)
+			report.Added = append(report.Added, name)
+		}
+		out = append(out, lines[j])
+		i = j
+	}
+
+	// the code of the removed regions is commented out
+	var removed []string
+	for _, r := range oldRegions {
+		switch {
+		case r.name == removedRegion:
+			removed = append(removed, r.lines...)
+		case !generatedNames[r.name]:
+			report.Removed = append(report.Removed, r.name)
+			removed = append(removed, comment+" "+r.name+":")
+			for _, line := range r.lines {
+				removed = append(removed, comment+" "+line)
+			}
+		}
+	}
+	if len(removed) > 0 && !generatedNames[removedRegion] {
+		for len(out) > 0 && out[len(out)-1] == "" {
+			out = out[:len(out)-1]
+		}
+		out = append(out, "", comment+" "+regionBegin+removedRegion)
+		out = append(out, removed...)
+		out = append(out, comment+" "+regionEnd, "")
+	}
+	return strings.Join(out, "\n"), report, nil
+}
+
+// parseRegions returns the protected regions of a content, in their order
+func parseRegions(content, comment string) ([]region, error) {
+	var regions []region
+	var current *region
+	for _, line := range strings.Split(content, "\n") {
+		if name, ok := parseRegionBegin(line, comment); ok {
+			if current != nil {
+				return nil, fmt.Errorf("region `%v` isn't closed before region `%v`", current.name, name)
+			}
+			current = &region{name: name}
+			continue
+		}
+		if isRegionEnd(line, comment) {
+			if current == nil {
+				return nil, fmt.Errorf("`%v` without `%v`", regionEnd, strings.TrimSpace(regionBegin))
+			}
+			regions = append(regions, *current)
+			current = nil
+			continue
+		}
+		if current != nil {
+			current.lines = append(current.lines, line)
+		}
+	}
+	if current != nil {
+		return nil, fmt.Errorf("region `%v` isn't closed", current.name)
+	}
+	return regions, nil
+}
+
+// parseRegionBegin returns the name of the region if the line begins a region
+func parseRegionBegin(line, comment string) (string, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, comment) {
+		return "", false
+	}
+	line = strings.TrimSpace(strings.TrimPrefix(line, comment))
+	if !strings.HasPrefix(line, regionBegin) {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(line, regionBegin)), true
+}
+
+// isRegionEnd returns true if the line ends a region
+func isRegionEnd(line, comment string) bool {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, comment) {
+		return false
+	}
+	line = strings.TrimSpace(strings.TrimPrefix(line, comment))
+	return line == regionEnd || strings.HasPrefix(line, regionEnd+" ")
+}
+
+// log logs the summary of the regeneration of a file
+func (r RegionsReport) log(filename string) {
+	for _, name := range r.Added {
+		log.Infof("%v: added %v", filename, name)
+	}
+	for _, name := range r.Removed {
+		log.Warnf("%v: %v is not in the specification anymore, its code is commented out at the end of the file", filename, name)
+	}
+	log.Infof("%v: kept the code of %v regions", filename, len(r.Kept))
+}
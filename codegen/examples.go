@@ -0,0 +1,22 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml"
+)
+
+// checkExamples checks that the examples of an API definition match their type
+// before generating code, it returns an error listing the invalid examples.
+func checkExamples(apiDef *raml.APIDefinition) error {
+	diags := raml.ValidateExamples(apiDef)
+	if !raml.HasErrors(diags) {
+		return nil
+	}
+	msgs := make([]string, 0, len(diags))
+	for _, d := range diags {
+		msgs = append(msgs, d.String())
+	}
+	return fmt.Errorf("invalid examples in RAML specification:\n%v", strings.Join(msgs, "\n"))
+}
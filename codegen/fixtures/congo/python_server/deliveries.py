@@ -1,19 +1,34 @@
 from flask import Blueprint, jsonify, request
+from werkzeug.datastructures import MultiDict
+import re
+import params_validator
 
 
+from DeliveriesGetQueryString import DeliveriesGetQueryString
 from User import User
+# go-raml:begin imports
+# go-raml:end
 
 deliveries_api = Blueprint('deliveries_api', __name__)
 
 
 @deliveries_api.route('/deliveries', methods=['GET'])
+@params_validator.validate([
+    {'in': 'query', 'name': 'sinceDate', 'type': 'datetime', 'required': True},
+    {'in': 'query', 'name': 'throughDate', 'type': 'datetime', 'required': True},
+])
 def deliveries_get():
     '''
     Get a list of deliveries
     It is handler for GET /deliveries
     '''
+    query_string = DeliveriesGetQueryString(MultiDict([(re.sub(r'\W', '_', k), v) for k, v in request.args.items(multi=True)]))
+    if not query_string.validate():
+        return jsonify(errors=query_string.errors), 400
     
+    # go-raml:begin GET /deliveries
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries', methods=['POST'])
@@ -27,7 +42,9 @@ def deliveries_post():
     if not inputs.validate():
         return jsonify(errors=inputs.errors), 400
     
+    # go-raml:begin POST /deliveries
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries/<deliveryId>', methods=['GET'])
@@ -37,7 +54,9 @@ def deliveries_byDeliveryId_get(deliveryId):
     It is handler for GET /deliveries/<deliveryId>
     '''
     
+    # go-raml:begin GET /deliveries/<deliveryId>
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries/<deliveryId>', methods=['PATCH'])
@@ -51,7 +70,9 @@ def deliveries_byDeliveryId_patch(deliveryId):
     if not inputs.validate():
         return jsonify(errors=inputs.errors), 400
     
+    # go-raml:begin PATCH /deliveries/<deliveryId>
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries/<deliveryId>', methods=['DELETE'])
@@ -61,4 +82,6 @@ def deliveries_byDeliveryId_delete(deliveryId):
     It is handler for DELETE /deliveries/<deliveryId>
     '''
     
+    # go-raml:begin DELETE /deliveries/<deliveryId>
     return jsonify()
+    # go-raml:end
@@ -1,19 +1,36 @@
 from flask import Blueprint, jsonify, request
+from werkzeug.datastructures import MultiDict
+import re
+import params_validator
 
 
+from DronesGetQueryString import DronesGetQueryString
 from User import User
+# go-raml:begin imports
+# go-raml:end
 
 drones_api = Blueprint('drones_api', __name__)
 
 
 @drones_api.route('/drones', methods=['GET'])
+@params_validator.validate([
+    {'in': 'query', 'name': 'atAltitude', 'type': 'number', 'required': True},
+    {'in': 'query', 'name': 'atLatitude', 'type': 'number', 'required': True},
+    {'in': 'query', 'name': 'atLongitude', 'type': 'number', 'required': True},
+    {'in': 'query', 'name': 'atRange', 'type': 'number', 'required': True},
+])
 def drones_get():
     '''
     Get a list of drones
     It is handler for GET /drones
     '''
+    query_string = DronesGetQueryString(MultiDict([(re.sub(r'\W', '_', k), v) for k, v in request.args.items(multi=True)]))
+    if not query_string.validate():
+        return jsonify(errors=query_string.errors), 400
     
+    # go-raml:begin GET /drones
     return jsonify()
+    # go-raml:end
 
 
 @drones_api.route('/drones', methods=['POST'])
@@ -27,7 +44,9 @@ def drones_post():
     if not inputs.validate():
         return jsonify(errors=inputs.errors), 400
     
+    # go-raml:begin POST /drones
     return jsonify()
+    # go-raml:end
 
 
 @drones_api.route('/drones/<droneId>', methods=['GET'])
@@ -37,7 +56,9 @@ def drones_byDroneId_get(droneId):
     It is handler for GET /drones/<droneId>
     '''
     
+    # go-raml:begin GET /drones/<droneId>
     return jsonify()
+    # go-raml:end
 
 
 @drones_api.route('/drones/<droneId>', methods=['PATCH'])
@@ -51,7 +72,9 @@ def drones_byDroneId_patch(droneId):
     if not inputs.validate():
         return jsonify(errors=inputs.errors), 400
     
+    # go-raml:begin PATCH /drones/<droneId>
     return jsonify()
+    # go-raml:end
 
 
 @drones_api.route('/drones/<droneId>', methods=['DELETE'])
@@ -61,7 +84,9 @@ def drones_byDroneId_delete(droneId):
     It is handler for DELETE /drones/<droneId>
     '''
     
+    # go-raml:begin DELETE /drones/<droneId>
     return jsonify()
+    # go-raml:end
 
 
 @drones_api.route('/drones/<droneId>/deliveries', methods=['GET'])
@@ -71,4 +96,6 @@ def drones_byDroneId_deliveries_get(droneId):
     It is handler for GET /drones/<droneId>/deliveries
     '''
     
+    # go-raml:begin GET /drones/<droneId>/deliveries
     return jsonify()
+    # go-raml:end
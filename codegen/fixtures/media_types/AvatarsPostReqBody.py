@@ -0,0 +1,14 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, DateTimeField, FieldList
+from input_validators import multiple_of
+
+
+
+class AvatarsPostReqBody(Form):
+    
+    description = TextField(validators=[])
+    image = FileField(validators=[DataRequired(message="")])
+    thumbnails = FieldList(FileField('thumbnails', [required()]), )
+    userId = IntegerField(validators=[DataRequired(message="")])
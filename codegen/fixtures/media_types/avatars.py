@@ -0,0 +1,53 @@
+from flask import Blueprint, jsonify, request
+from werkzeug.datastructures import CombinedMultiDict
+
+
+from AvatarsIdPutReqBody import AvatarsIdPutReqBody
+from AvatarsPostReqBody import AvatarsPostReqBody
+# go-raml:begin imports
+# go-raml:end
+
+avatars_api = Blueprint('avatars_api', __name__)
+
+
+@avatars_api.route('/avatars', methods=['POST'])
+def avatars_post():
+    '''
+    upload the avatar of an user
+    It is handler for POST /avatars
+    '''
+    
+    inputs = AvatarsPostReqBody(CombinedMultiDict([request.files, request.form]))
+    if not inputs.validate():
+        return jsonify(errors=inputs.errors), 400
+    
+    # go-raml:begin POST /avatars
+    return jsonify()
+    # go-raml:end
+
+
+@avatars_api.route('/avatars/<id>', methods=['GET'])
+def avatars_byId_get(id):
+    '''
+    It is handler for GET /avatars/<id>
+    '''
+    
+    # go-raml:begin GET /avatars/<id>
+    return jsonify()
+    # go-raml:end
+
+
+@avatars_api.route('/avatars/<id>', methods=['PUT'])
+def avatars_byId_put(id):
+    '''
+    update the description of an avatar
+    It is handler for PUT /avatars/<id>
+    '''
+    
+    inputs = AvatarsIdPutReqBody(request.form)
+    if not inputs.validate():
+        return jsonify(errors=inputs.errors), 400
+    
+    # go-raml:begin PUT /avatars/<id>
+    return jsonify()
+    # go-raml:end
@@ -0,0 +1,31 @@
+class AvatarsService:
+    def __init__(self, client):
+        self.client = client
+
+
+
+    def avatars_post(self, data, files=None, headers=None, query_params=None):
+        """
+        upload the avatar of an user
+        It is method for POST /avatars
+        files: dict of the file objects of image, thumbnails
+        """
+        uri = self.client.base_url + "/avatars"
+        return self.client.send_form("POST", uri, data, files=files, headers=headers, params=query_params)
+
+
+    def avatars_byId_get(self, id, headers=None, query_params=None):
+        """
+        It is method for GET /avatars/{id}
+        """
+        uri = self.client.base_url + "/avatars/"+id
+        return self.client.session.get(uri, headers=headers, params=query_params)
+
+
+    def avatars_byId_put(self, data, id, headers=None, query_params=None):
+        """
+        update the description of an avatar
+        It is method for PUT /avatars/{id}
+        """
+        uri = self.client.base_url + "/avatars/"+id
+        return self.client.send_form("PUT", uri, data, headers=headers, params=query_params)
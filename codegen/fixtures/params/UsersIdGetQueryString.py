@@ -0,0 +1,12 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, DateTimeField, FieldList
+from input_validators import multiple_of
+
+
+
+class UsersIdGetQueryString(Form):
+    
+    since = DateField(validators=[], format='%Y-%m-%d')
+    verbose = BooleanField(validators=[])
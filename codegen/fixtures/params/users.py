@@ -0,0 +1,68 @@
+from flask import Blueprint, jsonify, request
+from werkzeug.datastructures import MultiDict
+import re
+import params_validator
+
+
+from UsersGetQueryString import UsersGetQueryString
+from UsersIdGetQueryString import UsersIdGetQueryString
+# go-raml:begin imports
+# go-raml:end
+
+users_api = Blueprint('users_api', __name__)
+
+
+@users_api.route('/users', methods=['GET'])
+@params_validator.validate([
+    {'in': 'query', 'name': 'name', 'min_length': 2, 'max_length': 20},
+    {'in': 'query', 'name': 'page', 'type': 'integer', 'minimum': 1, 'maximum': 100},
+    {'in': 'query', 'name': 'status', 'enum': ['active', 'blocked']},
+    {'in': 'header', 'name': 'X-Request-Id', 'required': True, 'pattern': r'^[a-f0-9]{8}$'},
+])
+def users_get():
+    '''
+    search the users
+    It is handler for GET /users
+    '''
+    query_string = UsersGetQueryString(MultiDict([(re.sub(r'\W', '_', k), v) for k, v in request.args.items(multi=True)]))
+    if not query_string.validate():
+        return jsonify(errors=query_string.errors), 400
+    
+    # go-raml:begin GET /users
+    return jsonify()
+    # go-raml:end
+
+
+@users_api.route('/users/<id>', methods=['GET'])
+@params_validator.validate([
+    {'in': 'uri', 'name': 'id', 'type': 'integer', 'minimum': 1},
+    {'in': 'query', 'name': 'since', 'type': 'date-only'},
+    {'in': 'query', 'name': 'verbose', 'type': 'boolean'},
+])
+def users_byId_get(id):
+    '''
+    get a user
+    It is handler for GET /users/<id>
+    '''
+    query_string = UsersIdGetQueryString(MultiDict([(re.sub(r'\W', '_', k), v) for k, v in request.args.items(multi=True)]))
+    if not query_string.validate():
+        return jsonify(errors=query_string.errors), 400
+    
+    # go-raml:begin GET /users/<id>
+    return jsonify()
+    # go-raml:end
+
+
+@users_api.route('/users/<id>', methods=['DELETE'])
+@params_validator.validate([
+    {'in': 'uri', 'name': 'id', 'type': 'integer', 'minimum': 1},
+])
+def users_byId_delete(id):
+    '''
+    delete a user
+    It is handler for DELETE /users/<id>
+    '''
+    
+    # go-raml:begin DELETE /users/<id>
+    return jsonify()
+    # go-raml:end
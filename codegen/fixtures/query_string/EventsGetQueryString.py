@@ -0,0 +1,24 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, DateTimeField, FieldList
+from input_validators import multiple_of
+
+
+
+class EventsGetQueryString(Form):
+    
+    from_ = DateField(validators=[DataRequired(message="")], format='%Y-%m-%d')
+    page_size = IntegerField(validators=[NumberRange(max=100)])
+    start = IntegerField(validators=[])
+    tags = FieldList(TextField('tags', [required()]), )
+
+    class Meta:
+        # form keys of the fields which are not named after their key
+        form_keys = {
+            'from_': 'from',
+        }
+
+        def bind_field(self, form, unbound_field, options):
+            options['name'] = self.form_keys.get(options['name'], options['name'])
+            return unbound_field.bind(form=form, **options)
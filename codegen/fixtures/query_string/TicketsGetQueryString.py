@@ -0,0 +1,13 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, DateTimeField, FieldList
+from input_validators import multiple_of
+
+
+
+class TicketsGetQueryString(Form):
+    
+    ids = FieldList(IntegerField('ids', [required()]), DataRequired(message=""))
+    since = DateField(validators=[], format='%Y-%m-%d')
+    status = EnumTicketsGetQueryStringStatus(validators=[])
@@ -0,0 +1,40 @@
+from flask import Blueprint, jsonify, request
+from werkzeug.datastructures import MultiDict
+import re
+
+
+from Paging import Paging
+from PlacesGetQueryString import PlacesGetQueryString
+# go-raml:begin imports
+# go-raml:end
+
+places_api = Blueprint('places_api', __name__)
+
+
+@places_api.route('/places', methods=['GET'])
+def places_get():
+    '''
+    search places near a location
+    It is handler for GET /places
+    '''
+    query_string = PlacesGetQueryString(MultiDict([(re.sub(r'\W', '_', k), v) for k, v in request.args.items(multi=True)]))
+    if not query_string.validate():
+        return jsonify(errors=query_string.errors), 400
+    
+    # go-raml:begin GET /places
+    return jsonify()
+    # go-raml:end
+
+
+@places_api.route('/places/<id>/photos', methods=['GET'])
+def places_byId_photos_get(id):
+    '''
+    It is handler for GET /places/<id>/photos
+    '''
+    query_string = Paging(MultiDict([(re.sub(r'\W', '_', k), v) for k, v in request.args.items(multi=True)]))
+    if not query_string.validate():
+        return jsonify(errors=query_string.errors), 400
+    
+    # go-raml:begin GET /places/<id>/photos
+    return jsonify()
+    # go-raml:end
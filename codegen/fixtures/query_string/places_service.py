@@ -0,0 +1,23 @@
+class PlacesService:
+    def __init__(self, client):
+        self.client = client
+
+
+
+    def places_get(self, query_string=None, headers=None, query_params=None):
+        """
+        search places near a location
+        It is method for GET /places
+        query_string: dict of lat, location, long
+        """
+        uri = self.client.base_url + "/places"
+        return self.client.session.get(uri, headers=headers, params=dict(query_string or {}, **(query_params or {})))
+
+
+    def places_byId_photos_get(self, id, query_string=None, headers=None, query_params=None):
+        """
+        It is method for GET /places/{id}/photos
+        query_string: dict of page-size, start
+        """
+        uri = self.client.base_url + "/places/"+id+"/photos"
+        return self.client.session.get(uri, headers=headers, params=dict(query_string or {}, **(query_params or {})))
@@ -0,0 +1,53 @@
+from flask import Blueprint, jsonify, request
+
+
+from UsersIdPutReqBody import UsersIdPutReqBody
+# go-raml:begin imports
+# go-raml:end
+
+users_api = Blueprint('users_api', __name__)
+
+
+@users_api.route('/users', methods=['GET'])
+def users_get():
+    '''
+    list the users
+    It is handler for GET /users
+    '''
+    
+    # go-raml:begin GET /users
+    return jsonify(implemented=True)
+    # go-raml:end
+
+
+@users_api.route('/users/<id>', methods=['GET'])
+def users_byId_get(id):
+    '''
+    get a user
+    It is handler for GET /users/<id>
+    '''
+    
+    # go-raml:begin GET /users/<id>
+    return jsonify(implemented=True)
+    # go-raml:end
+
+
+@users_api.route('/users/<id>', methods=['PUT'])
+def users_byId_put(id):
+    '''
+    update a user
+    It is handler for PUT /users/<id>
+    '''
+    
+    inputs = UsersIdPutReqBody.from_json(request.get_json())
+    if not inputs.validate():
+        return jsonify(errors=inputs.errors), 400
+    
+    # go-raml:begin PUT /users/<id>
+    return jsonify()
+    # go-raml:end
+
+# go-raml:begin removed
+# DELETE /users/<id>:
+#     return jsonify(implemented=True)
+# go-raml:end
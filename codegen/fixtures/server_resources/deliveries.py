@@ -1,6 +1,8 @@
 from flask import Blueprint, jsonify, request
 
 
+# go-raml:begin imports
+# go-raml:end
 
 deliveries_api = Blueprint('deliveries_api', __name__)
 
@@ -12,7 +14,9 @@ def deliveries_get():
     It is handler for GET /deliveries
     '''
     
+    # go-raml:begin GET /deliveries
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries', methods=['POST'])
@@ -22,7 +26,9 @@ def deliveries_post():
     It is handler for POST /deliveries
     '''
     
+    # go-raml:begin POST /deliveries
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries/<deliveryId>', methods=['GET'])
@@ -32,7 +38,9 @@ def getDeliveriesByDeliveryID(deliveryId):
     It is handler for GET /deliveries/<deliveryId>
     '''
     
+    # go-raml:begin GET /deliveries/<deliveryId>
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries/<deliveryId>', methods=['PATCH'])
@@ -42,7 +50,9 @@ def deliveries_byDeliveryId_patch(deliveryId):
     It is handler for PATCH /deliveries/<deliveryId>
     '''
     
+    # go-raml:begin PATCH /deliveries/<deliveryId>
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries/<deliveryId>', methods=['DELETE'])
@@ -52,4 +62,6 @@ def deliveries_byDeliveryId_delete(deliveryId):
     It is handler for DELETE /deliveries/<deliveryId>
     '''
     
+    # go-raml:begin DELETE /deliveries/<deliveryId>
     return jsonify()
+    # go-raml:end
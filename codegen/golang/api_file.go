@@ -0,0 +1,225 @@
+package golang
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Jumpscale/go-raml/codegen/commons"
+	"github.com/Jumpscale/go-raml/codegen/resource"
+)
+
+// reHandlerDoc matches the doc comment of the generated handlers
+var reHandlerDoc = regexp.MustCompile(`is the handler for ([A-Z]+) (\S+)`)
+
+// apiType is the API implementation type of a resource, as declared in the Go files of a directory
+type apiType struct {
+	methods  map[string]bool   // names of the methods
+	handlers map[string]string // name of the methods by `VERB /endpoint`, from their doc comments
+}
+
+// parseAPIType finds the methods of the type of the given name in the Go files of dir
+func parseAPIType(dir, name string) (apiType, error) {
+	at := apiType{
+		methods:  map[string]bool{},
+		handlers: map[string]string{},
+	}
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return at, err
+	}
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				fd, ok := decl.(*ast.FuncDecl)
+				if !ok || fd.Recv == nil || len(fd.Recv.List) == 0 || receiverName(fd.Recv.List[0].Type) != name {
+					continue
+				}
+				at.methods[fd.Name.Name] = true
+				if fd.Doc == nil {
+					continue
+				}
+				if m := reHandlerDoc.FindStringSubmatch(fd.Doc.Text()); m != nil {
+					at.handlers[m[1]+" "+m[2]] = fd.Name.Name
+				}
+			}
+		}
+	}
+	return at, nil
+}
+
+// receiverName returns the name of the type of a method receiver
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// updateAPIFile updates the existing API implementation file of a resource:
+// the stubs of the new handlers are appended to the file and the handlers
+// which are renamed or not in the specification anymore are reported.
+// The code of the implementation is never modified.
+func (gr *goResource) updateAPIFile(filename, dir, tmplFile, tmplName string) error {
+	typeName := gr.Name + "API"
+	at, err := parseAPIType(dir, typeName)
+	if err != nil {
+		return fmt.Errorf("can't update %v: %v", filename, err)
+	}
+
+	var missing []resource.MethodInterface
+	endpoints := map[string]string{} // name of the methods by `VERB /endpoint`
+	for _, v := range gr.Methods {
+		gm := v.(serverMethod)
+		endpoints[gm.Verb()+" "+gm.Endpoint] = gm.MethodName
+		if !at.methods[gm.MethodName] {
+			missing = append(missing, v)
+		}
+	}
+
+	for _, endpoint := range sortedKeys(at.handlers) {
+		name := at.handlers[endpoint]
+		switch newName, ok := endpoints[endpoint]; {
+		case !ok:
+			log.Warnf("%v: %v.%v is the handler of %v which is not in the specification anymore", filename, typeName, name, endpoint)
+		case newName != name:
+			log.Warnf("%v: the handler of %v is renamed from %v.%v to %v.%v", filename, endpoint, typeName, name, typeName, newName)
+		}
+	}
+
+	if len(missing) == 0 {
+		log.Infof("%v: all the handlers are implemented", filename)
+		return nil
+	}
+
+	// generate the stubs of the missing handlers only,
+	// so only the packages they need are imported
+	rd := *gr.Resource
+	rd.Methods = missing
+	stubs := *gr
+	stubs.Resource = &rd
+	content, err := commons.ExecuteTemplate(stubs, tmplFile, tmplName)
+	if err != nil {
+		return err
+	}
+
+	old, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	updated, err := appendGoDecls(old, content)
+	if err != nil {
+		return fmt.Errorf("can't update %v: %v", filename, err)
+	}
+	for _, v := range missing {
+		gm := v.(serverMethod)
+		log.Infof("%v: added the handler %v.%v of %v %v", filename, typeName, gm.MethodName, gm.Verb(), gm.Endpoint)
+	}
+	return ioutil.WriteFile(filename, updated, 0644)
+}
+
+// appendGoDecls appends the functions of the Go source src to the Go source dst,
+// the packages imported by src are added to the imports of dst.
+func appendGoDecls(dst, src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	srcFile, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	dstFile, err := parser.ParseFile(fset, "", dst, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	imported := map[string]bool{}
+	for _, spec := range dstFile.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		imported[path] = true
+	}
+	var imports []string
+	for _, spec := range srcFile.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); !imported[path] {
+			imports = append(imports, spec.Path.Value)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.Write(dst[:importsOffset(fset, dstFile)])
+	if len(imports) > 0 {
+		if hasImportBlock(dstFile) {
+			buf.WriteString(strings.Join(imports, "\n") + "\n")
+		} else {
+			buf.WriteString("\n\nimport (\n" + strings.Join(imports, "\n") + "\n)\n")
+		}
+	}
+	buf.Write(dst[importsOffset(fset, dstFile):])
+
+	for _, decl := range srcFile.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		start := fd.Pos()
+		if fd.Doc != nil {
+			start = fd.Doc.Pos()
+		}
+		buf.WriteString("\n")
+		buf.Write(src[fset.Position(start).Offset:fset.Position(fd.End()).Offset])
+		buf.WriteString("\n")
+	}
+	return format.Source(buf.Bytes())
+}
+
+// hasImportBlock returns true if the file has a parenthesized import declaration
+func hasImportBlock(f *ast.File) bool {
+	for _, decl := range f.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT && gd.Lparen.IsValid() {
+			return true
+		}
+	}
+	return false
+}
+
+// importsOffset returns the offset where the new imports are inserted:
+// before the closing parenthesis of the first import block,
+// or after the package clause if there is no import block
+func importsOffset(fset *token.FileSet, f *ast.File) int {
+	for _, decl := range f.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT && gd.Lparen.IsValid() {
+			return fset.Position(gd.Rparen).Offset
+		}
+	}
+	return fset.Position(f.Name.End()).Offset
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// apiFilename returns the name of the API implementation file of a resource
+func (gr *goResource) apiFilename(dir string) string {
+	return filepath.Join(dir, strings.ToLower(gr.Name)+"_api.go")
+}
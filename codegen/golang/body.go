@@ -1,6 +1,8 @@
 package golang
 
 import (
+	"strconv"
+
 	"github.com/Jumpscale/go-raml/codegen/commons"
 	"github.com/Jumpscale/go-raml/raml"
 )
@@ -9,7 +11,7 @@ import (
 func generateBodyStructs(apiDef *raml.APIDefinition, dir, packageName string) error {
 	// generate
 	for _, v := range apiDef.Resources {
-		if err := generateStructsFromResourceBody("", dir, packageName, &v); err != nil {
+		if err := generateStructsFromResourceBody("", dir, packageName, &v, apiDef.Types); err != nil {
 			return err
 		}
 	}
@@ -17,8 +19,9 @@ func generateBodyStructs(apiDef *raml.APIDefinition, dir, packageName string) er
 	return nil
 }
 
-// generate all structs from resource's method's request & response body
-func generateStructsFromResourceBody(resourcePath, dir, packageName string, r *raml.Resource) error {
+// generate all structs from resource's method's request & response body and query string,
+// types are the types the query strings could refer to
+func generateStructsFromResourceBody(resourcePath, dir, packageName string, r *raml.Resource, types map[string]raml.Type) error {
 	if r == nil {
 		return nil
 	}
@@ -40,14 +43,14 @@ func generateStructsFromResourceBody(resourcePath, dir, packageName string, r *r
 	normalizedPath := commons.NormalizeURITitle(resourcePath + r.URI)
 
 	for _, v := range methods {
-		if err := buildBodyFromMethod(normalizedPath, v.Name, dir, packageName, v.Method); err != nil {
+		if err := buildBodyFromMethod(resourcePath+r.URI, normalizedPath, v.Name, dir, packageName, v.Method, types); err != nil {
 			return err
 		}
 	}
 
 	// build request/response body of child resources
 	for _, v := range r.Nested {
-		if err := generateStructsFromResourceBody(resourcePath+r.URI, dir, packageName, v); err != nil {
+		if err := generateStructsFromResourceBody(resourcePath+r.URI, dir, packageName, v, types); err != nil {
 			return err
 		}
 	}
@@ -55,18 +58,42 @@ func generateStructsFromResourceBody(resourcePath, dir, packageName string, r *r
 	return nil
 }
 
-// build request and reponse body of a method.
+// build request and reponse body and query string of a method.
 // in python case, we only need to build it for request body because we only need it for validator
-func buildBodyFromMethod(normalizedPath, methodName, dir, packageName string, method *raml.Method) error {
+func buildBodyFromMethod(endpoint, normalizedPath, methodName, dir, packageName string, method *raml.Method, types map[string]raml.Type) error {
 	if method == nil {
 		return nil
 	}
 
+	// generate struct for query string
+	if err := generateStructFromQueryString(normalizedPath+methodName, dir, packageName, method, types); err != nil {
+		return err
+	}
+
+	// generate struct for the request headers of the typed handlers
+	if globTypedHandlers {
+		if err := generateStructFromHeaders(normalizedPath+methodName, dir, packageName, method); err != nil {
+			return err
+		}
+	}
+
 	//generate struct for request body
 	if err := generateStructFromBody(normalizedPath+methodName, dir, packageName, &method.Bodies, true); err != nil {
 		return err
 	}
 
+	// generate a type per status code for the typed responses,
+	// the body of each status code has it's own struct
+	if mr := newMethodResponses(method, methodName, endpoint, normalizedPath+methodName, packageName); mr != nil {
+		for code, val := range method.Responses {
+			prefix := normalizedPath + methodName + strconv.Itoa(commons.AtoiOrPanic(string(code)))
+			if err := generateStructFromBody(prefix, dir, packageName, &val.Bodies, false); err != nil {
+				return err
+			}
+		}
+		return mr.generate(dir)
+	}
+
 	//generate struct for response body
 	for _, val := range method.Responses {
 		if err := generateStructFromBody(normalizedPath+methodName, dir, packageName, &val.Bodies, false); err != nil {
@@ -80,13 +107,86 @@ func buildBodyFromMethod(normalizedPath, methodName, dir, packageName string, me
 
 // generate a struct from an RAML request/response body
 func generateStructFromBody(structNamePrefix, dir, packageName string, body *raml.Bodies, isGenerateRequest bool) error {
+	if body.IsXML() {
+		return generateStructFromXMLBody(structNamePrefix, dir, packageName, body.ApplicationXML, isGenerateRequest)
+	}
+	if _, form := body.FormBody(); form != nil {
+		return generateStructFromFormBody(structNamePrefix, dir, packageName, form, isGenerateRequest)
+	}
 	if !commons.HasJSONBody(body) {
 		return nil
 	}
 
+	// JSON schema body, for example:
+	// application/json:
+	//		type: |
+	//			{
+	//				"type": "object",
+	//				"properties": {
+	//					"message": {
+	//						"type": "string"
+	//					}
+	//				}
+	//			}
+	if raml.IsJSONSchema(body.ApplicationJSON.Type) {
+		types, err := raml.JSONSchemaTypes(bodyStructName(structNamePrefix, isGenerateRequest), body.ApplicationJSON.Type)
+		if err != nil {
+			return err
+		}
+		return generateStructs(types, dir, packageName)
+	}
+
 	// construct struct from body
-	structDef := newStructDefFromBody(body, structNamePrefix, packageName, isGenerateRequest)
+	structDef := newStructDefFromBody(body.ApplicationJSON.Properties, structNamePrefix, packageName, isGenerateRequest)
 
 	// generate
 	return structDef.generate(dir)
 }
+
+// generate a struct from an application/xml body, which could be:
+// - an XML schema, the document is kept as is in the struct
+// - an inline object type
+func generateStructFromXMLBody(structNamePrefix, dir, packageName string, body *raml.Body, isGenerateRequest bool) error {
+	name := bodyStructName(structNamePrefix, isGenerateRequest)
+	if _, ok := body.XMLSchema(); ok {
+		return newXMLDocumentStructDef(name, packageName).generate(dir)
+	}
+	if len(body.Properties) == 0 {
+		return nil
+	}
+	return newStructDefFromBody(body.Properties, structNamePrefix, packageName, isGenerateRequest).generate(dir)
+}
+
+// generate a struct from the inline object type of a multipart/form-data
+// or application/x-www-form-urlencoded body
+func generateStructFromFormBody(structNamePrefix, dir, packageName string, body *raml.Body, isGenerateRequest bool) error {
+	if len(body.Properties) == 0 {
+		return nil
+	}
+	return newStructDefFromBody(body.Properties, structNamePrefix, packageName, isGenerateRequest).generate(dir)
+}
+
+// generate a struct from the properties of a method query string, or from it's query parameters.
+// Nothing is generated if the query string is only a type name, the struct of the type is used.
+func generateStructFromQueryString(structNamePrefix, dir, packageName string, method *raml.Method, types map[string]raml.Type) error {
+	qs := method.QueryStringType()
+	if qs == nil || commons.QueryStringTypeName(qs) != "" {
+		return nil
+	}
+	props := method.QueryStringProperties(types)
+	if method.QueryString == nil { // query parameters
+		props = commons.WithoutParamFacets(props)
+	}
+	name := structNamePrefix + commons.QueryStringSuffix
+	return newStructDef(name, packageName, qs.Description, props).generate(dir)
+}
+
+// generate a struct from the request headers of a method
+func generateStructFromHeaders(structNamePrefix, dir, packageName string, method *raml.Method) error {
+	props := method.HeadersProperties()
+	if len(props) == 0 {
+		return nil
+	}
+	name := structNamePrefix + commons.HeadersSuffix
+	return newStructDef(name, packageName, "", commons.WithoutParamFacets(props)).generate(dir)
+}
@@ -35,6 +35,8 @@ func NewClient(apiDef *raml.APIDefinition, packageName, rootImportPath string) (
 
 	globRootImportPath = rootImportPath
 	globAPIDef = apiDef
+	globTypedHandlers = false
+	globRouter = routers[RouterGorilla]
 
 	services := map[string]*ClientService{}
 	for k, v := range apiDef.Resources {
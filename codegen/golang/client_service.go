@@ -46,3 +46,21 @@ func (cs ClientService) LibImportPaths() map[string]struct{} {
 	}
 	return ip
 }
+
+// CodecImportPaths returns the packages used to decode the response bodies,
+// the request bodies are encoded by the client utils
+// and the typed responses decode themselves.
+func (cs ClientService) CodecImportPaths() map[string]struct{} {
+	ip := map[string]struct{}{}
+	for _, v := range cs.Methods {
+		gm := v.(clientMethod)
+		switch {
+		case gm.RespBody == "", gm.TypedResponses:
+		case gm.RespBodyIsXML():
+			ip["encoding/xml"] = struct{}{}
+		default:
+			ip["encoding/json"] = struct{}{}
+		}
+	}
+	return ip
+}
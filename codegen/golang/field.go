@@ -2,11 +2,16 @@ package golang
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/Jumpscale/go-raml/raml"
 )
 
+var (
+	regNonIdentifier = regexp.MustCompile(`[^\pL\pN_]+`)
+)
+
 // FieldDef defines a field of a struct
 type fieldDef struct {
 	Name          string // field name
@@ -14,18 +19,24 @@ type fieldDef struct {
 	IsComposition bool   // composition type
 	IsOmitted     bool   // omitted empty
 	UniqueItems   bool
-	Enum          *enum // not nil if this field contains enum
+	Enum          *enum      // not nil if this field contains enum
+	Struct        *structDef // not nil if the type of this field is an inline object type
+	XMLTag        string     // value of the `xml` struct tag
 
 	Validators string
 }
 
 func newFieldDef(structName string, prop raml.Property, pkg string) fieldDef {
 	fd := fieldDef{
-		Name:      strings.Title(prop.Name),
+		Name:      fieldName(prop.Name),
 		Type:      convertToGoType(prop.Type),
 		IsOmitted: !prop.Required,
 	}
+	if prop.Type == "object" {
+		fd.buildInlineObject(structName, prop, pkg)
+	}
 	fd.buildValidators(prop)
+	fd.buildXMLTag(prop)
 	if prop.IsEnum() {
 		fd.Enum = newEnum(structName, prop, pkg, false)
 		fd.Type = fd.Enum.Name
@@ -34,13 +45,101 @@ func newFieldDef(structName string, prop raml.Property, pkg string) fieldDef {
 	return fd
 }
 
+// buildInlineObject builds the Go type of a property of an inline object type:
+//   - a map if it only has pattern properties, e.g. `//: string` is a map[string]string
+//   - a struct named after the struct and the field otherwise, e.g. `ProductLabels`
+//   - a map of any value if it has no properties
+func (fd *fieldDef) buildInlineObject(structName string, prop raml.Property, pkg string) {
+	var valueType string
+	for name, v := range prop.Properties {
+		p := raml.ToProperty(name, v)
+		if !p.IsPattern() {
+			sd := newStructDef(structName+fd.Name, pkg, prop.Description, prop.Properties)
+			sd.T = raml.Type{Type: "object", Properties: prop.Properties}
+			sd.buildAdditionalProperties(nil)
+			fd.Struct = &sd
+			fd.Type = sd.Name
+			return
+		}
+
+		typ := convertToGoType(p.Type)
+		if p.Type == "object" {
+			typ = "interface{}"
+		}
+		if valueType != "" && valueType != typ {
+			typ = "interface{}"
+		}
+		valueType = typ
+	}
+	if valueType == "" {
+		valueType = "interface{}"
+	}
+	fd.Type = "map[string]" + valueType
+}
+
+// fieldName returns the Go name of the field of a property,
+// the characters not allowed in an identifier are removed, e.g. `page-size` becomes `PageSize`
+func fieldName(propName string) string {
+	return regNonIdentifier.ReplaceAllString(strings.Title(propName), "")
+}
+
+// buildXMLTag builds the `xml` struct tag from the xml facet of the property.
+// The prefix of the xml facet is ignored, encoding/xml only supports the namespace.
+// see http://docs.raml.org/specs/1.0/#raml-10-spec-xml-serialization-of-type-instances
+func (fd *fieldDef) buildXMLTag(p raml.Property) {
+	// encoding/xml doesn't support maps
+	if strings.HasPrefix(fd.Type, "map[") {
+		fd.XMLTag = "-"
+		return
+	}
+
+	x := p.XML
+	if x == nil {
+		x = &raml.XML{}
+	}
+
+	name := p.Name
+	if x.Name != "" {
+		name = x.Name
+	}
+	if x.Namespace != "" {
+		name = x.Namespace + " " + name
+	}
+
+	switch {
+	case x.Attribute:
+		name += ",attr"
+	case x.Wrapped:
+		name += ">" + xmlItemName(p)
+	}
+
+	if fd.IsOmitted {
+		name += ",omitempty"
+	}
+	fd.XMLTag = name
+}
+
+// xmlItemName returns the element name of the items of a wrapped array,
+// which is the name of the items type, e.g. `Author` for `Author[]` and `lib.Author[]`
+func xmlItemName(p raml.Property) string {
+	te, err := raml.ParseTypeExpr(p.Type)
+	if err != nil || te.Kind != raml.TypeExprArray || te.Items.Kind != raml.TypeExprName {
+		return p.Name
+	}
+	name := te.Items.Name
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
 func (fd *fieldDef) buildValidators(p raml.Property) {
 	validators := ""
-	// string
-	if p.MinLength != nil {
+	// string, the length of a file isn't validated
+	if p.MinLength != nil && p.Type != "file" {
 		validators += fmt.Sprintf(",min=%v", *p.MinLength)
 	}
-	if p.MaxLength != nil {
+	if p.MaxLength != nil && p.Type != "file" {
 		validators += fmt.Sprintf(",max=%v", *p.MaxLength)
 	}
 	if p.Pattern != nil {
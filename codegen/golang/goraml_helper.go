@@ -37,9 +37,48 @@ func (gh goramlHelper) generate(dir string) error {
 		return err
 	}
 
+	// file of the multipart bodies
+	ctx := map[string]interface{}{
+		"PackageName": gh.packageName,
+	}
+	if err := commons.GenerateFile(ctx, "./templates/file_go.tmpl", "file_go", filepath.Join(pkgDir, "file.go"), true); err != nil {
+		return err
+	}
+
+	// headers of the typed responses
+	if err := commons.GenerateFile(ctx, "./templates/header_go.tmpl", "header_go", filepath.Join(pkgDir, "header.go"), true); err != nil {
+		return err
+	}
+
 	// generate struct validator
 	if err := generateInputValidator(gh.packageName, pkgDir); err != nil {
 		return err
 	}
 	return nil
 }
+
+// generate the helpers which decode the query strings, headers and form bodies into structs,
+// validate the request parameters and write the error responses
+func generateRequestDecoders(packageName, dir string) error {
+	ctx := struct {
+		PackageName string
+	}{
+		PackageName: packageName,
+	}
+	decoders := []struct {
+		name     string
+		fileName string
+	}{
+		{"query_string_go", "query_string.go"},
+		{"form_go", "form.go"},
+		{"params_go", "params.go"},
+		{"error_go", "error.go"},
+	}
+	for _, d := range decoders {
+		fileName := filepath.Join(dir, d.fileName)
+		if err := commons.GenerateFile(ctx, "./templates/"+d.name+".tmpl", d.name, fileName, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -80,6 +80,13 @@ func libImportPath(rootImportPath, typ string) string {
 		return ""
 	}
 
+	// element type of the arrays and maps, e.g. `[]goraml.File`
+	for _, prefix := range []string{"[]", "map[string]", "*"} {
+		for strings.HasPrefix(typ, prefix) {
+			typ = typ[len(prefix):]
+		}
+	}
+
 	// library name in the current document
 	libName := strings.Split(typ, ".")[0]
 
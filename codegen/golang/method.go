@@ -12,7 +12,18 @@ import (
 
 type serverMethod struct {
 	*resource.Method
-	Middlewares string
+	Middlewares    string
+	TypedResponses bool // RespBody is the typed response of the first 2xx status code
+
+	// typed handler, see setupTypedHandler
+	HandlerName string           // name of the function which wraps the typed handler
+	URIParams   []uriParam       // URI parameters
+	HeadersType string           // request headers struct, empty if there is no request header
+	RespTypes   *methodResponses // responses, nil if there is no response
+
+	// parameters validated before the handler is called, see setupParams
+	Params    []resource.RequestParam
+	ParamsVar string // name of the variable of the goraml.Param of the parameters
 }
 
 // setup go server method, initializes all needed variables
@@ -58,11 +69,23 @@ func (gm serverMethod) libImported(rootImportPath string) map[string]struct{} {
 	if lib := libImportPath(rootImportPath, gm.RespBody); lib != "" {
 		libs[lib] = struct{}{}
 	}
+	// request body, the errors are written and the form body is decoded by the goraml package
+	if gm.ReqBody != "" {
+		libs[libImportPath(rootImportPath, "goraml.WriteError")] = struct{}{}
+	}
+	// query string, decoded by the goraml package
+	if gm.QueryString != "" {
+		libs[libImportPath(rootImportPath, "goraml.DecodeQueryString")] = struct{}{}
+		if lib := libImportPath(rootImportPath, gm.QueryString); lib != "" {
+			libs[lib] = struct{}{}
+		}
+	}
 	return libs
 }
 
 type clientMethod struct {
 	*resource.Method
+	TypedResponses bool // RespBody is the type of the responses of all the status codes
 }
 
 // create client resource's method
@@ -74,8 +97,13 @@ func newGoClientMethod(r *raml.Resource, rd *resource.Resource, m *raml.Method,
 	name := commons.NormalizeURITitle(method.Endpoint)
 
 	method.ReqBody = setBodyName(m.Bodies, name+methodName, "ReqBody")
+	method.QueryString = queryStringName(m, name+methodName)
 
 	gcm := clientMethod{Method: &method}
+	if mr := newMethodResponses(m, methodName, method.Endpoint, name+methodName, ""); mr != nil {
+		gcm.RespBody = mr.Name
+		gcm.TypedResponses = true
+	}
 	gcm.setup(methodName)
 	return gcm, nil
 }
@@ -100,6 +128,11 @@ func (gcm *clientMethod) setup(methodName string) {
 			params = append(params, strings.ToLower(bodyType)+" "+bodyType)
 		}
 
+		// append query string
+		if gcm.QueryString != "" {
+			params = append(params, "queryString "+gcm.QueryString)
+		}
+
 		// append header
 		if len(paramsStr) > 0 {
 			paramsStr += ","
@@ -144,6 +177,10 @@ func (gcm clientMethod) libImported(rootImportPath string) map[string]struct{} {
 	if lib := libImportPath(rootImportPath, gcm.RespBody); lib != "" {
 		libs[lib] = struct{}{}
 	}
+	// query string
+	if lib := libImportPath(rootImportPath, gcm.QueryString); lib != "" {
+		libs[lib] = struct{}{}
+	}
 	return libs
 }
 
@@ -181,24 +218,56 @@ func newServerMethod(apiDef *raml.APIDefinition, r *raml.Resource, rd *resource.
 	// security scheme
 	method.SecuredBy = security.GetMethodSecuredBy(apiDef, r, m)
 
+	method.QueryString = queryStringName(m, method.Endpoint+methodName)
+
 	gm := serverMethod{
 		Method: &method,
 	}
+	if globTypedHandlers {
+		gm.setupTypedHandler(r, m, methodName)
+	} else if mr := newMethodResponses(m, methodName, method.Endpoint, commons.NormalizeURITitle(method.Endpoint+methodName), ""); mr != nil {
+		gm.RespBody = mr.DefaultResponse()
+		gm.TypedResponses = true
+	}
+	gm.setupParams(methodName)
 	gm.setup(apiDef, r, rd, methodName)
 	return gm
 }
 
+// queryStringName returns the name of the query string struct of a method,
+// the struct of the type is used if the query string is only a type name.
+// The struct of the query parameters is named as an inline query string.
+func queryStringName(m *raml.Method, prefix string) string {
+	qs := m.QueryStringType()
+	if qs == nil {
+		return ""
+	}
+	if name := commons.QueryStringTypeName(qs); name != "" {
+		return convertToGoType(name)
+	}
+	return commons.NormalizeURITitle(prefix) + commons.QueryStringSuffix
+}
+
 // setBodyName set name of method's request/response body.
+// The name of the inline body is prefix+suffix, see bodyTypeName.
+func setBodyName(bodies raml.Bodies, prefix, suffix string) string {
+	return bodyTypeName(bodies, commons.NormalizeURITitle(prefix)+suffix)
+}
+
+// bodyTypeName returns the Go type of a request/response body.
 //
 // Rules:
 //	- use bodies.Type if not empty and not `object`
 //	- use bodies.ApplicationJSON.Type if not empty and not `object`
-//	- use prefix+suffix if:
+//	- use bodies.ApplicationXML.Type if not empty and not `object`, if there is no JSON body
+//	- use the type of the multipart/form-data or application/x-www-form-urlencoded body
+//	  if not empty and not `object`, if there is no JSON nor XML body
+//	- use name, the name of the inline body, if:
 //		- not meet previous rules
 //		- previous rules produces JSON string
-func setBodyName(bodies raml.Bodies, prefix, suffix string) string {
+//		- the XML body is declared using XML schema
+func bodyTypeName(bodies raml.Bodies, name string) string {
 	var tipe string
-	prefix = commons.NormalizeURITitle(prefix)
 
 	if len(bodies.Type) > 0 && bodies.Type != "object" {
 		tipe = convertToGoType(bodies.Type)
@@ -206,12 +275,28 @@ func setBodyName(bodies raml.Bodies, prefix, suffix string) string {
 		if bodies.ApplicationJSON.Type != "" && bodies.ApplicationJSON.Type != "object" {
 			tipe = convertToGoType(bodies.ApplicationJSON.Type)
 		} else {
-			tipe = prefix + suffix
+			tipe = name
+		}
+	} else if bodies.ApplicationXML != nil {
+		xmlType := bodies.ApplicationXML.Type
+		if xmlType == "" { // schema is an alias of type
+			xmlType = bodies.ApplicationXML.Schema
+		}
+		if _, isSchema := bodies.ApplicationXML.XMLSchema(); !isSchema && xmlType != "" && xmlType != "object" {
+			tipe = convertToGoType(xmlType)
+		} else {
+			tipe = name
+		}
+	} else if _, form := bodies.FormBody(); form != nil {
+		if form.Type != "" && form.Type != "object" {
+			tipe = convertToGoType(form.Type)
+		} else if len(form.Properties) > 0 {
+			tipe = name
 		}
 	}
 
 	if commons.IsJSONString(tipe) {
-		tipe = prefix + suffix
+		tipe = name
 	}
 
 	return tipe
@@ -0,0 +1,94 @@
+package golang
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/codegen/commons"
+	"github.com/Jumpscale/go-raml/codegen/resource"
+)
+
+// constants of the locations of the parameters in the goraml package
+var paramLocations = map[string]string{
+	resource.ParamInURI:    "goraml.InURI",
+	resource.ParamInQuery:  "goraml.InQuery",
+	resource.ParamInHeader: "goraml.InHeader",
+}
+
+// setupParams sets the URI, query and header parameters of a server method
+// which are validated before the handler is called
+func (gm *serverMethod) setupParams(methodName string) {
+	gm.Params = gm.RequestParams()
+	if len(gm.Params) == 0 {
+		return
+	}
+	prefix := commons.NormalizeURITitle(gm.Endpoint + methodName)
+	gm.ParamsVar = strings.ToLower(prefix[:1]) + prefix[1:] + "Params"
+}
+
+// ParamLiterals returns the goraml.Param literals of the parameters of the method
+func (gm serverMethod) ParamLiterals() []string {
+	var literals []string
+	for _, p := range gm.Params {
+		literals = append(literals, paramLiteral(p))
+	}
+	return literals
+}
+
+// Handler returns the expression of the handler of the route of the method,
+// h is wrapped by the validator of the parameters if the method has parameters to validate
+func (gm serverMethod) Handler(h string) string {
+	if gm.ParamsVar == "" {
+		return h
+	}
+	return fmt.Sprintf("goraml.ValidateParams(%v, %v, %v)", gm.ParamsVar, gm.URIParamsFunc(), h)
+}
+
+// paramLiteral returns the goraml.Param literal of a request parameter
+func paramLiteral(p resource.RequestParam) string {
+	fields := []string{
+		"In: " + paramLocations[p.In],
+		"Name: " + strconv.Quote(p.Name),
+	}
+	if p.Type != "" && p.Type != "string" {
+		fields = append(fields, "Type: "+strconv.Quote(p.Type))
+	}
+	if p.Array {
+		fields = append(fields, "Array: true")
+	}
+	if p.Required {
+		fields = append(fields, "Required: true")
+	}
+	if len(p.Enum) > 0 {
+		var enum []string
+		for _, e := range p.Enum {
+			enum = append(enum, strconv.Quote(e))
+		}
+		fields = append(fields, "Enum: []string{"+strings.Join(enum, ", ")+"}")
+	}
+	if p.Pattern != "" {
+		fields = append(fields, "Pattern: regexp.MustCompile("+quoteRaw(p.Pattern)+")")
+	}
+	if p.Minimum != nil {
+		fields = append(fields, fmt.Sprintf("Minimum: goraml.Float(%v)", *p.Minimum))
+	}
+	if p.Maximum != nil {
+		fields = append(fields, fmt.Sprintf("Maximum: goraml.Float(%v)", *p.Maximum))
+	}
+	if p.MinLength != nil {
+		fields = append(fields, fmt.Sprintf("MinLength: goraml.Int(%v)", *p.MinLength))
+	}
+	if p.MaxLength != nil {
+		fields = append(fields, fmt.Sprintf("MaxLength: goraml.Int(%v)", *p.MaxLength))
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// quoteRaw returns a raw string literal of s, or an interpreted one if s contains a backquote
+func quoteRaw(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
@@ -1,6 +1,7 @@
 package golang
 
 import (
+	"os"
 	"sort"
 	"strings"
 
@@ -10,24 +11,38 @@ import (
 )
 
 const (
-	resourceIfTemplate  = "./templates/server_resources_interface.tmpl" // resource interface template
-	resourceAPITemplate = "./templates/server_resources_api.tmpl"       // resource API template
+	resourceIfTemplate       = "./templates/server_resources_interface.tmpl"       // resource interface template
+	resourceAPITemplate      = "./templates/server_resources_api.tmpl"             // resource API template
+	resourceTypedIfTemplate  = "./templates/server_resources_typed_interface.tmpl" // resource typed interface template
+	resourceTypedAPITemplate = "./templates/server_resources_typed_api.tmpl"       // resource typed API template
 )
 
 type goResource struct {
 	*resource.Resource
+	TypedHandlers bool // the methods are typed handlers
 }
 
 // generate interface file of a resource
 func (gr *goResource) generateInterfaceFile(directory string) error {
 	filename := directory + "/" + strings.ToLower(gr.Name) + "_if.go"
+	if gr.TypedHandlers {
+		return commons.GenerateFile(gr, resourceTypedIfTemplate, "resource_typed_if_template", filename, true)
+	}
 	return commons.GenerateFile(gr, resourceIfTemplate, "resource_if_template", filename, true)
 }
 
-// generate API file of a resource
+// generate API file of a resource,
+// an existing file is updated with the stubs of the new handlers if the regions are preserved
 func (gr *goResource) generateAPIFile(directory string) error {
-	filename := directory + "/" + strings.ToLower(gr.Name) + "_api.go"
-	return commons.GenerateFile(gr, resourceAPITemplate, "resource_api_template", filename, false)
+	filename := gr.apiFilename(directory)
+	tmplFile, tmplName := resourceAPITemplate, "resource_api_template"
+	if gr.TypedHandlers {
+		tmplFile, tmplName = resourceTypedAPITemplate, "resource_typed_api_template"
+	}
+	if _, err := os.Stat(filename); err == nil && globPreserveRegions {
+		return gr.updateAPIFile(filename, directory, tmplFile, tmplName)
+	}
+	return commons.GenerateFile(gr, tmplFile, tmplName, filename, false)
 }
 
 // generate Go representation of server's resource.
@@ -37,7 +52,8 @@ func (gr *goResource) generateAPIFile(directory string) error {
 //		always regenerated
 // - API implementation
 //		implementation of the API interface.
-//		Don't generate if the file already exist
+//		Don't generate if the file already exist,
+//		only the stubs of the new handlers are added if the regions are preserved
 func (gr *goResource) generate(r *raml.Resource, URI, dir string) error {
 	gr.GenerateMethods(r, "go", newServerMethod, newGoClientMethod)
 	if err := gr.generateInterfaceFile(dir); err != nil {
@@ -50,8 +66,14 @@ func (gr *goResource) generate(r *raml.Resource, URI, dir string) error {
 // this resource interface file
 func (gr goResource) InterfaceImportPaths() []string {
 	ip := map[string]struct{}{
-		"net/http":               struct{}{},
-		"github.com/gorilla/mux": struct{}{},
+		"net/http": struct{}{},
+	}
+	if globRouter.ImportPath != "" {
+		ip[globRouter.ImportPath] = struct{}{}
+	}
+	// the echo routes are adapted by goraml
+	if globRouter.Name == RouterEcho {
+		ip[libImportPath(globRootImportPath, "goraml.EchoHandler")] = struct{}{}
 	}
 
 	for _, v := range gr.Methods {
@@ -66,6 +88,16 @@ func (gr goResource) InterfaceImportPaths() []string {
 				ip[lib] = struct{}{}
 			}
 		}
+
+		// parameters validator
+		if gm.ParamsVar != "" {
+			ip[libImportPath(globRootImportPath, "goraml.Param")] = struct{}{}
+		}
+		for _, p := range gm.Params {
+			if p.Pattern != "" {
+				ip["regexp"] = struct{}{}
+			}
+		}
 	}
 
 	// return sorted array for predictable order
@@ -73,6 +105,46 @@ func (gr goResource) InterfaceImportPaths() []string {
 	return sortImportPaths(ip)
 }
 
+// TypedInterfaceImportPaths returns all packages imported by
+// the typed interface file of this resource,
+// which also decodes the requests and encodes the responses
+func (gr goResource) TypedInterfaceImportPaths() []string {
+	ip := map[string]struct{}{
+		"context":  struct{}{},
+		"net/http": struct{}{},
+		libImportPath(globRootImportPath, "goraml.WriteError"): struct{}{},
+	}
+	for _, lib := range gr.InterfaceImportPaths() {
+		ip[lib] = struct{}{}
+	}
+
+	for _, v := range gr.Methods {
+		gm := v.(serverMethod)
+		for _, codec := range bodyCodecs(gm.Method, true) {
+			ip[codec] = struct{}{}
+		}
+		for lib := range gm.typedLibImported(globRootImportPath) {
+			ip[lib] = struct{}{}
+		}
+	}
+	return sortImportPaths(ip)
+}
+
+// TypedAPIImportPaths returns all packages that need to be imported
+// by the typed API implementation
+func (gr goResource) TypedAPIImportPaths() []string {
+	ip := map[string]struct{}{
+		"context": struct{}{},
+	}
+	for _, v := range gr.Methods {
+		gm := v.(serverMethod)
+		for lib := range gm.typedLibImported(globRootImportPath) {
+			ip[lib] = struct{}{}
+		}
+	}
+	return sortImportPaths(ip)
+}
+
 // APIImportPaths returns all packages that need to be imported
 // by the API implementation
 func (gr goResource) APILibImportPaths() []string {
@@ -83,8 +155,8 @@ func (gr goResource) APILibImportPaths() []string {
 	// methods
 	for _, v := range gr.Methods {
 		gm := v.(serverMethod)
-		if gm.RespBody != "" || gm.ReqBody != "" {
-			ip["encoding/json"] = struct{}{}
+		for _, codec := range bodyCodecs(gm.Method, gm.TypedResponses) {
+			ip[codec] = struct{}{}
 		}
 		for lib := range gm.libImported(globRootImportPath) {
 			ip[lib] = struct{}{}
@@ -96,6 +168,31 @@ func (gr goResource) APILibImportPaths() []string {
 	return sortImportPaths(ip)
 }
 
+// bodyCodecs returns the packages used to encode and decode
+// the request and response body of a method,
+// the form request bodies are decoded by the goraml package
+// and the typed responses encode themselves.
+func bodyCodecs(m *resource.Method, typedResponses bool) []string {
+	var codecs []string
+	for _, body := range []struct {
+		name    string
+		isXML   bool
+		noCodec bool
+	}{
+		{m.ReqBody, m.ReqBodyIsXML(), m.ReqBodyIsForm()},
+		{m.RespBody, m.RespBodyIsXML(), typedResponses},
+	} {
+		switch {
+		case body.name == "", body.noCodec:
+		case body.isXML:
+			codecs = append(codecs, "encoding/xml")
+		default:
+			codecs = append(codecs, "encoding/json")
+		}
+	}
+	return codecs
+}
+
 func sortImportPaths(ip map[string]struct{}) []string {
 	libs := []string{}
 	for k := range ip {
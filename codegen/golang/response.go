@@ -0,0 +1,170 @@
+package golang
+
+import (
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/codegen/commons"
+	"github.com/Jumpscale/go-raml/raml"
+)
+
+// methodResponses are the responses of a method,
+// which are generated as a type per status code
+type methodResponses struct {
+	Name        string // name of the type of all the responses
+	Endpoint    string // verb and endpoint of the method, e.g. `POST /users`
+	PackageName string
+	Responses   []response // sorted by status code
+}
+
+// response is the response of a method for a status code
+type response struct {
+	Name        string // struct name
+	Code        int
+	Description []string
+	Body        string // Go type of the body, empty if there is no body
+	BodyIsXML   bool
+	Headers     []responseHeader // sorted by name
+}
+
+// responseHeader is a typed header of a response
+type responseHeader struct {
+	Name     string // HTTP header name
+	Field    string // struct field name
+	Type     string // Go type
+	Required bool
+}
+
+// hasTypedResponses returns true if the responses of a method are generated as a type per status code,
+// i.e. if a response declares headers, or a body which isn't the single 2xx response body.
+// The methods which only have a single 2xx response body simply return this body.
+func hasTypedResponses(m *raml.Method) bool {
+	var bodies, successBodies int
+	for code, resp := range m.Responses {
+		if len(resp.Headers) > 0 {
+			return true
+		}
+		if bodyTypeName(resp.Bodies, "body") == "" {
+			continue
+		}
+		bodies++
+		if c := commons.AtoiOrPanic(string(code)); c >= 200 && c < 300 {
+			successBodies++
+		}
+	}
+	return bodies > 1 || bodies != successBodies
+}
+
+// newMethodResponses creates the responses of a method, prefix is the normalized name of the method,
+// e.g. `UsersIdGet`. It returns nil if the method doesn't have typed responses,
+// all the responses are typed for the typed handlers.
+func newMethodResponses(m *raml.Method, verb, endpoint, prefix, packageName string) *methodResponses {
+	if m == nil || len(m.Responses) == 0 || !(globTypedHandlers || hasTypedResponses(m)) {
+		return nil
+	}
+	mr := &methodResponses{
+		Name:        prefix + commons.RespSuffix,
+		Endpoint:    strings.ToUpper(verb) + " " + endpoint,
+		PackageName: packageName,
+	}
+	for code, resp := range m.Responses {
+		c := commons.AtoiOrPanic(string(code))
+		r := response{
+			Name:        responseName(prefix, c),
+			Code:        c,
+			Description: commons.ParseDescription(resp.Description),
+			Body:        bodyTypeName(resp.Bodies, responseBodyName(prefix, c)),
+			BodyIsXML:   resp.Bodies.IsXML(),
+		}
+		for name, h := range resp.Headers {
+			r.Headers = append(r.Headers, newResponseHeader(string(name), h))
+		}
+		sort.Slice(r.Headers, func(i, j int) bool {
+			return r.Headers[i].Name < r.Headers[j].Name
+		})
+		mr.Responses = append(mr.Responses, r)
+	}
+	sort.Slice(mr.Responses, func(i, j int) bool {
+		return mr.Responses[i].Code < mr.Responses[j].Code
+	})
+	return mr
+}
+
+func newResponseHeader(name string, h raml.Header) responseHeader {
+	return responseHeader{
+		Name:     name,
+		Field:    fieldName(name),
+		Type:     convertToGoType(raml.NamedParameter(h).TypeExpr()),
+		Required: h.Required,
+	}
+}
+
+// responseName returns the name of the response type of a status code
+func responseName(prefix string, code int) string {
+	return prefix + strconv.Itoa(code) + commons.RespSuffix
+}
+
+// responseBodyName returns the name of the struct of an inline response body of a status code
+func responseBodyName(prefix string, code int) string {
+	return prefix + strconv.Itoa(code) + commons.RespBodySuffix
+}
+
+// Default returns the response of the first 2xx status code,
+// or of the first status code if there is no 2xx status code
+func (mr methodResponses) Default() response {
+	for _, r := range mr.Responses {
+		if r.Code >= 200 && r.Code < 300 {
+			return r
+		}
+	}
+	return mr.Responses[0]
+}
+
+// DefaultResponse returns the name of the default response
+func (mr methodResponses) DefaultResponse() string {
+	return mr.Default().Name
+}
+
+// HelperPkg returns the package of the header helpers, with the trailing dot
+func (mr methodResponses) HelperPkg() string {
+	if globGoramlPkgDir == "" {
+		return ""
+	}
+	return globGoramlPkgDir + "."
+}
+
+// ImportPaths returns all packages imported by the responses file
+func (mr methodResponses) ImportPaths() []string {
+	ip := map[string]struct{}{
+		"errors":   struct{}{},
+		"net/http": struct{}{},
+	}
+	for _, r := range mr.Responses {
+		switch {
+		case r.Body == "":
+		case r.BodyIsXML:
+			ip["encoding/xml"] = struct{}{}
+		default:
+			ip["encoding/json"] = struct{}{}
+		}
+		if lib := libImportPath(globRootImportPath, r.Body); lib != "" {
+			ip[lib] = struct{}{}
+		}
+		for _, h := range r.Headers {
+			if lib := libImportPath(globRootImportPath, h.Type); lib != "" {
+				ip[lib] = struct{}{}
+			}
+		}
+		if len(r.Headers) > 0 && globGoramlPkgDir != "" {
+			ip[libImportPath(globRootImportPath, "goraml.SetHeader")] = struct{}{}
+		}
+	}
+	return sortImportPaths(ip)
+}
+
+func (mr methodResponses) generate(dir string) error {
+	fileName := filepath.Join(dir, mr.Name+".go")
+	return commons.GenerateFile(mr, "./templates/response_go.tmpl", "response_go", fileName, true)
+}
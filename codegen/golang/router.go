@@ -0,0 +1,130 @@
+package golang
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/codegen/commons"
+)
+
+// routers of the generated server
+const (
+	RouterGorilla = "gorilla" // github.com/gorilla/mux
+	RouterStdlib  = "stdlib"  // net/http ServeMux, with the method and wildcard patterns of Go 1.22
+	RouterChi     = "chi"     // github.com/go-chi/chi
+	RouterEcho    = "echo"    // github.com/labstack/echo
+)
+
+// router is the router of the generated server
+type router struct {
+	Name       string
+	ImportPath string // package of the router, empty for the standard library
+	Type       string // type of the router given to the routes functions
+}
+
+var routers = map[string]router{
+	RouterGorilla: {RouterGorilla, "github.com/gorilla/mux", "*mux.Router"},
+	RouterStdlib:  {RouterStdlib, "", "*http.ServeMux"},
+	RouterChi:     {RouterChi, "github.com/go-chi/chi/v5", "chi.Router"},
+	RouterEcho:    {RouterEcho, "github.com/labstack/echo/v4", "*echo.Echo"},
+}
+
+// Routers returns the names of the supported routers
+func Routers() []string {
+	return []string{RouterGorilla, RouterStdlib, RouterChi, RouterEcho}
+}
+
+// newRouter returns the router of the given name, the default router is gorilla
+func newRouter(name string) (router, error) {
+	if name == "" {
+		name = RouterGorilla
+	}
+	r, ok := routers[name]
+	if !ok {
+		return r, fmt.Errorf("invalid router `%v`, must be one of %v", name, strings.Join(Routers(), ", "))
+	}
+	return r, nil
+}
+
+// URIParamsFunc returns the expression of the function which returns the URI parameters of a request
+func (gm serverMethod) URIParamsFunc() string {
+	if globRouter.Name == RouterGorilla {
+		return "mux.Vars"
+	}
+	var names []string
+	for _, p := range reURIParam.FindAllString(gm.Endpoint, -1) {
+		names = append(names, strconv.Quote(p[1:len(p)-1]))
+	}
+	return "goraml.URIParams(" + strings.Join(names, ", ") + ")"
+}
+
+// Route returns the statement which routes the requests of the method to its handler,
+// the handler is wrapped by the parameters validator and the middlewares of the method
+func (gm serverMethod) Route() string {
+	fn := "i." + gm.MethodName
+	h := "http.HandlerFunc(" + fn + ")"
+	if globTypedHandlers {
+		fn = gm.HandlerName + "(i)"
+		h = fn
+	}
+	h = gm.Handler(h)
+	if gm.Middlewares != "" {
+		h = fmt.Sprintf("alice.New(%v).Then(%v)", gm.Middlewares, h)
+	}
+	plain := gm.Middlewares == "" && gm.ParamsVar == "" // fn isn't wrapped
+
+	endpoint, verb := gm.Endpoint, gm.Verb()
+	switch globRouter.Name {
+	case RouterStdlib:
+		pattern := strconv.Quote(verb + " " + reURIParam.ReplaceAllStringFunc(endpoint, stdlibWildcard))
+		if plain {
+			return fmt.Sprintf("r.HandleFunc(%v, %v)", pattern, fn)
+		}
+		return fmt.Sprintf("r.Handle(%v, %v)", pattern, h)
+	case RouterChi:
+		if plain {
+			return fmt.Sprintf("r.MethodFunc(%q, %q, %v)", verb, endpoint, fn)
+		}
+		return fmt.Sprintf("r.Method(%q, %q, %v)", verb, endpoint, h)
+	case RouterEcho:
+		path := reURIParam.ReplaceAllStringFunc(endpoint, func(p string) string {
+			return ":" + p[1:len(p)-1]
+		})
+		return fmt.Sprintf("r.Add(%q, %q, goraml.EchoHandler(%v))", verb, path, h)
+	default:
+		if plain {
+			return fmt.Sprintf("r.HandleFunc(%q, %v).Methods(%q)", endpoint, fn, verb)
+		}
+		return fmt.Sprintf("r.Handle(%q, %v).Methods(%q)", endpoint, h, verb)
+	}
+}
+
+// RouterType returns the type of the router given to the routes function of the resource
+func (gr goResource) RouterType() string {
+	return globRouter.Type
+}
+
+// stdlibWildcard returns the ServeMux wildcard of an URI parameter, e.g. `{id}`,
+// the wildcard names must be Go identifiers, see URIParams of goraml
+func stdlibWildcard(param string) string {
+	return "{" + regNonIdentifier.ReplaceAllString(param[1:len(param)-1], "_") + "}"
+}
+
+// generateRouterHelper generates the helper which gives the URI parameters
+// of the requests to the parameters validator and the typed handlers,
+// gorilla has mux.Vars
+func generateRouterHelper(packageName, dir string) error {
+	if globRouter.Name == RouterGorilla {
+		return nil
+	}
+	ctx := struct {
+		PackageName string
+		Router      string
+	}{
+		PackageName: packageName,
+		Router:      globRouter.Name,
+	}
+	return commons.GenerateFile(ctx, "./templates/router_go.tmpl", "router_go", filepath.Join(dir, "router.go"), true)
+}
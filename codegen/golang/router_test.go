@@ -0,0 +1,79 @@
+package golang
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRouters(t *testing.T) {
+	Convey("Server routers", t, func() {
+		var apiDef raml.APIDefinition
+
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		err = raml.ParseFile("../fixtures/params/api.raml", &apiDef)
+		So(err, ShouldBeNil)
+
+		rootFixture := "../fixtures/routers"
+		for _, router := range []string{RouterStdlib, RouterChi, RouterEcho} {
+			Convey(router, func() {
+				server := NewServer(&apiDef, "main", "apidocs", "examples.com/routers", true, false, router)
+				err = server.Generate(targetDir)
+				So(err, ShouldBeNil)
+
+				checks := []struct {
+					Result   string
+					Expected string
+				}{
+					{"main.go", router + "/main.txt"},
+					{"users_if.go", router + "/users_if.txt"},
+					{"goraml/router.go", router + "/router.txt"},
+				}
+				for _, check := range checks {
+					s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+					So(err, ShouldBeNil)
+
+					tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+					So(err, ShouldBeNil)
+
+					So(s, ShouldEqual, tmpl)
+				}
+			})
+		}
+
+		Convey("typed handlers", func() {
+			server := NewServer(&apiDef, "main", "apidocs", "examples.com/routers", true, true, RouterStdlib)
+			err = server.Generate(targetDir)
+			So(err, ShouldBeNil)
+
+			s, err := testLoadFile(filepath.Join(targetDir, "users_if.go"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile(filepath.Join(rootFixture, "stdlib/typed_users_if.txt"))
+			So(err, ShouldBeNil)
+
+			So(s, ShouldEqual, tmpl)
+		})
+
+		Convey("invalid router", func() {
+			server := NewServer(&apiDef, "main", "apidocs", "examples.com/routers", true, false, "martini")
+			err = server.Generate(targetDir)
+			So(err, ShouldNotBeNil)
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+			globRouter = routers[RouterGorilla]
+			globTypedHandlers = false
+			globRootImportPath = ""
+			globGoramlPkgDir = ""
+		})
+	})
+}
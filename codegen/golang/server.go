@@ -18,6 +18,15 @@ var (
 
 	// global value of API definition
 	globAPIDef *raml.APIDefinition
+
+	// generate typed handler interfaces for the server resources
+	globTypedHandlers bool
+
+	// router of the server resources
+	globRouter = routers[RouterGorilla]
+
+	// update the existing API implementation files instead of keeping them as is
+	globPreserveRegions bool
 )
 
 // Server represents a Go server
@@ -29,13 +38,23 @@ type Server struct {
 	APIDocsDir     string // apidocs directory. apidocs won't be generated if it is empty
 	withMain       bool
 	RootImportPath string
+	TypedHandlers  bool   // generate typed handler interfaces instead of http.HandlerFunc
+	Router         string // router of the server: gorilla, stdlib, chi or echo
+
+	// PreserveRegions appends the stubs of the new handlers to the existing API implementation files,
+	// they are not modified otherwise
+	PreserveRegions bool
 }
 
-// NewServer creates a new Golang server
-func NewServer(apiDef *raml.APIDefinition, packageName, apiDocsDir, rootImportPath string, withMain bool) Server {
+// NewServer creates a new Golang server.
+// If typedHandlers is true, the handlers of the API implementation take typed parameters
+// and return typed responses, the generated code decodes, validates and encodes them.
+// router is the router of the server, gorilla if empty.
+func NewServer(apiDef *raml.APIDefinition, packageName, apiDocsDir, rootImportPath string, withMain, typedHandlers bool, router string) Server {
 	// global variables
 	globAPIDef = apiDef
 	globRootImportPath = rootImportPath
+	globTypedHandlers = typedHandlers
 
 	return Server{
 		apiDef:         apiDef,
@@ -44,11 +63,21 @@ func NewServer(apiDef *raml.APIDefinition, packageName, apiDocsDir, rootImportPa
 		APIDocsDir:     apiDocsDir,
 		withMain:       withMain,
 		RootImportPath: rootImportPath,
+		TypedHandlers:  typedHandlers,
+		Router:         router,
 	}
 }
 
 // Generate generates all Go server files
 func (gs Server) Generate(dir string) error {
+	r, err := newRouter(gs.Router)
+	if err != nil {
+		return err
+	}
+	globRouter = r
+	gs.Router = r.Name
+	globPreserveRegions = gs.PreserveRegions
+
 	// helper package
 	gh := goramlHelper{
 		rootImportPath: gs.RootImportPath,
@@ -59,6 +88,16 @@ func (gs Server) Generate(dir string) error {
 		return err
 	}
 
+	// query string, header and form decoders, used by the handlers
+	if err := generateRequestDecoders(gh.packageName, filepath.Join(dir, gh.packageDir)); err != nil {
+		return err
+	}
+
+	// URI parameters of the routers other than gorilla
+	if err := generateRouterHelper(gh.packageName, filepath.Join(dir, gh.packageDir)); err != nil {
+		return err
+	}
+
 	// generate all Type structs
 	if err := generateStructs(gs.apiDef.Types, dir, gs.PackageName); err != nil {
 		return err
@@ -28,7 +28,10 @@ func generateServerResources(apiDef *raml.APIDefinition, directory, packageName
 		r := rs[k]
 		rd := resource.New(apiDef, k, packageName)
 		rd.IsServer = true
-		gr := goResource{Resource: &rd}
+		gr := goResource{
+			Resource:      &rd,
+			TypedHandlers: globTypedHandlers,
+		}
 		err = gr.generate(&r, k, directory)
 		if err != nil {
 			return rds, err
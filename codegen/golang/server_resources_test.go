@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/Jumpscale/go-raml/raml"
@@ -49,6 +50,12 @@ func TestResource(t *testing.T) {
 			err := raml.ParseFile("../fixtures/server_resources/usergroups.raml", apiDef)
 			So(err, ShouldBeNil)
 
+			// the error writer is imported from the goraml package
+			globRootImportPath = "examples.com/usergroups"
+			defer func() {
+				globRootImportPath = ""
+			}()
+
 			_, err = generateServerResources(apiDef, targetdir, "main")
 			So(err, ShouldBeNil)
 
@@ -70,6 +77,192 @@ func TestResource(t *testing.T) {
 
 		})
 
+		Convey("resource with XML bodies", func() {
+			err := raml.ParseFile("../fixtures/struct/xml/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// the error writer is imported from the goraml package
+			globRootImportPath = "examples.com/xml"
+			defer func() {
+				globRootImportPath = ""
+			}()
+
+			_, err = generateServerResources(apiDef, targetdir, "main")
+			So(err, ShouldBeNil)
+
+			for _, name := range []string{"books_api", "orders_api"} {
+				s, err := testLoadFile(filepath.Join(targetdir, name+".go"))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile("../fixtures/server_resources/" + name + ".txt")
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("resource with query strings", func() {
+			err := raml.ParseFile("../fixtures/query_string/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// the query string decoder is imported from the goraml package
+			globRootImportPath = "examples.com/places"
+			defer func() {
+				globRootImportPath = ""
+			}()
+
+			_, err = generateServerResources(apiDef, targetdir, "main")
+			So(err, ShouldBeNil)
+
+			for _, name := range []string{"places_api", "events_api"} {
+				s, err := testLoadFile(filepath.Join(targetdir, name+".go"))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile("../fixtures/server_resources/" + name + ".txt")
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("resource with form bodies", func() {
+			err := raml.ParseFile("../fixtures/media_types/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// the form decoder is imported from the goraml package
+			globRootImportPath = "examples.com/avatars"
+			defer func() {
+				globRootImportPath = ""
+			}()
+
+			_, err = generateServerResources(apiDef, targetdir, "main")
+			So(err, ShouldBeNil)
+
+			s, err := testLoadFile(filepath.Join(targetdir, "avatars_api.go"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("../fixtures/server_resources/avatars_api.txt")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+		})
+
+		Convey("resource with typed responses", func() {
+			err := raml.ParseFile("../fixtures/responses/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// the error writer is imported from the goraml package
+			globRootImportPath = "examples.com/responses"
+			defer func() {
+				globRootImportPath = ""
+			}()
+
+			_, err = generateServerResources(apiDef, targetdir, "main")
+			So(err, ShouldBeNil)
+
+			s, err := testLoadFile(filepath.Join(targetdir, "users_api.go"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("../fixtures/responses/users_api.txt")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+		})
+
+		Convey("resource with typed handlers", func() {
+			err := raml.ParseFile("../fixtures/typed_handlers/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			globTypedHandlers, globRootImportPath = true, "examples.com/typed"
+			defer func() {
+				globTypedHandlers, globRootImportPath = false, ""
+			}()
+
+			err = generateBodyStructs(apiDef, targetdir, "main")
+			So(err, ShouldBeNil)
+
+			_, err = generateServerResources(apiDef, targetdir, "main")
+			So(err, ShouldBeNil)
+
+			rootFixture := "../fixtures/typed_handlers"
+			files := []string{
+				"users_if.go",        // typed interface and decoding
+				"users_api.go",       // typed handlers
+				"UsersGetHeaders.go", // request headers
+				"UsersIdGetResp.go",  // responses
+			}
+			for _, f := range files {
+				s, err := testLoadFile(filepath.Join(targetdir, f))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, strings.TrimSuffix(f, ".go")+".txt"))
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("resource with validated request parameters", func() {
+			err := raml.ParseFile("../fixtures/params/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			globRootImportPath = "examples.com/params"
+			defer func() {
+				globRootImportPath = ""
+			}()
+
+			err = generateBodyStructs(apiDef, targetdir, "main")
+			So(err, ShouldBeNil)
+
+			_, err = generateServerResources(apiDef, targetdir, "main")
+			So(err, ShouldBeNil)
+
+			rootFixture := "../fixtures/params"
+			files := []string{
+				"users_if.go",            // validated parameters
+				"UsersGetQueryString.go", // facets validated by goraml.ValidateParams
+			}
+			for _, f := range files {
+				s, err := testLoadFile(filepath.Join(targetdir, f))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, strings.TrimSuffix(f, ".go")+".txt"))
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("regenerated resource", func() {
+			err := raml.ParseFile("../fixtures/regeneration/api_v1.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// the error writer is imported from the goraml package
+			globRootImportPath, globPreserveRegions = "examples.com/regeneration", true
+			defer func() {
+				globRootImportPath, globPreserveRegions = "", false
+			}()
+
+			_, err = generateServerResources(apiDef, targetdir, "main")
+			So(err, ShouldBeNil)
+
+			// implement the handlers
+			filename := filepath.Join(targetdir, "users_api.go")
+			s, err := testLoadFile(filename)
+			So(err, ShouldBeNil)
+			s = strings.Replace(s, "// uncomment below line to add header", "// implemented", -1)
+			So(ioutil.WriteFile(filename, []byte(s), 0644), ShouldBeNil)
+
+			// the PUT handler is added, the others are kept
+			apiDef = new(raml.APIDefinition)
+			err = raml.ParseFile("../fixtures/regeneration/api_v2.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			_, err = generateServerResources(apiDef, targetdir, "main")
+			So(err, ShouldBeNil)
+
+			s, err = testLoadFile(filename)
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("../fixtures/regeneration/users_api.txt")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+		})
+
 		Reset(func() {
 			os.RemoveAll(targetdir)
 		})
@@ -0,0 +1,57 @@
+package golang
+
+import (
+	"go/build"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Jumpscale/go-raml/raml"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestServerBuild(t *testing.T) {
+	Convey("generated server compiles", t, func() {
+		goCmd, err := exec.LookPath("go")
+		if err != nil {
+			SkipSo(err, ShouldBeNil)
+			return
+		}
+
+		// the server is generated inside this package,
+		// so it is built with the vendored dependencies
+		targetDir, err := ioutil.TempDir(".", "testserver")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(targetDir)
+
+		importPath := reflect.TypeOf(Server{}).PkgPath() + "/" + filepath.Base(targetDir)
+		if _, err := build.Import(importPath, "", build.FindOnly); err != nil { // not in GOPATH
+			SkipSo(err, ShouldBeNil)
+			return
+		}
+
+		// the generator keeps it's state in global variables
+		defer func(rootImportPath, goramlPkgDir string, apiDef *raml.APIDefinition, typedHandlers bool, r router) {
+			globRootImportPath, globGoramlPkgDir, globAPIDef, globTypedHandlers, globRouter = rootImportPath, goramlPkgDir, apiDef, typedHandlers, r
+		}(globRootImportPath, globGoramlPkgDir, globAPIDef, globTypedHandlers, globRouter)
+
+		Convey("facets, pattern properties and inline objects", func() {
+			apiDef := new(raml.APIDefinition)
+			err := raml.ParseFile("../../raml/samples/facets/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			server := NewServer(apiDef, "main", "", importPath, true, false, "")
+			err = server.Generate(targetDir)
+			So(err, ShouldBeNil)
+
+			cmd := exec.Command(goCmd, "build", "-o", os.DevNull, importPath, importPath+"/goraml")
+			cmd.Env = append(os.Environ(), "GO111MODULE=off")
+			out, err := cmd.CombinedOutput()
+			So(string(out), ShouldEqual, "")
+			So(err, ShouldBeNil)
+		})
+	})
+}
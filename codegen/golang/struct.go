@@ -1,8 +1,9 @@
 package golang
 
 import (
-	"encoding/json"
+	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/Jumpscale/go-raml/codegen/commons"
@@ -24,6 +25,23 @@ type structDef struct {
 	Fields      map[string]fieldDef // all struct's fields
 	OneLineDef  string              // not empty if this struct can be defined in one line
 	Enum        *enum
+	XMLName     string // value of the `xml` struct tag of XMLName field, empty if it has no such field
+
+	// true if it is an XML document declared using an XML schema,
+	// which is kept as is in the struct
+	IsXMLDocument bool
+
+	// regular expressions of the pattern properties,
+	// the properties matching them are kept in the AdditionalProperties field
+	PropertyPatterns []string
+
+	// Go type of the values of the AdditionalProperties field,
+	// empty if the struct has no such field
+	AdditionalPropertiesType string
+
+	// true if the properties which are not declared are rejected when decoding JSON,
+	// i.e. `additionalProperties: false`
+	Strict bool
 
 	Validators []string
 }
@@ -39,6 +57,9 @@ func newStructDef(name, packageName, description string, properties map[string]i
 	fields := make(map[string]fieldDef)
 	for k, v := range properties {
 		prop := raml.ToProperty(k, v)
+		if prop.IsPattern() {
+			continue
+		}
 		fields[prop.Name] = newFieldDef(name, prop, packageName)
 	}
 	return structDef{
@@ -54,51 +75,70 @@ func newStructDefFromType(t raml.Type, sName, packageName string) structDef {
 	sd := newStructDef(sName, packageName, t.Description, t.Properties)
 	sd.T = t
 
+	if _, ok := t.XMLSchema(); ok {
+		sd.IsXMLDocument = true
+		return sd
+	}
+
+	sd.buildXMLName()
+
 	// handle advanced type on raml1.0
 	sd.handleAdvancedType()
 
 	return sd
 }
 
-// create struct definition from RAML Body node
-func newStructDefFromBody(body *raml.Bodies, structNamePrefix, packageName string, isGenerateRequest bool) structDef {
-	// set struct name based on request or response
-	structName := structNamePrefix + commons.RespBodySuffix
+// create struct definition of an XML document declared using an XML schema
+func newXMLDocumentStructDef(name, packageName string) structDef {
+	sd := newStructDef(name, packageName, "", nil)
+	sd.IsXMLDocument = true
+	return sd
+}
+
+// buildXMLName builds the XML element name of the struct from the xml facet of the type,
+// the default name is the struct name.
+func (sd *structDef) buildXMLName() {
+	x := sd.T.XML
+	if x == nil || (x.Name == "" && x.Namespace == "") {
+		return
+	}
+	name := sd.Name
+	if x.Name != "" {
+		name = x.Name
+	}
+	if x.Namespace != "" {
+		name = x.Namespace + " " + name
+	}
+	sd.XMLName = name
+}
+
+// create struct definition from the properties of RAML Body node
+func newStructDefFromBody(properties map[string]interface{}, structNamePrefix, packageName string, isGenerateRequest bool) structDef {
+	return newStructDef(bodyStructName(structNamePrefix, isGenerateRequest), packageName, "", properties)
+}
+
+// name of the request/response body struct
+func bodyStructName(structNamePrefix string, isGenerateRequest bool) string {
 	if isGenerateRequest {
-		structName = structNamePrefix + commons.ReqBodySuffix
-	}
-
-	// handle JSON string type
-	// for example:
-	// application/json: # media type
-	//		type: | # structural definition of a response (schema or type)
-	//			{
-	//				"title": "Hello world Response",
-	//				"type": "object",
-	//					"properties": {
-	//					"message": {
-	//						"type": "string"
-	//						}
-	//					}
-	//				}
-	if body.ApplicationJSON.Type != "" {
-		var t raml.Type
-		if err := json.Unmarshal([]byte(body.ApplicationJSON.Type), &t); err == nil {
-			return newStructDefFromType(t, structName, packageName)
-		}
+		return structNamePrefix + commons.ReqBodySuffix
 	}
-	return newStructDef(structName, packageName, "", body.ApplicationJSON.Properties)
+	return structNamePrefix + commons.RespBodySuffix
 }
 
 // generate Go struct
 func (sd structDef) generate(dir string) error {
-	// generate enums
+	// generate enums and structs of the fields
 	for _, f := range sd.Fields {
 		if f.Enum != nil {
 			if err := f.Enum.generate(dir); err != nil {
 				return err
 			}
 		}
+		if f.Struct != nil {
+			if err := f.Struct.generate(dir); err != nil {
+				return err
+			}
+		}
 	}
 	if sd.Enum != nil {
 		return sd.Enum.generate(dir)
@@ -109,8 +149,14 @@ func (sd structDef) generate(dir string) error {
 
 // generate all structs from an RAML api definition
 func generateStructs(types map[string]raml.Type, dir, packageName string) error {
+	// the types declared using JSON schema
+	types, err := raml.ExpandJSONSchemaTypes(types)
+	if err != nil {
+		return err
+	}
 	for name, t := range types {
 		sd := newStructDefFromType(t, name, packageName)
+		sd.buildAdditionalProperties(types)
 		if err := sd.generate(dir); err != nil {
 			return err
 		}
@@ -129,8 +175,23 @@ func (sd structDef) ImportPaths() map[string]struct{} {
 	if sd.OneLineDef == "" {
 		ip["gopkg.in/validator.v2"] = struct{}{}
 	}
+	if sd.XMLName != "" || sd.IsXMLDocument {
+		ip["encoding/xml"] = struct{}{}
+	}
+	if sd.HasJSONMethods() {
+		ip["encoding/json"] = struct{}{}
+	}
+	if len(sd.PropertyPatterns) > 0 {
+		ip["regexp"] = struct{}{}
+	}
 
-	// libraries
+	// libraries and goraml package, of the fields and of the one line definition,
+	// e.g. `type CustomDate goraml.DateOnly`
+	if sd.OneLineDef != "" {
+		if lib := libImportPath(globRootImportPath, sd.oneLineType()); lib != "" {
+			ip[lib] = struct{}{}
+		}
+	}
 	for _, fd := range sd.Fields {
 		if lib := libImportPath(globRootImportPath, fd.Type); lib != "" {
 			ip[lib] = struct{}{}
@@ -139,6 +200,11 @@ func (sd structDef) ImportPaths() map[string]struct{} {
 	return ip
 }
 
+// oneLineType returns the Go type of the one line definition
+func (sd structDef) oneLineType() string {
+	return strings.TrimPrefix(sd.OneLineDef, "type "+sd.Name+" ")
+}
+
 // handle advance type type into structField
 // example:
 //   Mammal:
@@ -152,26 +218,63 @@ func (sd *structDef) handleAdvancedType() {
 		sd.T.Type = "object"
 	}
 
-	strType := commons.InterfaceToString(sd.T.Type)
+	te, err := sd.T.TypeExpr()
+	if err != nil { // not a valid type expression, use it as is
+		sd.buildOneLine(commons.NormalizePkgName(commons.InterfaceToString(sd.T.Type)))
+		return
+	}
 
 	switch {
-	case len(strings.Split(strType, ",")) > 1: //multiple inheritance
-		sd.addMultipleInheritance(strType)
-	case sd.T.IsUnion():
-		sd.buildUnion()
-	case sd.T.IsArray(): // arary type
-		sd.buildArray()
-	case strings.ToLower(strType) == "object": // plain type
+	case te.Kind == raml.TypeExprInline: // inline type declaration
+		sd.addInlineType(te.Decl)
+	case te.Kind == raml.TypeExprInheritance: //multiple inheritance
+		sd.addMultipleInheritance(te.Members)
+	case te.Kind == raml.TypeExprUnion:
+		sd.buildUnion(te)
+	case te.Kind == raml.TypeExprArray || te.Kind == raml.TypeExprMap: // arary type
+		sd.buildArray(te)
+	case strings.ToLower(te.Name) == "object": // plain type
 		return
 	case sd.T.IsEnum(): // enum
 		sd.buildEnum()
-	case strType != "" && len(sd.T.Properties) == 0: // type alias
-		sd.buildTypeAlias()
+	case len(sd.T.Properties) == 0: // type alias
+		sd.buildTypeAlias(te)
 	default: // single inheritance
-		sd.addSingleInheritance(strType)
+		sd.addSingleInheritance(te.Name)
 	}
 }
 
+// add the properties of an inline type declaration
+// and use it's type as type of this struct
+// example:
+//   Mammal:
+//     type:
+//       type: Animal
+//       properties:
+//         legs: integer
+func (sd *structDef) addInlineType(decl *raml.Type) {
+	for k, v := range decl.Properties {
+		prop := raml.ToProperty(k, v)
+		if prop.IsPattern() {
+			continue
+		}
+		if _, ok := sd.Fields[prop.Name]; !ok {
+			sd.Fields[prop.Name] = newFieldDef(sd.Name, prop, sd.PackageName)
+		}
+	}
+	if len(decl.Properties) > 0 && len(sd.T.Properties) == 0 {
+		sd.T.Properties = decl.Properties
+	}
+	if sd.T.Enum == nil {
+		sd.T.Enum = decl.Enum
+	}
+	if sd.T.Items == nil {
+		sd.T.Items = decl.Items
+	}
+	sd.T.Type = decl.Type
+	sd.handleAdvancedType()
+}
+
 // add single inheritance
 // inheritance is implemented as composition
 // spec : http://docs.raml.org/specs/1.0/#raml-10-spec-inheritance-and-specialization
@@ -193,11 +296,13 @@ func (sd *structDef) addSingleInheritance(strType string) {
 //			type: string
 // The additional fielddef would be a composition of Animal & Cat
 // http://docs.raml.org/specs/1.0/#raml-10-spec-multiple-inheritance
-func (sd *structDef) addMultipleInheritance(strType string) {
-	for _, s := range strings.Split(strType, ",") {
-		fieldType := strings.TrimSpace(s)
+func (sd *structDef) addMultipleInheritance(parents []*raml.TypeExpr) {
+	for _, parent := range parents {
+		if parent.Kind != raml.TypeExprName {
+			continue
+		}
 		fd := fieldDef{
-			Name:          fieldType,
+			Name:          parent.Name,
 			IsComposition: true,
 		}
 
@@ -214,25 +319,156 @@ func (sd *structDef) buildEnum() {
 // build array type
 // spec http://docs.raml.org/specs/1.0/#raml-10-spec-array-types
 // example result  `type TypeName []something`
-func (sd *structDef) buildArray() {
-	sd.buildOneLine(convertToGoType(sd.T.Type.(string)))
+func (sd *structDef) buildArray(te *raml.TypeExpr) {
+	sd.buildOneLine(typeExprToGo(te))
 }
 
 // build union type
 // union type is implemented as `interface{}`
 // example result `type sometype interface{}`
-func (sd *structDef) buildUnion() {
-	sd.buildOneLine(convertUnion(sd.T.Type.(string)))
+func (sd *structDef) buildUnion(te *raml.TypeExpr) {
+	sd.buildOneLine(typeExprToGo(te))
 }
 
-func (sd *structDef) buildTypeAlias() {
-	sd.buildOneLine(convertToGoType(sd.T.Type.(string)))
+func (sd *structDef) buildTypeAlias(te *raml.TypeExpr) {
+	sd.buildOneLine(typeExprToGo(te))
 }
 
 func (sd *structDef) buildOneLine(tipe string) {
 	sd.OneLineDef = "type " + sd.Name + " " + tipe
 }
 
+// buildAdditionalProperties handles the pattern properties and the `additionalProperties` facet
+// of an object type, both are inherited from the parent types declared in types.
+// example:
+//   Extensible:
+//     additionalProperties: false
+//     properties:
+//       name: string
+//       /^x-/: string
+// The properties matching `^x-` are kept in the `AdditionalProperties map[string]string` field,
+// the other unknown properties are rejected by UnmarshalJSON.
+// The fields of the parent types are copied into such a struct instead of being embedded,
+// otherwise the JSON methods of the parents would be promoted to it.
+func (sd *structDef) buildAdditionalProperties(types map[string]raml.Type) {
+	if sd.OneLineDef != "" || sd.Enum != nil || sd.IsXMLDocument {
+		return
+	}
+	props, parents, allowed := objectProperties(sd.T, types, 0)
+
+	var patterns []raml.Property
+	for name, v := range props {
+		if prop := raml.ToProperty(name, v); prop.IsPattern() {
+			patterns = append(patterns, prop)
+		}
+	}
+	sort.Slice(patterns, func(i, j int) bool { return patterns[i].Name < patterns[j].Name })
+	sd.Strict = allowed != nil && !*allowed
+	if len(patterns) == 0 && !sd.Strict {
+		return
+	}
+
+	// flatten the parent types
+	for _, parent := range parents {
+		delete(sd.Fields, parent)
+	}
+	for name, v := range props {
+		prop := raml.ToProperty(name, v)
+		if _, ok := sd.Fields[prop.Name]; ok || prop.IsPattern() {
+			continue
+		}
+		sd.Fields[prop.Name] = newFieldDef(sd.Name, prop, sd.PackageName)
+	}
+
+	if len(patterns) == 0 {
+		return
+	}
+	sd.AdditionalPropertiesType = convertToGoType(patterns[0].Type)
+	for _, prop := range patterns {
+		sd.PropertyPatterns = append(sd.PropertyPatterns, prop.NamePattern())
+		if convertToGoType(prop.Type) != sd.AdditionalPropertiesType {
+			sd.AdditionalPropertiesType = "interface{}"
+		}
+	}
+}
+
+// objectProperties returns the properties of an object type merged with the ones
+// of it's parent types declared in types, the names of these parents,
+// and the nearest declared value of the `additionalProperties` facet.
+func objectProperties(t raml.Type, types map[string]raml.Type, depth int) (map[string]interface{}, []string, *bool) {
+	props := map[string]interface{}{}
+	for k, v := range t.Properties {
+		props[k] = v
+	}
+	allowed := t.AdditionalProperties
+	if depth > 10 { // recursive inheritance
+		return props, nil, allowed
+	}
+
+	te, err := t.TypeExpr()
+	if err != nil {
+		return props, nil, allowed
+	}
+	var parentTypes []raml.Type
+	var parents []string
+	switch te.Kind {
+	case raml.TypeExprInline:
+		parentTypes = append(parentTypes, *te.Decl)
+	case raml.TypeExprName, raml.TypeExprInheritance:
+		members := te.Members
+		if te.Kind == raml.TypeExprName {
+			members = []*raml.TypeExpr{te}
+		}
+		for _, m := range members {
+			if m.Kind != raml.TypeExprName {
+				continue
+			}
+			if parent, ok := types[m.Name]; ok {
+				parentTypes = append(parentTypes, parent)
+				parents = append(parents, m.Name)
+			}
+		}
+	}
+
+	for _, parent := range parentTypes {
+		parentProps, grandParents, parentAllowed := objectProperties(parent, types, depth+1)
+		for k, v := range parentProps {
+			if _, ok := props[k]; !ok {
+				props[k] = v
+			}
+		}
+		parents = append(parents, grandParents...)
+		if allowed == nil {
+			allowed = parentAllowed
+		}
+	}
+	return props, parents, allowed
+}
+
+// HasJSONMethods returns true if the struct has it's own
+// UnmarshalJSON and MarshalJSON methods
+func (sd structDef) HasJSONMethods() bool {
+	return len(sd.PropertyPatterns) > 0 || sd.Strict
+}
+
+// PropertyPatternsVar returns the name of the variable holding the compiled pattern properties
+func (sd structDef) PropertyPatternsVar() string {
+	return strings.ToLower(sd.Name[:1]) + sd.Name[1:] + "PropertyPatterns"
+}
+
+// DeclaredJSONNames returns the quoted JSON names of the declared properties,
+// to be used in a `case` clause
+func (sd structDef) DeclaredJSONNames() string {
+	var names []string
+	for name, fd := range sd.Fields {
+		if !fd.IsComposition {
+			names = append(names, fmt.Sprintf("%q", name))
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
 // generate input validator helper file
 func generateInputValidator(packageName, dir string) error {
 	var ctx = struct {
@@ -253,6 +489,11 @@ func (sd structDef) needFmt() bool {
 		return true
 	}
 
+	// unknown properties error
+	if sd.Strict {
+		return true
+	}
+
 	// unique items
 	for _, f := range sd.Fields {
 		if f.UniqueItems {
@@ -33,14 +33,17 @@ func TestGenerateStructFromRaml(t *testing.T) {
 				{"MultipleInheritance.go", "multipleinheritance.txt"},
 				{"ArrayOfCats.go", "arrayofcats.txt"},
 				{"BidimensionalArrayOfCats.go", "bidimensionalarrayofcats.txt"},
-				{"petshop.go", "petshop.txt"},                   // using map type & testing case sensitive type name
-				{"Pet.go", "Pet.txt"},                           // Union
-				{"ArrayOfPets.go", "ArrayOfPets.txt"},           // Array of union
-				{"Specialization.go", "Specialization.txt"},     // Specialization
-				{"EnumCity.go", "enumcity.txt"},                 // Enum Field
-				{"animal.go", "animal.txt"},                     // using enum
-				{"EnumString.go", "enumstring.txt"},             // Enum type
-				{"ValidationString.go", "ValidationString.txt"}, // validation
+				{"petshop.go", "petshop.txt"},                                     // using map type & testing case sensitive type name
+				{"Pet.go", "Pet.txt"},                                             // Union
+				{"ArrayOfPets.go", "ArrayOfPets.txt"},                             // Array of union
+				{"Specialization.go", "Specialization.txt"},                       // Specialization
+				{"EnumCity.go", "enumcity.txt"},                                   // Enum Field
+				{"animal.go", "animal.txt"},                                       // using enum
+				{"EnumString.go", "enumstring.txt"},                               // Enum type
+				{"ValidationString.go", "ValidationString.txt"},                   // validation
+				{"TridimensionalArrayOfPets.go", "TridimensionalArrayOfPets.txt"}, // multidimensional array of union
+				{"ArrayOfCatsItems.go", "ArrayOfCatsItems.txt"},                   // array with items facet
+				{"InlineDeclaration.go", "InlineDeclaration.txt"},                 // inline type declaration
 			}
 
 			for _, check := range checks {
@@ -87,6 +90,196 @@ func TestGenerateStructFromRaml(t *testing.T) {
 
 		})
 
+		Convey("With JSON schema references", func() {
+			err := raml.ParseFile("../fixtures/struct/jsonschema/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			err = generateStructs(apiDef.Types, targetDir, "main")
+			So(err, ShouldBeNil)
+
+			err = generateBodyStructs(apiDef, targetDir, "main")
+			So(err, ShouldBeNil)
+
+			rootFixture := "./fixtures/struct/jsonschema"
+			checks := []struct {
+				Result   string
+				Expected string
+			}{
+				{"Pet.go", "Pet.txt"},
+				{"PetTag.go", "PetTag.txt"},
+				{"Owner.go", "Owner.txt"},
+				{"PetsPostReqBody.go", "PetsPostReqBody.txt"},
+			}
+
+			for _, check := range checks {
+				s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("With XML facets and schemas", func() {
+			err := raml.ParseFile("../fixtures/struct/xml/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			err = generateStructs(apiDef.Types, targetDir, "main")
+			So(err, ShouldBeNil)
+
+			err = generateBodyStructs(apiDef, targetDir, "main")
+			So(err, ShouldBeNil)
+
+			rootFixture := "./fixtures/struct/xml"
+			checks := []struct {
+				Result   string
+				Expected string
+			}{
+				{"Book.go", "Book.txt"}, // element name, attribute and wrapped array
+				{"Author.go", "Author.txt"},
+				{"Order.go", "Order.txt"}, // XML schema type
+				{"OrdersPostReqBody.go", "OrdersPostReqBody.txt"},
+			}
+
+			for _, check := range checks {
+				s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("With query strings", func() {
+			err := raml.ParseFile("../fixtures/query_string/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			err = generateBodyStructs(apiDef, targetDir, "main")
+			So(err, ShouldBeNil)
+
+			rootFixture := "./fixtures/struct/query_string"
+			checks := []struct {
+				Result   string
+				Expected string
+			}{
+				{"PlacesGetQueryString.go", "PlacesGetQueryString.txt"}, // union
+				{"EventsGetQueryString.go", "EventsGetQueryString.txt"}, // inherited properties
+				{"TicketsGetQueryString.go", "TicketsGetQueryString.txt"}, // query parameters
+			}
+
+			for _, check := range checks {
+				s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+
+			_, err = os.Stat(filepath.Join(targetDir, "PlacesIdPhotosGetQueryString.go"))
+			So(os.IsNotExist(err), ShouldBeTrue) // the struct of the type is used
+		})
+
+		Convey("With form bodies", func() {
+			err := raml.ParseFile("../fixtures/media_types/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// files are in the goraml package
+			globGoramlPkgDir = "goraml"
+			globRootImportPath = "examples.com/avatars"
+
+			err = generateBodyStructs(apiDef, targetDir, "main")
+			So(err, ShouldBeNil)
+
+			rootFixture := "./fixtures/struct/media_types"
+			checks := []struct {
+				Result   string
+				Expected string
+			}{
+				{"AvatarsPostReqBody.go", "AvatarsPostReqBody.txt"},   // multipart/form-data
+				{"AvatarsIdPutReqBody.go", "AvatarsIdPutReqBody.txt"}, // application/x-www-form-urlencoded
+			}
+
+			for _, check := range checks {
+				s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("With typed responses", func() {
+			err := raml.ParseFile("../fixtures/responses/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// header helpers are in the goraml package
+			globGoramlPkgDir = "goraml"
+			globRootImportPath = "examples.com/users"
+
+			err = generateBodyStructs(apiDef, targetDir, "main")
+			So(err, ShouldBeNil)
+
+			rootFixture := "./fixtures/struct/responses"
+			checks := []struct {
+				Result   string
+				Expected string
+			}{
+				{"UsersPostResp.go", "UsersPostResp.txt"},         // required & optional headers
+				{"UsersIdGetResp.go", "UsersIdGetResp.txt"},       // date header, inline body
+				{"UsersIdDeleteResp.go", "UsersIdDeleteResp.txt"}, // response without body
+			}
+
+			for _, check := range checks {
+				s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+
+			_, err = os.Stat(filepath.Join(targetDir, "UsersIdGet404RespBody.go"))
+			So(err, ShouldBeNil)
+		})
+
+		Convey("With pattern properties and additionalProperties", func() {
+			err := raml.ParseFile("../fixtures/struct/facets/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			err = generateStructs(apiDef.Types, targetDir, "main")
+			So(err, ShouldBeNil)
+
+			rootFixture := "./fixtures/struct/facets"
+			checks := []struct {
+				Result   string
+				Expected string
+			}{
+				{"Extensible.go", "Extensible.txt"}, // pattern properties & strict
+				{"Product.go", "Product.txt"},       // inherited from Extensible
+				{"Settings.go", "Settings.txt"},     // patterns of different types
+				{"Closed.go", "Closed.txt"},         // strict only
+			}
+
+			for _, check := range checks {
+				s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
 		Reset(func() {
 			os.RemoveAll(targetDir)
 		})
@@ -0,0 +1,129 @@
+package golang
+
+import (
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/codegen/commons"
+	"github.com/Jumpscale/go-raml/raml"
+)
+
+var reURIParam = regexp.MustCompile(`{[^{}/]+}`)
+
+// uriParam is a typed URI parameter of a typed handler
+type uriParam struct {
+	Name string // name of the parameter in the URI
+	Var  string // name of the Go variable
+	Type string // Go type
+}
+
+// newURIParams returns the URI parameters of a resource and of it's parents,
+// in the order of the URI. The undeclared parameters are strings.
+func newURIParams(r *raml.Resource) []uriParam {
+	if r == nil {
+		return nil
+	}
+	params := newURIParams(r.Parent)
+	for _, match := range reURIParam.FindAllString(r.URI, -1) {
+		name := match[1 : len(match)-1]
+		tipe := "string"
+		if np, ok := r.URIParameters[name]; ok {
+			tipe = convertToGoType(np.TypeExpr())
+		}
+		params = append(params, uriParam{
+			Name: name,
+			Var:  paramVarName(name),
+			Type: tipe,
+		})
+	}
+	return params
+}
+
+// paramVarName returns the name of the Go variable of a parameter
+func paramVarName(name string) string {
+	v := fieldName(name)
+	if v == "" {
+		return "param"
+	}
+	v = strings.ToLower(v[:1]) + v[1:]
+	if token.IsKeyword(v) {
+		v += "Param"
+	}
+	return v
+}
+
+// setupTypedHandler sets the typed parameters and responses of the handler of a server method
+func (gm *serverMethod) setupTypedHandler(r *raml.Resource, m *raml.Method, methodName string) {
+	prefix := commons.NormalizeURITitle(gm.Endpoint + methodName)
+
+	gm.HandlerName = "handle" + prefix
+	gm.URIParams = newURIParams(r)
+	if len(m.Headers) > 0 {
+		gm.HeadersType = prefix + commons.HeadersSuffix
+	}
+	if mr := newMethodResponses(m, methodName, gm.Endpoint, prefix, ""); mr != nil {
+		gm.RespTypes = mr
+		gm.RespBody = mr.Name
+		gm.TypedResponses = true
+	}
+}
+
+// TypedParams returns the parameters of the typed handler
+func (gm serverMethod) TypedParams() string {
+	params := []string{"ctx context.Context"}
+	for _, p := range gm.URIParams {
+		params = append(params, p.Var+" "+p.Type)
+	}
+	if gm.QueryString != "" {
+		params = append(params, "queryString "+gm.QueryString)
+	}
+	if gm.HeadersType != "" {
+		params = append(params, "headers "+gm.HeadersType)
+	}
+	if gm.ReqBody != "" {
+		params = append(params, "reqBody "+gm.ReqBody)
+	}
+	return strings.Join(params, ", ")
+}
+
+// TypedArgs returns the arguments given to the typed handler by it's wrapper
+func (gm serverMethod) TypedArgs() string {
+	args := []string{"r.Context()"}
+	for _, p := range gm.URIParams {
+		args = append(args, p.Var)
+	}
+	if gm.QueryString != "" {
+		args = append(args, "queryString")
+	}
+	if gm.HeadersType != "" {
+		args = append(args, "headers")
+	}
+	if gm.ReqBody != "" {
+		args = append(args, "reqBody")
+	}
+	return strings.Join(args, ", ")
+}
+
+// TypedReturns returns the types returned by the typed handler
+func (gm serverMethod) TypedReturns() string {
+	if gm.RespTypes == nil {
+		return "error"
+	}
+	return "(" + gm.RespTypes.Name + ", error)"
+}
+
+// typedLibImported returns the libraries of the types of the typed handler parameters
+func (gm serverMethod) typedLibImported(rootImportPath string) map[string]struct{} {
+	libs := map[string]struct{}{}
+	types := []string{gm.QueryString, gm.HeadersType, gm.ReqBody}
+	for _, p := range gm.URIParams {
+		types = append(types, p.Type)
+	}
+	for _, t := range types {
+		if lib := libImportPath(rootImportPath, t); lib != "" {
+			libs[lib] = struct{}{}
+		}
+	}
+	return libs
+}
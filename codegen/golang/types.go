@@ -1,31 +1,41 @@
 package golang
 
 import (
-	"strings"
-
 	"github.com/Jumpscale/go-raml/codegen/commons"
+	"github.com/Jumpscale/go-raml/raml"
 )
 
 var (
 	typeMap = map[string]string{
 		"string":  "string",
-		"file":    "string",
 		"number":  "float64",
 		"integer": "int",
 		"boolean": "bool",
 	}
 )
 
-func convertUnion(strType string) string {
-	if strings.Index(strType, "[]") > 0 {
-		return "[]interface{}"
+// convert from raml type to go type
+func convertToGoType(tip string) string {
+	te, err := raml.ParseTypeExpr(tip)
+	if err != nil {
+		return commons.NormalizePkgName(tip)
 	}
-	return "interface{}"
+	return typeExprToGo(te)
 }
 
-// convert from raml type to go type
-func convertToGoType(tip string) string {
-	if v, ok := typeMap[tip]; ok {
+// convert from raml type expression to go type
+func typeExprToGo(te *raml.TypeExpr) string {
+	switch te.Kind {
+	case raml.TypeExprArray:
+		return "[]" + typeExprToGo(te.Items)
+	case raml.TypeExprMap:
+		return "map[string]" + typeExprToGo(te.Items)
+	case raml.TypeExprName:
+	default: // union, multiple inheritance and inline type declaration
+		return "interface{}"
+	}
+
+	if v, ok := typeMap[te.Name]; ok {
 		return v
 	}
 	goramlPkgDir := func() string {
@@ -34,7 +44,9 @@ func convertToGoType(tip string) string {
 		}
 		return globGoramlPkgDir + "."
 	}()
-	dateMap := map[string]string{
+	// types implemented by the goraml package
+	helperTypeMap := map[string]string{
+		"file":          goramlPkgDir + "File",
 		"date":          goramlPkgDir + "Date",
 		"date-only":     goramlPkgDir + "DateOnly",
 		"time-only":     goramlPkgDir + "TimeOnly",
@@ -42,20 +54,8 @@ func convertToGoType(tip string) string {
 		"datetime":      goramlPkgDir + "DateTime",
 	}
 
-	if v, ok := dateMap[tip]; ok {
+	if v, ok := helperTypeMap[te.Name]; ok {
 		return v
 	}
-
-	// other types that need some processing
-	switch {
-	case strings.HasSuffix(tip, "[][]"): // bidimensional array
-		return "[][]" + convertToGoType(tip[:len(tip)-4])
-	case strings.HasSuffix(tip, "[]"): // array
-		return "[]" + convertToGoType(tip[:len(tip)-2])
-	case strings.HasSuffix(tip, "{}"): // map
-		return "map[string]" + convertToGoType(tip[:len(tip)-2])
-	case strings.Index(tip, "|") > 0:
-		return convertUnion(tip)
-	}
-	return commons.NormalizePkgName(tip)
+	return commons.NormalizePkgName(te.Name)
 }
@@ -14,7 +14,8 @@ func TestTypeConversion(t *testing.T) {
 			So(convertToGoType("number"), ShouldEqual, "float64")
 			So(convertToGoType("integer"), ShouldEqual, "int")
 			So(convertToGoType("boolean"), ShouldEqual, "bool")
-			So(convertToGoType("file"), ShouldEqual, "string")
+			So(convertToGoType("file"), ShouldEqual, "goraml.File")
+			So(convertToGoType("file[]"), ShouldEqual, "[]goraml.File")
 			So(convertToGoType("date-only"), ShouldEqual, "goraml.DateOnly")
 			So(convertToGoType("time-only"), ShouldEqual, "goraml.TimeOnly")
 			So(convertToGoType("Object"), ShouldEqual, "Object")
@@ -22,6 +23,10 @@ func TestTypeConversion(t *testing.T) {
 			So(convertToGoType("string[][]"), ShouldEqual, "[][]string")
 			So(convertToGoType("string | Person"), ShouldEqual, "interface{}")
 			So(convertToGoType("(string | Person)[]"), ShouldEqual, "[]interface{}")
+			So(convertToGoType("string[][][]"), ShouldEqual, "[][][]string")
+			So(convertToGoType("((Cat | Dog))[][]"), ShouldEqual, "[][]interface{}")
+			So(convertToGoType("Person{}"), ShouldEqual, "map[string]Person")
+			So(convertToGoType("string[] | Person"), ShouldEqual, "interface{}")
 		})
 	})
 }
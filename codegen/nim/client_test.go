@@ -35,6 +35,46 @@ func TestGenerateClient(t *testing.T) {
 			{"Users_service.nim", "Users_service.nim"},
 		}
 
+		Convey("client with query strings", func() {
+			var apiDef raml.APIDefinition
+			err := raml.ParseFile("../fixtures/query_string/api.raml", &apiDef)
+			So(err, ShouldBeNil)
+
+			client := Client{
+				APIDef: &apiDef,
+				Dir:    targetDir,
+			}
+			err = client.Generate()
+			So(err, ShouldBeNil)
+
+			s, err := testLoadFile(filepath.Join(targetDir, "Places_service.nim"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("./fixtures/resource/client/Places_service.nim")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+		})
+
+		Convey("client with form bodies", func() {
+			var apiDef raml.APIDefinition
+			err := raml.ParseFile("../fixtures/media_types/api.raml", &apiDef)
+			So(err, ShouldBeNil)
+
+			client := Client{
+				APIDef: &apiDef,
+				Dir:    targetDir,
+			}
+			err = client.Generate()
+			So(err, ShouldBeNil)
+
+			s, err := testLoadFile(filepath.Join(targetDir, "Avatars_service.nim"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("./fixtures/resource/client/Avatars_service.nim")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+		})
+
 		for _, check := range checks {
 			s, err := testLoadFile(filepath.Join(targetDir, check.Result))
 			So(err, ShouldBeNil)
@@ -1,9 +1,31 @@
 package nim
 
 import (
+	"regexp"
+
 	"github.com/Jumpscale/go-raml/raml"
 )
 
+var (
+	regNonIdentifier = regexp.MustCompile(`\W`)
+
+	// Nim keywords, can only be used as identifier when enclosed in backticks
+	nimKeywords = map[string]bool{
+		"addr": true, "and": true, "as": true, "asm": true, "bind": true, "block": true,
+		"break": true, "case": true, "cast": true, "concept": true, "const": true,
+		"continue": true, "converter": true, "defer": true, "discard": true, "distinct": true,
+		"div": true, "do": true, "elif": true, "else": true, "end": true, "enum": true,
+		"except": true, "export": true, "finally": true, "for": true, "from": true, "func": true,
+		"if": true, "import": true, "in": true, "include": true, "interface": true, "is": true,
+		"isnot": true, "iterator": true, "let": true, "macro": true, "method": true, "mixin": true,
+		"mod": true, "nil": true, "not": true, "notin": true, "object": true, "of": true, "or": true,
+		"out": true, "proc": true, "ptr": true, "raise": true, "ref": true, "return": true,
+		"shl": true, "shr": true, "static": true, "template": true, "try": true, "tuple": true,
+		"type": true, "using": true, "var": true, "when": true, "while": true, "xor": true,
+		"yield": true,
+	}
+)
+
 // field represents a Nim object field
 type field struct {
 	Name string // field name
@@ -13,7 +35,7 @@ type field struct {
 
 func newField(objName string, prop raml.Property) field {
 	f := field{
-		Name: prop.Name,
+		Name: fieldName(prop.Name),
 		Type: toNimType(prop.Type),
 	}
 	if prop.IsEnum() {
@@ -22,3 +44,14 @@ func newField(objName string, prop raml.Property) field {
 	}
 	return f
 }
+
+// fieldName returns the Nim name of the field of a property,
+// the characters not allowed in an identifier are replaced by `_`, e.g. `page-size` becomes `page_size`,
+// and the keywords are enclosed in backticks.
+func fieldName(propName string) string {
+	name := regNonIdentifier.ReplaceAllString(propName, "_")
+	if nimKeywords[name] {
+		return "`" + name + "`"
+	}
+	return name
+}
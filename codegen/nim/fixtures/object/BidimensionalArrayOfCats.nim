@@ -1,3 +1,4 @@
 
+import Cat
 type
   BidimensionalArrayOfCats* = seq[seq[Cat]]
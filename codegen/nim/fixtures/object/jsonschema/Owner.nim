@@ -0,0 +1,13 @@
+
+import OwnerAddress
+import OwnerContact
+import OwnerPet
+import tables
+type
+  Owner* = object
+    address*: OwnerAddress
+    contact*: OwnerContact
+    labels*: Table[string, string]
+    name*: string
+    pets*: seq[OwnerPet]
+    rating*: float64
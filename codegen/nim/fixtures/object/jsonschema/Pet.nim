@@ -0,0 +1,14 @@
+
+import EnumPetKind
+import PetCommonAudit
+import PetCommonId
+import PetTag
+type
+  Pet* = object
+    age*: int
+    audit*: PetCommonAudit
+    id*: PetCommonId
+    kind*: EnumPetKind
+    name*: string
+    nickname*: string
+    tags*: seq[PetTag]
@@ -0,0 +1,6 @@
+
+import petsPostReqBodyTag
+type
+  petsPostReqBody* = object
+    name*: string
+    tags*: seq[petsPostReqBodyTag]
@@ -0,0 +1,39 @@
+import marshal, tables
+import strutils, sequtils, times
+import httpclient
+import client_media
+
+import Avatar
+import avatarsPostReqBody
+import avatarsidPutReqBody
+
+
+type
+  Avatars_service* = object
+    client*: Client
+    name*: string
+
+proc AvatarsSrv*(c : Client) : Avatars_service  =
+  return Avatars_service(client:c, name:c.baseURI)
+
+
+proc avatarsPost*(srv: Avatars_service, reqBody: avatarsPostReqBody, queryParams: Table[string, string] = initTable[string, string]()) : Avatar =
+  var form = newMultipartData()
+  if reqBody.description != default(type(reqBody.description)): form.add("description", reqBody.description)
+  form.add("image", reqBody.image, filename="image")
+  for content in reqBody.thumbnails: form.add("thumbnails", content, filename="thumbnails")
+  form.add("userId", $reqBody.userId)
+  let resp = srv.client.requestMultipart("/avatars", "POST", form, queryParams=queryParams)
+  return to[Avatar](resp.body)
+
+proc avatarsByIdGet*(srv: Avatars_service, id: string, queryParams: Table[string, string] = initTable[string, string]()) : Avatar =
+  let resp = srv.client.request("/avatars/"&id, "GET", queryParams=queryParams)
+  return to[Avatar](resp.body)
+
+proc avatarsByIdPut*(srv: Avatars_service, reqBody: avatarsidPutReqBody, id: string, queryParams: Table[string, string] = initTable[string, string]()) : string =
+  var form: seq[(string, string)] = @[]
+  form.add(("description", reqBody.description))
+  if reqBody.tags != default(type(reqBody.tags)): form.add(("tags", reqBody.tags.mapIt($it).join(",")))
+  let resp = srv.client.requestForm("/avatars/"&id, "PUT", form, queryParams=queryParams)
+  return to[string](resp.body)
+
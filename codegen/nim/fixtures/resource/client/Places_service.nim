@@ -0,0 +1,32 @@
+import marshal, tables
+import strutils, sequtils, times
+import client_places
+
+import Paging
+import placesGetQueryString
+
+
+type
+  Places_service* = object
+    client*: Client
+    name*: string
+
+proc PlacesSrv*(c : Client) : Places_service  =
+  return Places_service(client:c, name:c.baseURI)
+
+
+proc placesGet*(srv: Places_service, queryString: placesGetQueryString, queryParams: Table[string, string] = initTable[string, string]()) : seq[Place] =
+  var queryParams = queryParams
+  if queryString.lat != default(type(queryString.lat)): queryParams["lat"] = $queryString.lat
+  if queryString.location != default(type(queryString.location)): queryParams["location"] = queryString.location
+  if queryString.long != default(type(queryString.long)): queryParams["long"] = $queryString.long
+  let resp = srv.client.request("/places", "GET", queryParams=queryParams)
+  return to[seq[Place]](resp.body)
+
+proc placesByIdPhotosGet*(srv: Places_service, queryString: Paging, id: string, queryParams: Table[string, string] = initTable[string, string]()) : string =
+  var queryParams = queryParams
+  if queryString.page_size != default(type(queryString.page_size)): queryParams["page-size"] = $queryString.page_size
+  if queryString.start != default(type(queryString.start)): queryParams["start"] = $queryString.start
+  let resp = srv.client.request("/places/"&id&"/photos", "GET", queryParams=queryParams)
+  return to[string](resp.body)
+
@@ -1,7 +1,9 @@
 import marshal, tables
+import strutils, sequtils, times
 import client_struct
 
 import City
+import GetUsersQueryString
 
 
 type
@@ -13,7 +15,10 @@ proc UsersSrv*(c : Client) : Users_service  =
   return Users_service(client:c, name:c.baseURI)
 
 
-proc GetUsers*(srv: Users_service, queryParams: Table[string, string] = initTable[string, string]()) : usersGetRespBody =
+proc GetUsers*(srv: Users_service, queryString: GetUsersQueryString, queryParams: Table[string, string] = initTable[string, string]()) : usersGetRespBody =
+  var queryParams = queryParams
+  queryParams["page"] = $queryString.page
+  queryParams["per_page"] = $queryString.per_page
   let resp = srv.client.request("/users", "GET", queryParams=queryParams)
   return to[usersGetRespBody](resp.body)
 
@@ -1,4 +1,4 @@
-import httpclient, strutils, tables
+import httpclient, strutils, tables, uri
 
 type
   Client* = object
@@ -35,10 +35,25 @@ proc addQueryParams(url: string, queryParams: Table) : string =
   result = url & sep & qp.join("&")
 
 
-proc request*(c: Client, endpoint: string, httpMethod = "GET", body = "", queryParams: Table[string, string] = initTable[string, string]()): httpclient.Response =
+proc requestURL(c: Client, endpoint: string, queryParams: Table[string, string]): string =
+  # URL of the request to an endpoint
   var url: string = endpoint
   if not url.startsWith("http"):
     url = c.baseURI & url
 
-  url = addQueryParams(url, queryParams)
-  return c.hc.request(url, httpMethod, body)
\ No newline at end of file
+  return addQueryParams(url, queryParams)
+
+proc request*(c: Client, endpoint: string, httpMethod = "GET", body = "", queryParams: Table[string, string] = initTable[string, string]()): httpclient.Response =
+  return c.hc.request(c.requestURL(endpoint, queryParams), httpMethod, body)
+
+proc requestForm*(c: Client, endpoint: string, httpMethod: string, form: seq[(string, string)], queryParams: Table[string, string] = initTable[string, string]()): httpclient.Response =
+  # sends an application/x-www-form-urlencoded body
+  var fields: seq[string] = @[]
+  for field in form:
+    fields.add(encodeUrl(field[0]) & "=" & encodeUrl(field[1]))
+  let headers = newHttpHeaders({ "Content-Type": "application/x-www-form-urlencoded" })
+  return c.hc.request(c.requestURL(endpoint, queryParams), httpMethod, fields.join("&"), headers)
+
+proc requestMultipart*(c: Client, endpoint: string, httpMethod: string, form: MultipartData, queryParams: Table[string, string] = initTable[string, string]()): httpclient.Response =
+  # sends a multipart/form-data body, the content type is set by the http client
+  return c.hc.request(c.requestURL(endpoint, queryParams), httpMethod, multipart=form)
\ No newline at end of file
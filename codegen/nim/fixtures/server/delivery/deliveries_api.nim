@@ -1,6 +1,8 @@
 import jester, marshal, system
 
 
+# go-raml:begin imports
+# go-raml:end
 
 
 
@@ -10,33 +12,43 @@ proc deliveriesGet*(req: Request) : tuple[code: HttpCode, content: string] =
   let respBody = ""
   
   
+  # go-raml:begin GET /deliveries
   result = (code: Http200, content: respBody)
+  # go-raml:end
 
 proc deliveriesPost*(req: Request) : tuple[code: HttpCode, content: string] =
   # Create/request a new delivery
   let respBody = ""
   
   
+  # go-raml:begin POST /deliveries
   result = (code: Http200, content: respBody)
+  # go-raml:end
 
 proc getDeliveriesByDeliveryID*(deliveryId: string, req: Request) : tuple[code: HttpCode, content: string] =
   # Get information on a specific delivery
   let respBody = ""
   
   
+  # go-raml:begin GET /deliveries/{deliveryId}
   result = (code: Http200, content: respBody)
+  # go-raml:end
 
 proc deliveriesByDeliveryIdPatch*(deliveryId: string, req: Request) : tuple[code: HttpCode, content: string] =
   # Update the information on a specific delivery
   let respBody = ""
   
   
+  # go-raml:begin PATCH /deliveries/{deliveryId}
   result = (code: Http200, content: respBody)
+  # go-raml:end
 
 proc deliveriesByDeliveryIdDelete*(deliveryId: string, req: Request) : tuple[code: HttpCode, content: string] =
   # Cancel a specific delivery
   let respBody = ""
   
   
+  # go-raml:begin DELETE /deliveries/{deliveryId}
   result = (code: Http200, content: respBody)
+  # go-raml:end
 
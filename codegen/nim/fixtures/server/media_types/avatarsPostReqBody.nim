@@ -0,0 +1,7 @@
+
+type
+  avatarsPostReqBody* = object
+    description*: string
+    image*: string
+    thumbnails*: seq[string]
+    userId*: int
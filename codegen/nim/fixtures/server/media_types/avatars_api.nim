@@ -0,0 +1,52 @@
+import jester, marshal, system
+import strutils, sequtils, times
+import tables
+
+
+import Avatar
+import avatarsPostReqBody
+import avatarsidPutReqBody
+# go-raml:begin imports
+# go-raml:end
+
+
+
+
+proc avatarsPost*(req: Request) : tuple[code: HttpCode, content: Avatar] =
+  # upload the avatar of an user
+  var respBody: Avatar
+  
+  var reqBody: avatarsPostReqBody
+  try:
+    if req.formData.hasKey("description"): reqBody.description = req.formData["description"].body
+    if req.formData.hasKey("image"): reqBody.image = req.formData["image"].body
+    if req.formData.hasKey("thumbnails"): reqBody.thumbnails = @[req.formData["thumbnails"].body]
+    if req.formData.hasKey("userId"): reqBody.userId = parseInt(req.formData["userId"].body)
+  except ValueError:
+    return (code: Http400, content: respBody)
+  # go-raml:begin POST /avatars
+  result = (code: Http200, content: respBody)
+  # go-raml:end
+
+proc avatarsByIdGet*(id: string, req: Request) : tuple[code: HttpCode, content: Avatar] =
+  var respBody: Avatar
+  
+  
+  # go-raml:begin GET /avatars/{id}
+  result = (code: Http200, content: respBody)
+  # go-raml:end
+
+proc avatarsByIdPut*(id: string, req: Request) : tuple[code: HttpCode, content: string] =
+  # update the description of an avatar
+  let respBody = ""
+  
+  var reqBody: avatarsidPutReqBody
+  try:
+    if req.params.hasKey("description"): reqBody.description = req.params["description"]
+    if req.params.hasKey("tags"): reqBody.tags = req.params["tags"].split(',')
+  except ValueError:
+    return (code: Http400, content: respBody)
+  # go-raml:begin PUT /avatars/{id}
+  result = (code: Http200, content: respBody)
+  # go-raml:end
+
@@ -0,0 +1,36 @@
+import jester, asyncdispatch, json, marshal, system
+import params
+
+import avatars_api
+
+routes:
+  POST "/avatars":
+    let violations = checkParams(request, @[
+      Param(location: "form", name: "image", required: true),
+      Param(location: "form", name: "userId", kind: "integer", required: true),
+    ])
+    if violations.len > 0:
+      resp(Http400, violationsResponse(violations), "application/json")
+    else:
+      let ret = avatarsPost(request)
+      resp(ret.code, $$ret.content)
+
+  GET "/avatars/@id":
+    let ret = avatarsByIdGet(@"id", request)
+    resp(ret.code, $$ret.content)
+
+  PUT "/avatars/@id":
+    let violations = checkParams(request, @[
+      Param(location: "form", name: "description", required: true),
+    ])
+    if violations.len > 0:
+      resp(Http400, violationsResponse(violations), "application/json")
+    else:
+      let ret = avatarsByIdPut(@"id", request)
+      resp(ret.code, $$ret.content)
+
+
+  GET "/":
+    resp(readFile("index.html"))
+
+runForever()
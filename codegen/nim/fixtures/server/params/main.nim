@@ -0,0 +1,46 @@
+import jester, asyncdispatch, json, marshal, system
+import params
+
+import users_api
+
+routes:
+  GET "/users":
+    let violations = checkParams(request, @[
+      Param(location: "query", name: "name", hasMinLength: true, minLength: 2, hasMaxLength: true, maxLength: 20),
+      Param(location: "query", name: "page", kind: "integer", hasMinimum: true, minimum: 1.0, hasMaximum: true, maximum: 100.0),
+      Param(location: "query", name: "status", enumValues: @["active", "blocked"]),
+      Param(location: "header", name: "X-Request-Id", required: true, pattern: r"^[a-f0-9]{8}$"),
+    ])
+    if violations.len > 0:
+      resp(Http400, violationsResponse(violations), "application/json")
+    else:
+      let ret = usersGet(request)
+      resp(ret.code, $$ret.content)
+
+  GET "/users/@id":
+    let violations = checkParams(request, @[
+      Param(location: "uri", name: "id", kind: "integer", hasMinimum: true, minimum: 1.0),
+      Param(location: "query", name: "since", kind: "date-only"),
+      Param(location: "query", name: "verbose", kind: "boolean"),
+    ])
+    if violations.len > 0:
+      resp(Http400, violationsResponse(violations), "application/json")
+    else:
+      let ret = usersByIdGet(@"id", request)
+      resp(ret.code, $$ret.content)
+
+  DELETE "/users/@id":
+    let violations = checkParams(request, @[
+      Param(location: "uri", name: "id", kind: "integer", hasMinimum: true, minimum: 1.0),
+    ])
+    if violations.len > 0:
+      resp(Http400, violationsResponse(violations), "application/json")
+    else:
+      let ret = usersByIdDelete(@"id", request)
+      resp(ret.code, $$ret.content)
+
+
+  GET "/":
+    resp(readFile("index.html"))
+
+runForever()
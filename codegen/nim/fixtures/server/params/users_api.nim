@@ -0,0 +1,52 @@
+import jester, marshal, system
+import strutils, sequtils, times
+
+
+import usersByIdGetQueryString
+import usersGetQueryString
+# go-raml:begin imports
+# go-raml:end
+
+
+
+
+proc usersGet*(req: Request) : tuple[code: HttpCode, content: seq[string]] =
+  # search the users
+  var respBody: seq[string]
+  
+  var queryString: usersGetQueryString
+  try:
+    if req.params.hasKey("name"): queryString.name = req.params["name"]
+    if req.params.hasKey("page"): queryString.page = parseInt(req.params["page"])
+    if req.params.hasKey("tags"): queryString.tags = req.params["tags"].split(',')
+  except ValueError:
+    return (code: Http400, content: respBody)
+  
+  # go-raml:begin GET /users
+  result = (code: Http200, content: respBody)
+  # go-raml:end
+
+proc usersByIdGet*(id: string, req: Request) : tuple[code: HttpCode, content: string] =
+  # get a user
+  var respBody: string
+  
+  var queryString: usersByIdGetQueryString
+  try:
+    if req.params.hasKey("since"): queryString.since = parse(req.params["since"], "yyyy-MM-dd")
+    if req.params.hasKey("verbose"): queryString.verbose = (req.params["verbose"] == "true")
+  except ValueError:
+    return (code: Http400, content: respBody)
+  
+  # go-raml:begin GET /users/{id}
+  result = (code: Http200, content: respBody)
+  # go-raml:end
+
+proc usersByIdDelete*(id: string, req: Request) : tuple[code: HttpCode, content: string] =
+  # delete a user
+  let respBody = ""
+  
+  
+  # go-raml:begin DELETE /users/{id}
+  result = (code: Http200, content: respBody)
+  # go-raml:end
+
@@ -0,0 +1,27 @@
+import jester, marshal, system
+import strutils, sequtils, times
+
+
+import eventsGetQueryString
+# go-raml:begin imports
+# go-raml:end
+
+
+
+
+proc eventsGet*(req: Request) : tuple[code: HttpCode, content: string] =
+  let respBody = ""
+  
+  var queryString: eventsGetQueryString
+  try:
+    if req.params.hasKey("from"): queryString.`from` = parse(req.params["from"], "yyyy-MM-dd")
+    if req.params.hasKey("page-size"): queryString.page_size = parseInt(req.params["page-size"])
+    if req.params.hasKey("start"): queryString.start = parseInt(req.params["start"])
+    if req.params.hasKey("tags"): queryString.tags = req.params["tags"].split(',')
+  except ValueError:
+    return (code: Http400, content: respBody)
+  
+  # go-raml:begin GET /events
+  result = (code: Http200, content: respBody)
+  # go-raml:end
+
@@ -0,0 +1,65 @@
+import jester, asyncdispatch, json, marshal, system
+import params
+
+import events_api
+import places_api
+import tickets_api
+
+routes:
+  GET "/events":
+    let violations = checkParams(request, @[
+      Param(location: "query", name: "from", kind: "date-only", required: true),
+      Param(location: "query", name: "page-size", kind: "integer", hasMaximum: true, maximum: 100.0),
+      Param(location: "query", name: "start", kind: "integer"),
+    ])
+    if violations.len > 0:
+      resp(Http400, violationsResponse(violations), "application/json")
+    else:
+      let ret = eventsGet(request)
+      resp(ret.code, $$ret.content)
+
+
+  GET "/":
+    resp(readFile("index.html"))
+  GET "/places":
+    let violations = checkParams(request, @[
+      Param(location: "query", name: "lat", kind: "number"),
+      Param(location: "query", name: "long", kind: "number"),
+    ])
+    if violations.len > 0:
+      resp(Http400, violationsResponse(violations), "application/json")
+    else:
+      let ret = placesGet(request)
+      resp(ret.code, $$ret.content)
+
+  GET "/places/@id/photos":
+    let violations = checkParams(request, @[
+      Param(location: "query", name: "page-size", kind: "integer", hasMaximum: true, maximum: 100.0),
+      Param(location: "query", name: "start", kind: "integer"),
+    ])
+    if violations.len > 0:
+      resp(Http400, violationsResponse(violations), "application/json")
+    else:
+      let ret = placesByIdPhotosGet(@"id", request)
+      resp(ret.code, $$ret.content)
+
+
+  GET "/":
+    resp(readFile("index.html"))
+  GET "/tickets":
+    let violations = checkParams(request, @[
+      Param(location: "query", name: "ids", kind: "integer", array: true, required: true),
+      Param(location: "query", name: "since", kind: "date-only"),
+      Param(location: "query", name: "status", enumValues: @["open", "closed"]),
+    ])
+    if violations.len > 0:
+      resp(Http400, violationsResponse(violations), "application/json")
+    else:
+      let ret = ticketsGet(request)
+      resp(ret.code, $$ret.content)
+
+
+  GET "/":
+    resp(readFile("index.html"))
+
+runForever()
@@ -0,0 +1,6 @@
+
+type
+  placesGetQueryString* = object
+    lat*: float64
+    location*: string
+    long*: float64
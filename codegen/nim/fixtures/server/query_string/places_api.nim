@@ -0,0 +1,42 @@
+import jester, marshal, system
+import strutils, sequtils, times
+
+
+import Paging
+import placesGetQueryString
+# go-raml:begin imports
+# go-raml:end
+
+
+
+
+proc placesGet*(req: Request) : tuple[code: HttpCode, content: seq[Place]] =
+  # search places near a location
+  var respBody: seq[Place]
+  
+  var queryString: placesGetQueryString
+  try:
+    if req.params.hasKey("lat"): queryString.lat = parseFloat(req.params["lat"])
+    if req.params.hasKey("location"): queryString.location = req.params["location"]
+    if req.params.hasKey("long"): queryString.long = parseFloat(req.params["long"])
+  except ValueError:
+    return (code: Http400, content: respBody)
+  
+  # go-raml:begin GET /places
+  result = (code: Http200, content: respBody)
+  # go-raml:end
+
+proc placesByIdPhotosGet*(id: string, req: Request) : tuple[code: HttpCode, content: string] =
+  let respBody = ""
+  
+  var queryString: Paging
+  try:
+    if req.params.hasKey("page-size"): queryString.page_size = parseInt(req.params["page-size"])
+    if req.params.hasKey("start"): queryString.start = parseInt(req.params["start"])
+  except ValueError:
+    return (code: Http400, content: respBody)
+  
+  # go-raml:begin GET /places/{id}/photos
+  result = (code: Http200, content: respBody)
+  # go-raml:end
+
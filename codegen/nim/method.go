@@ -14,6 +14,12 @@ import (
 
 type method struct {
 	*cr.Method
+	QueryParams []queryParam // parameters of the query string
+	FormParams  []queryParam // fields of the form request body
+
+	// parameters of the query string type and fields of the form body
+	// which are validated with the other request parameters
+	objectParams []cr.RequestParam
 }
 
 // creates new Nim method
@@ -32,7 +38,22 @@ func newMethod(apiDef *raml.APIDefinition, r *raml.Resource, rd *cr.Resource, m
 	if apiDef != nil {
 		rm.SecuredBy = security.GetMethodSecuredBy(apiDef, r, m)
 	}
-	return method{Method: &rm}, nil
+	rm.QueryString = queryStringName(m, rm.MethodName)
+
+	// the query parameters are already in the request params of the method
+	var objectParams []cr.RequestParam
+	if m.QueryString != nil {
+		objectParams = cr.PropertyParams(cr.ParamInQuery, m.QueryStringProperties(rd.APIDef.Types))
+	}
+	if _, form := m.Bodies.FormBody(); form != nil {
+		objectParams = append(objectParams, cr.PropertyParams(cr.ParamInForm, form.Properties)...)
+	}
+	return method{
+		Method:       &rm,
+		QueryParams:  newQueryParams(m, rd.APIDef.Types),
+		FormParams:   newFormParams(m),
+		objectParams: objectParams,
+	}, nil
 }
 
 // creates new client method
@@ -92,6 +113,11 @@ func (m method) ClientProcParams() string {
 		params = append(params, fmt.Sprintf("reqBody: %v", m.ReqBody))
 	}
 
+	// query string
+	if m.QueryString != "" {
+		params = append(params, fmt.Sprintf("queryString: %v", m.QueryString))
+	}
+
 	// resource params
 	for _, p := range cr.GetResourceParams(m.Resource()) {
 		params = append(params, fmt.Sprintf("%v: string", p))
@@ -107,6 +133,19 @@ func (m method) ClientProcParams() string {
 	return str
 }
 
+// ClientRequestProc is the client proc which sends the request
+func (m method) ClientRequestProc() string {
+	switch {
+	case m.ReqBody == "":
+		return "request"
+	case m.ReqBodyIsMultipart():
+		return "requestMultipart"
+	case m.ReqBodyIsForm():
+		return "requestForm"
+	}
+	return "request"
+}
+
 // ClientCallParams are params when we call jester handler
 func (m method) ClientCallParams() string {
 	params := []string{
@@ -114,13 +153,90 @@ func (m method) ClientCallParams() string {
 		fmt.Sprintf(`"%v"`, m.Verb()),
 	}
 	if m.ReqBody != "" {
-		params = append(params, "$$reqBody")
+		if m.ReqBodyIsForm() {
+			params = append(params, "form")
+		} else {
+			params = append(params, "$$reqBody")
+		}
 	}
 	params = append(params, "queryParams=queryParams")
 
 	return strings.Join(params, ", ")
 }
 
+// ServerQueryParams are the statements which decode the query string parameters
+// of the request to the query string object
+func (m method) ServerQueryParams() []string {
+	return decodeParams(m.QueryParams, "queryString", `req.params.hasKey("%v")`, `req.params["%v"]`)
+}
+
+// ServerFormParams are the statements which decode the fields of the form body
+// of the request to the request body object.
+// The application/x-www-form-urlencoded fields are in the params of the request.
+func (m method) ServerFormParams() []string {
+	if m.ReqBodyIsMultipart() {
+		return decodeParams(m.FormParams, "reqBody", `req.formData.hasKey("%v")`, `req.formData["%v"].body`)
+	}
+	return decodeParams(m.FormParams, "reqBody", `req.params.hasKey("%v")`, `req.params["%v"]`)
+}
+
+// decodeParams returns the statements which decode the params to the fields of the object,
+// hasKey and value are the formats of the expressions which check and get a param.
+// The required params and the values are checked by checkParams before calling the proc.
+func decodeParams(params []queryParam, object, hasKey, value string) []string {
+	var lines []string
+	for _, qp := range params {
+		has := fmt.Sprintf(hasKey, qp.Name)
+		decode := qp.Decode(fmt.Sprintf(value, qp.Name))
+		if decode == "" {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf(`if %v: %v.%v = %v`, has, object, qp.Field, decode))
+	}
+	return lines
+}
+
+// ClientFormParams are the statements which add the fields of the request body to the form,
+// the optional fields are only sent if they are not the default value
+func (m method) ClientFormParams() []string {
+	var lines []string
+	for _, qp := range m.FormParams {
+		field := "reqBody." + qp.Field
+		var line string
+		switch {
+		case m.ReqBodyIsMultipart() && qp.isFile && qp.Type == "seq[string]":
+			lines = append(lines, fmt.Sprintf(`for content in %v: form.add("%v", content, filename="%v")`, field, qp.Name, qp.Name))
+			continue
+		case m.ReqBodyIsMultipart() && qp.isFile:
+			line = fmt.Sprintf(`form.add("%v", %v, filename="%v")`, qp.Name, field, qp.Name)
+		case m.ReqBodyIsMultipart():
+			line = fmt.Sprintf(`form.add("%v", %v)`, qp.Name, qp.Encode(field))
+		default:
+			line = fmt.Sprintf(`form.add(("%v", %v))`, qp.Name, qp.Encode(field))
+		}
+		if !qp.Required {
+			line = fmt.Sprintf("if %v != default(type(%v)): %v", field, field, line)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// ClientQueryParams are the query string parameters sent by the client,
+// the optional parameters are only sent if they are not the default value
+func (m method) ClientQueryParams() []string {
+	var lines []string
+	for _, qp := range m.QueryParams {
+		field := "queryString." + qp.Field
+		line := fmt.Sprintf(`queryParams["%v"] = %v`, qp.Name, qp.Encode(field))
+		if !qp.Required {
+			line = fmt.Sprintf("if %v != default(type(%v)): %v", field, field, line)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
 func (m method) ContentRetval() string {
 	retval := m.RespBody
 	if retval == "" {
@@ -150,6 +266,8 @@ func (m method) SecurityScopes() string {
 // Rules:
 //  - use bodies.Type if not empty and not `object`
 //  - use bodies.ApplicationJSON.Type if not empty and not `object`
+//  - use the type of the multipart/form-data or application/x-www-form-urlencoded body
+//    if not empty and not `object`, if there is no JSON nor XML body
 //  - use prefix+suffix if:
 //      - not meet previous rules
 //      - previous rules produces JSON string
@@ -166,6 +284,12 @@ func setBodyName(bodies raml.Bodies, prefix, suffix string) string {
 		} else {
 			tipe = prefix + suffix
 		}
+	} else if _, form := bodies.FormBody(); form != nil {
+		if form.Type != "" && form.Type != "object" {
+			tipe = toNimType(form.Type)
+		} else if len(form.Properties) > 0 {
+			tipe = prefix + suffix
+		}
 	}
 
 	if commons.IsJSONString(tipe) {
@@ -1,7 +1,6 @@
 package nim
 
 import (
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -34,6 +33,12 @@ type object struct {
 
 // generates Nim objects from RAML types
 func generateObjects(types map[string]raml.Type, dir string) error {
+	// the types declared using JSON schema
+	types, err := raml.ExpandJSONSchemaTypes(types)
+	if err != nil {
+		return err
+	}
+
 	objs := []object{}
 	for name, t := range types {
 		obj, err := newObjectFromType(t, name)
@@ -89,6 +94,18 @@ func generateObjectFromMethod(r resource, m method, dir string) ([]string, error
 	}
 	names = append(names, name)
 
+	// form request body, the object is named as the request body of the method
+	if _, form := m.Bodies.FormBody(); form != nil && len(form.Properties) > 0 && m.ReqBody != "" {
+		obj, err := newObject(m.ReqBody, form.Description, form.Properties)
+		if err != nil {
+			return names, err
+		}
+		if err := obj.generate(dir); err != nil {
+			return names, err
+		}
+		names = append(names, obj.Name)
+	}
+
 	for _, v := range m.Responses {
 		name, err := generateObjectFromBody(m.MethodName, &v.Bodies, false, dir)
 		if err != nil {
@@ -96,14 +113,35 @@ func generateObjectFromMethod(r resource, m method, dir string) ([]string, error
 		}
 		names = append(names, name)
 	}
+
+	// query string, nothing to generate if it is only a type name
+	if qs := m.Method.Method.QueryStringType(); qs != nil && commons.QueryStringTypeName(qs) == "" {
+		obj, err := newObject(m.QueryString, qs.Description, m.Method.Method.QueryStringProperties(r.APIDef.Types))
+		if err != nil {
+			return names, err
+		}
+		if err := obj.generate(dir); err != nil {
+			return names, err
+		}
+		names = append(names, obj.Name)
+	}
 	return names, nil
 }
 
-// generateObjectFromBody generate a Nim object from an RAML Body
+// generateObjectFromBody generate a Nim object from an RAML Body,
+// a JSON schema body might generates more than one object
 func generateObjectFromBody(methodName string, body *raml.Bodies, isReq bool, dir string) (string, error) {
 	if !commons.HasJSONBody(body) {
 		return "", nil
 	}
+	if raml.IsJSONSchema(body.ApplicationJSON.Type) {
+		name := bodyObjectName(methodName, isReq)
+		types, err := raml.JSONSchemaTypes(name, body.ApplicationJSON.Type)
+		if err != nil {
+			return "", err
+		}
+		return name, generateObjects(types, dir)
+	}
 	obj, err := newObjectFromBody(methodName, body, isReq)
 	if err != nil {
 		return "", err
@@ -113,19 +151,15 @@ func generateObjectFromBody(methodName string, body *raml.Bodies, isReq bool, di
 
 // create new object from a method body
 func newObjectFromBody(methodName string, body *raml.Bodies, isReq bool) (object, error) {
-	name := methodName + "RespBody"
-	if isReq {
-		name = methodName + "ReqBody"
-	}
+	return newObject(bodyObjectName(methodName, isReq), "", body.ApplicationJSON.Properties)
+}
 
-	if body.ApplicationJSON.Type != "" {
-		var t raml.Type
-		if err := json.Unmarshal([]byte(body.ApplicationJSON.Type), &t); err == nil {
-			return newObjectFromType(t, name)
-		}
+// name of the request/response body object
+func bodyObjectName(methodName string, isReq bool) string {
+	if isReq {
+		return methodName + "ReqBody"
 	}
-
-	return newObject(name, "", body.ApplicationJSON.Properties)
+	return methodName + "RespBody"
 }
 
 // create new object from an RAML type
@@ -142,6 +176,9 @@ func newObject(name, description string, properties map[string]interface{}) (obj
 
 	for k, v := range properties {
 		prop := raml.ToProperty(k, v)
+		if prop.IsPattern() { // pattern properties are not supported
+			continue
+		}
 		fd := newField(name, prop)
 		if fd.Type == "" {
 			return object{}, fmt.Errorf("unsupported type in nim:%v", prop.Type)
@@ -182,12 +219,16 @@ func (o object) Imports() []string {
 	ip := map[string]struct{}{}
 
 	for _, f := range o.Fields {
-		if objectRegistered(f.Type) {
-			ip[f.Type] = struct{}{}
+		typ := elemType(f.Type)
+		if objectRegistered(typ) {
+			ip[typ] = struct{}{}
 		}
-		if f.Type == "Time" {
+		if typ == "Time" {
 			ip["times"] = struct{}{}
 		}
+		if strings.HasPrefix(f.Type, "Table[") {
+			ip["tables"] = struct{}{}
+		}
 	}
 
 	for _, p := range o.Parents {
@@ -203,25 +244,28 @@ func (o *object) handleAdvancedType() {
 	if o.T.Type == nil {
 		o.T.Type = "object"
 	}
-	strType := commons.InterfaceToString(o.T.Type)
+	te, err := o.T.TypeExpr()
+	if err != nil {
+		return
+	}
 
 	switch {
-	case len(strings.Split(strType, ",")) > 1: //multiple inheritance
+	case te.Kind == raml.TypeExprInheritance: //multiple inheritance
 		// TODO
 	case o.T.IsEnum():
 		o.makeEnum()
-	case strings.ToLower(strType) == "object": // plain type
-	case o.T.IsArray():
-		o.makeArray(strType)
+	case strings.ToLower(te.Name) == "object": // plain type
+	case te.Kind == raml.TypeExprArray:
+		o.makeArray(te)
 	}
 }
 
 func (o *object) makeEnum() {
 	o.Enum = newEnumFromObject(o)
 }
-func (o *object) makeArray(t string) {
-	o.Parents = append(o.Parents, t[:len(t)-2])
-	o.buildOneLine(toNimType(t))
+func (o *object) makeArray(te *raml.TypeExpr) {
+	o.Parents = append(o.Parents, te.Names()...)
+	o.buildOneLine(typeExprToNim(te))
 }
 
 func (o *object) buildOneLine(tipe string) {
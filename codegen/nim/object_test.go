@@ -179,6 +179,38 @@ func TestGenerateObjectMethodBody(t *testing.T) {
 
 		})
 
+		Convey("From raml with JSON schema references", func() {
+			var apiDef raml.APIDefinition
+			err := raml.ParseFile("../fixtures/struct/jsonschema/api.raml", &apiDef)
+			So(err, ShouldBeNil)
+
+			err = generateObjects(apiDef.Types, targetDir)
+			So(err, ShouldBeNil)
+
+			_, err = generateObjectsFromBodies(getAllResources(&apiDef, true), targetDir)
+			So(err, ShouldBeNil)
+
+			rootFixture := "./fixtures/object/jsonschema"
+			checks := []struct {
+				Result   string
+				Expected string
+			}{
+				{"Pet.nim", "Pet.nim"},
+				{"Owner.nim", "Owner.nim"},
+				{"petsPostReqBody.nim", "petsPostReqBody.nim"},
+			}
+
+			for _, check := range checks {
+				s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
 		Reset(func() {
 			os.RemoveAll(targetDir)
 		})
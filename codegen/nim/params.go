@@ -0,0 +1,75 @@
+package nim
+
+import (
+	"strconv"
+	"strings"
+
+	cr "github.com/Jumpscale/go-raml/codegen/resource"
+)
+
+// ParamObjects returns the Param objects given to checkParams
+// of the parameters of the method which are validated before calling the proc
+func (m method) ParamObjects() []string {
+	var objects []string
+	for _, p := range m.requestParams() {
+		objects = append(objects, paramObject(p))
+	}
+	return objects
+}
+
+// requestParams returns the request parameters of the method,
+// including the parameters of the query string type and the fields of the form body
+func (m method) requestParams() []cr.RequestParam {
+	params := append(m.RequestParams(), m.objectParams...)
+	cr.SortRequestParams(params)
+	return params
+}
+
+// paramObject returns the Nim Param object of a request parameter
+func paramObject(p cr.RequestParam) string {
+	fields := []string{
+		"location: " + strconv.Quote(p.In),
+		"name: " + strconv.Quote(p.Name),
+	}
+	if p.Type != "" && p.Type != "string" {
+		fields = append(fields, "kind: "+strconv.Quote(p.Type))
+	}
+	if p.Array {
+		fields = append(fields, "array: true")
+	}
+	if p.Required {
+		fields = append(fields, "required: true")
+	}
+	if len(p.Enum) > 0 {
+		var enum []string
+		for _, e := range p.Enum {
+			enum = append(enum, strconv.Quote(e))
+		}
+		fields = append(fields, "enumValues: @["+strings.Join(enum, ", ")+"]")
+	}
+	if p.Pattern != "" {
+		fields = append(fields, `pattern: r"`+strings.Replace(p.Pattern, `"`, `""`, -1)+`"`)
+	}
+	if p.Minimum != nil {
+		fields = append(fields, "hasMinimum: true", "minimum: "+nimFloat(*p.Minimum))
+	}
+	if p.Maximum != nil {
+		fields = append(fields, "hasMaximum: true", "maximum: "+nimFloat(*p.Maximum))
+	}
+	if p.MinLength != nil {
+		fields = append(fields, "hasMinLength: true", "minLength: "+strconv.Itoa(*p.MinLength))
+	}
+	if p.MaxLength != nil {
+		fields = append(fields, "hasMaxLength: true", "maxLength: "+strconv.Itoa(*p.MaxLength))
+	}
+	return "Param(" + strings.Join(fields, ", ") + ")"
+}
+
+// nimFloat returns the Nim float literal of f
+func nimFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
@@ -0,0 +1,128 @@
+package nim
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Jumpscale/go-raml/codegen/commons"
+	"github.com/Jumpscale/go-raml/raml"
+)
+
+var (
+	// format of the date/time query parameters
+	timeFormats = map[string]string{
+		"date-only":        "yyyy-MM-dd",
+		"time-only":        "HH:mm:ss",
+		"datetime-only":    "yyyy-MM-dd'T'HH:mm:ss",
+		"datetime":         "yyyy-MM-dd'T'HH:mm:sszzz",
+		"datetime-rfc2616": "ddd, dd MMM yyyy HH:mm:ss 'GMT'",
+	}
+)
+
+// queryParam is a parameter of a query string object,
+// or a field of a form body object
+type queryParam struct {
+	Name     string // query parameter name
+	Field    string // object field name
+	Type     string // Nim type of the field
+	Required bool
+	format   string // format of the date/time parameter
+	isFile   bool   // file of a multipart/form-data body
+}
+
+// creates the query string parameters of a method,
+// sorted by name
+func newQueryParams(m *raml.Method, types map[string]raml.Type) []queryParam {
+	if m.QueryStringType() == nil {
+		return nil
+	}
+	return newParams(m.QueryStringProperties(types))
+}
+
+// creates the fields of the form request body of a method,
+// sorted by name
+func newFormParams(m *raml.Method) []queryParam {
+	_, form := m.Bodies.FormBody()
+	if form == nil {
+		return nil
+	}
+	return newParams(form.Properties)
+}
+
+func newParams(properties map[string]interface{}) []queryParam {
+	var params []queryParam
+	for name, v := range properties {
+		prop := raml.ToProperty(name, v)
+		if prop.IsPattern() {
+			continue
+		}
+		fd := newField("", prop)
+		params = append(params, queryParam{
+			Name:     prop.Name,
+			Field:    fd.Name,
+			Type:     fd.Type,
+			Required: prop.Required,
+			format:   timeFormats[prop.Type],
+			isFile:   prop.Type == "file" || prop.Type == "file[]",
+		})
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].Name < params[j].Name
+	})
+	return params
+}
+
+// queryStringName returns the name of the query string object of a method,
+// the object of the type is used if the query string is only a type name.
+func queryStringName(m *raml.Method, prefix string) string {
+	qs := m.QueryStringType()
+	if qs == nil {
+		return ""
+	}
+	if name := commons.QueryStringTypeName(qs); name != "" {
+		return toNimType(name)
+	}
+	return prefix + commons.QueryStringSuffix
+}
+
+// Decode returns the Nim expression which decodes
+// the query parameter value `v` to the field type.
+// It returns empty string if the type can't be decoded from a query parameter.
+func (qp queryParam) Decode(v string) string {
+	switch {
+	case qp.isFile && qp.Type == "seq[string]": // jester only keeps one file of a name
+		return fmt.Sprintf("@[%v]", v)
+	case qp.isFile:
+		return v
+	case qp.format != "":
+		return fmt.Sprintf(`parse(%v, "%v")`, v, qp.format)
+	case qp.Type == "string":
+		return v
+	case qp.Type == "int":
+		return fmt.Sprintf("parseInt(%v)", v)
+	case qp.Type == "float64":
+		return fmt.Sprintf("parseFloat(%v)", v)
+	case qp.Type == "bool": // the value is checked to be true or false by checkParams
+		return fmt.Sprintf(`(%v == "true")`, v)
+	case qp.Type == "seq[string]":
+		return fmt.Sprintf("%v.split(',')", v)
+	case qp.Type == "seq[int]":
+		return fmt.Sprintf("%v.split(',').map(parseInt)", v)
+	case qp.Type == "seq[float64]":
+		return fmt.Sprintf("%v.split(',').map(parseFloat)", v)
+	}
+	return ""
+}
+
+// Encode returns the Nim expression which encodes the field `v` to a query parameter value
+func (qp queryParam) Encode(v string) string {
+	switch {
+	case qp.format != "":
+		return fmt.Sprintf(`%v.format("%v")`, v, qp.format)
+	case qp.Type == "string":
+		return v
+	case len(qp.Type) > 4 && qp.Type[:4] == "seq[":
+		return fmt.Sprintf(`%v.mapIt($it).join(",")`, v)
+	}
+	return "$" + v
+}
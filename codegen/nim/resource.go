@@ -37,13 +37,20 @@ func (r *resource) Imports() []string {
 		if m.RespBody != "" && objectRegistered(m.RespBody) {
 			ip[m.RespBody] = struct{}{}
 		}
+		if m.QueryString != "" && objectRegistered(m.QueryString) {
+			ip[m.QueryString] = struct{}{}
+		}
 	}
 	return commons.MapToSortedStrings(ip)
 }
 
-// generate server resource API implementation
-func (r *resource) generate(dir string) error {
+// generate server resource API implementation,
+// the implementation of the procs is kept in protected regions if preserveRegions is true
+func (r *resource) generate(dir string, preserveRegions bool) error {
 	filename := filepath.Join(dir, r.apiName()+".nim")
+	if preserveRegions {
+		return commons.GenerateFileWithRegions(r, "./templates/server_resources_api_nim.tmpl", "server_resources_api_nim", filename, "#")
+	}
 	return commons.GenerateFile(r, "./templates/server_resources_api_nim.tmpl", "server_resources_api_nim", filename, true)
 }
 
@@ -52,6 +59,37 @@ func (r *resource) apiName() string {
 	return strings.ToLower(r.Name) + "_api"
 }
 
+// HasQueryString returns true if one of the methods of this resource has a query string
+func (r *resource) HasQueryString() bool {
+	for _, mi := range r.Methods {
+		if mi.(method).QueryString != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasFormBody returns true if one of the methods of this resource has a form request body
+func (r *resource) HasFormBody() bool {
+	for _, mi := range r.Methods {
+		if m := mi.(method); m.ReqBody != "" && m.ReqBodyIsForm() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMultipartBody returns true if one of the methods of this resource
+// has a multipart/form-data request body
+func (r *resource) HasMultipartBody() bool {
+	for _, mi := range r.Methods {
+		if m := mi.(method); m.ReqBody != "" && m.ReqBodyIsMultipart() {
+			return true
+		}
+	}
+	return false
+}
+
 // NeedJWT returns true if this resource need JWT Library
 func (r *resource) NeedJWT() bool {
 	for _, mi := range r.Methods {
@@ -14,6 +14,10 @@ type Server struct {
 	Title      string
 	APIDocsDir string
 	Resources  []resource
+
+	// PreserveRegions keeps the code of the protected regions of the existing resource files,
+	// they are overwritten otherwise
+	PreserveRegions bool
 }
 
 // NewServer creates a new Nim server
@@ -46,7 +50,7 @@ func (s *Server) Generate() error {
 	}
 
 	// API implementation
-	if err := generateResourceAPIs(s.Resources, s.Dir); err != nil {
+	if err := generateResourceAPIs(s.Resources, s.Dir, s.PreserveRegions); err != nil {
 		return err
 	}
 
@@ -55,6 +59,13 @@ func (s *Server) Generate() error {
 		return err
 	}
 
+	// parameters validator
+	if s.HasParams() {
+		if err := commons.GenerateFile(s, "./templates/params_nim.tmpl", "params_nim", filepath.Join(s.Dir, "params.nim"), true); err != nil {
+			return err
+		}
+	}
+
 	// HTML front page
 	if err := commons.GenerateFile(s, "./templates/index.html.tmpl", "index.html", filepath.Join(s.Dir, "index.html"), false); err != nil {
 		return err
@@ -94,6 +105,18 @@ func (s *Server) Imports() []string {
 	return commons.MapToSortedStrings(imports)
 }
 
+// HasParams returns true if the server validates the parameters of a request
+func (s *Server) HasParams() bool {
+	for _, r := range s.Resources {
+		for _, mi := range r.Methods {
+			if len(mi.(method).requestParams()) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // check if this server need to have jwt lib
 func (s *Server) needJWT() bool {
 	for _, r := range s.Resources {
@@ -103,9 +126,9 @@ func (s *Server) needJWT() bool {
 	}
 	return false
 }
-func generateResourceAPIs(rs []resource, dir string) error {
+func generateResourceAPIs(rs []resource, dir string, preserveRegions bool) error {
 	for _, r := range rs {
-		if err := r.generate(dir); err != nil {
+		if err := r.generate(dir, preserveRegions); err != nil {
 			return err
 		}
 	}
@@ -52,3 +52,135 @@ func TestGenerateServer(t *testing.T) {
 		})
 	})
 }
+
+func TestGenerateServerQueryString(t *testing.T) {
+	Convey("generate server with query strings", t, func() {
+		var apiDef raml.APIDefinition
+		err := raml.ParseFile("../fixtures/query_string/api.raml", &apiDef)
+		So(err, ShouldBeNil)
+
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		ns := Server{
+			Title:      apiDef.Title,
+			APIDef:     &apiDef,
+			APIDocsDir: "apidocs",
+			Dir:        targetDir,
+		}
+		err = ns.Generate()
+		So(err, ShouldBeNil)
+
+		rootFixture := "./fixtures/server/query_string"
+		checks := []struct {
+			Result   string
+			Expected string
+		}{
+			{"places_api.nim", "places_api.nim"},
+			{"events_api.nim", "events_api.nim"},
+			{"placesGetQueryString.nim", "placesGetQueryString.nim"},
+			{"main.nim", "main.nim"},
+		}
+
+		for _, check := range checks {
+			s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+			So(err, ShouldBeNil)
+
+			So(s, ShouldEqual, tmpl)
+		}
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
+
+func TestGenerateServerFormBodies(t *testing.T) {
+	Convey("generate server with form bodies", t, func() {
+		var apiDef raml.APIDefinition
+		err := raml.ParseFile("../fixtures/media_types/api.raml", &apiDef)
+		So(err, ShouldBeNil)
+
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		ns := Server{
+			Title:      apiDef.Title,
+			APIDef:     &apiDef,
+			APIDocsDir: "apidocs",
+			Dir:        targetDir,
+		}
+		err = ns.Generate()
+		So(err, ShouldBeNil)
+
+		rootFixture := "./fixtures/server/media_types"
+		checks := []struct {
+			Result   string
+			Expected string
+		}{
+			{"avatars_api.nim", "avatars_api.nim"},
+			{"avatarsPostReqBody.nim", "avatarsPostReqBody.nim"},
+			{"main.nim", "main.nim"},
+		}
+
+		for _, check := range checks {
+			s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+			So(err, ShouldBeNil)
+
+			So(s, ShouldEqual, tmpl)
+		}
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
+
+func TestGenerateServerParams(t *testing.T) {
+	Convey("generate server with validated request parameters", t, func() {
+		var apiDef raml.APIDefinition
+		err := raml.ParseFile("../fixtures/params/api.raml", &apiDef)
+		So(err, ShouldBeNil)
+
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		ns := Server{
+			Title:      apiDef.Title,
+			APIDef:     &apiDef,
+			APIDocsDir: "apidocs",
+			Dir:        targetDir,
+		}
+		err = ns.Generate()
+		So(err, ShouldBeNil)
+
+		rootFixture := "./fixtures/server/params"
+		checks := []struct {
+			Result   string
+			Expected string
+		}{
+			{"main.nim", "main.nim"},
+			{"users_api.nim", "users_api.nim"},
+		}
+
+		for _, check := range checks {
+			s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+			So(err, ShouldBeNil)
+
+			So(s, ShouldEqual, tmpl)
+		}
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
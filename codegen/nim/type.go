@@ -2,6 +2,8 @@ package nim
 
 import (
 	"strings"
+
+	"github.com/Jumpscale/go-raml/raml"
 )
 
 var (
@@ -18,14 +20,41 @@ var (
 )
 
 func toNimType(t string) string {
-	if v, ok := typeMap[t]; ok {
-		return v
+	te, err := raml.ParseTypeExpr(t)
+	if err != nil {
+		return t
+	}
+	return typeExprToNim(te)
+}
+
+// convert from raml type expression to nim type
+func typeExprToNim(te *raml.TypeExpr) string {
+	switch te.Kind {
+	case raml.TypeExprArray:
+		return "seq[" + typeExprToNim(te.Items) + "]"
+	case raml.TypeExprMap:
+		return "Table[string, " + typeExprToNim(te.Items) + "]"
+	case raml.TypeExprName:
+	default:
+		return te.String()
 	}
-	// other types that need some processing
-	switch {
-	case strings.HasSuffix(t, "[]"): // array
-		return "seq[" + toNimType(t[:len(t)-2]) + "]"
+	if v, ok := typeMap[te.Name]; ok {
+		return v
 	}
+	return te.Name
+}
 
-	return t
+// elemType returns the element type of Nim seq and Table types,
+// e.g. `Pet` of `seq[Pet]`
+func elemType(t string) string {
+	for {
+		switch {
+		case strings.HasPrefix(t, "seq[") && strings.HasSuffix(t, "]"):
+			t = t[len("seq[") : len(t)-1]
+		case strings.HasPrefix(t, "Table[string, ") && strings.HasSuffix(t, "]"):
+			t = t[len("Table[string, ") : len(t)-1]
+		default:
+			return t
+		}
+	}
 }
@@ -30,7 +30,11 @@ func newClass(name, description string, properties map[string]interface{}) class
 
 	// generate fields
 	for k, v := range properties {
-		field, err := newField(name, raml.ToProperty(k, v))
+		prop := raml.ToProperty(k, v)
+		if prop.IsPattern() { // pattern properties are not supported
+			continue
+		}
+		field, err := newField(name, prop)
 		if err != nil {
 			continue
 		}
@@ -75,7 +79,7 @@ func (pc *class) handleAdvancedType() {
 	}
 }
 
-// generate all classes from all  methods request/response bodies
+// generate all classes from all  methods request/response bodies and query strings
 func generateClassesFromBodies(rs []pythonResource, dir string) error {
 	for _, r := range rs {
 		for _, mi := range r.Methods {
@@ -83,11 +87,29 @@ func generateClassesFromBodies(rs []pythonResource, dir string) error {
 			if err := generateClassesFromMethod(m, dir); err != nil {
 				return err
 			}
+			if err := generateClassFromQueryString(m, r.APIDef.Types, dir); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// generate class of the query string of a method.
+// Nothing is generated if the query string is only a type name, the class of the type is used.
+func generateClassFromQueryString(m serverMethod, types map[string]raml.Type, dir string) error {
+	qs := m.Method.Method.QueryStringType()
+	if qs == nil || commons.QueryStringTypeName(qs) != "" {
+		return nil
+	}
+	props := m.QueryStringProperties(types)
+	if m.Method.Method.QueryString == nil { // query parameters
+		props = commons.WithoutParamFacets(props)
+	}
+	class := newClass(m.QueryString, qs.Description, props)
+	return class.generate(dir)
+}
+
 // generate classes from a method
 //
 // TODO:
@@ -97,7 +119,15 @@ func generateClassesFromMethod(m serverMethod, dir string) error {
 	// request body
 	if commons.HasJSONBody(&m.Bodies) {
 		name := inflect.UpperCamelCase(m.MethodName + "ReqBody")
-		class := newClass(name, "", m.Bodies.ApplicationJSON.Properties)
+		if err := generateClassesFromBody(name, &m.Bodies, dir); err != nil {
+			return err
+		}
+	}
+
+	// form request body
+	if _, form := m.Bodies.FormBody(); form != nil && len(form.Properties) > 0 {
+		name := inflect.UpperCamelCase(m.MethodName + "ReqBody")
+		class := newClass(name, form.Description, form.Properties)
 		if err := class.generate(dir); err != nil {
 			return err
 		}
@@ -109,14 +139,27 @@ func generateClassesFromMethod(m serverMethod, dir string) error {
 			continue
 		}
 		name := inflect.UpperCamelCase(m.MethodName + "RespBody")
-		class := newClass(name, "", r.Bodies.ApplicationJSON.Properties)
-		if err := class.generate(dir); err != nil {
+		if err := generateClassesFromBody(name, &r.Bodies, dir); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// generate class of a request/response body,
+// a JSON schema body might generates more than one class
+func generateClassesFromBody(name string, body *raml.Bodies, dir string) error {
+	if raml.IsJSONSchema(body.ApplicationJSON.Type) {
+		types, err := raml.JSONSchemaTypes(name, body.ApplicationJSON.Type)
+		if err != nil {
+			return err
+		}
+		return generateClasses(types, dir)
+	}
+	class := newClass(name, "", body.ApplicationJSON.Properties)
+	return class.generate(dir)
+}
+
 // return list of import statements
 func (pc class) Imports() []string {
 	var imports []string
@@ -135,8 +178,25 @@ func (pc class) Imports() []string {
 	return imports
 }
 
+// FormKeys returns the form keys of the fields which are not named after their form key,
+// e.g. the python keywords
+func (pc class) FormKeys() map[string]string {
+	keys := map[string]string{}
+	for _, f := range pc.Fields {
+		if f.FormKey != "" {
+			keys[f.Name] = f.FormKey
+		}
+	}
+	return keys
+}
+
 // generate all python classes from an RAML document
 func generateClasses(types map[string]raml.Type, dir string) error {
+	// the types declared using JSON schema
+	types, err := raml.ExpandJSONSchemaTypes(types)
+	if err != nil {
+		return err
+	}
 	for k, t := range types {
 		pc := newClassFromType(t, k)
 		if err := pc.generate(dir); err != nil {
@@ -72,6 +72,86 @@ func TestGeneratePythonClass(t *testing.T) {
 
 		})
 
+		Convey("python class from raml with JSON schema references", func() {
+			err := raml.ParseFile("../fixtures/struct/jsonschema/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			err = generateClasses(apiDef.Types, targetDir)
+			So(err, ShouldBeNil)
+
+			err = generateClassesFromBodies(getAllResources(apiDef, true), targetDir)
+			So(err, ShouldBeNil)
+
+			rootFixture := "./fixtures/class/jsonschema/"
+			checks := []struct {
+				Result   string
+				Expected string
+			}{
+				{"Pet.py", "Pet.py"},
+				{"PetTag.py", "PetTag.py"},
+				{"PetsPostReqBody.py", "PetsPostReqBody.py"},
+				// types without properties
+				{"OwnerContact.py", "OwnerContact.py"},
+				{"OwnerCommonId.py", "OwnerCommonId.py"},
+				{"PetCommonId.py", "PetCommonId.py"},
+				{"PetsPostRespBodyCommonId.py", "PetsPostRespBodyCommonId.py"},
+			}
+
+			for _, check := range checks {
+				s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+
+			out, err := testPyCompile(targetDir)
+			So(out, ShouldEqual, "")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("python class from raml with XML schema type", func() {
+			err := raml.ParseFile("../fixtures/struct/xml/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			err = generateClasses(apiDef.Types, targetDir)
+			So(err, ShouldBeNil)
+
+			s, err := testLoadFile(filepath.Join(targetDir, "Order.py"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("./fixtures/class/xml/Order.py")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+
+			out, err := testPyCompile(targetDir)
+			So(out, ShouldEqual, "")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("python class from raml with pattern properties only", func() {
+			err := raml.ParseFile("../fixtures/struct/facets/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			err = generateClasses(apiDef.Types, targetDir)
+			So(err, ShouldBeNil)
+
+			for _, name := range []string{"Labels.py", "Settings.py"} {
+				s, err := testLoadFile(filepath.Join(targetDir, name))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join("./fixtures/class/facets", name))
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, tmpl)
+			}
+
+			out, err := testPyCompile(targetDir)
+			So(out, ShouldEqual, "")
+			So(err, ShouldBeNil)
+		})
+
 		Reset(func() {
 			os.RemoveAll(targetDir)
 		})
@@ -14,6 +14,9 @@ import (
 
 var (
 	globAPIDef *raml.APIDefinition
+
+	// keep the code of the protected regions of the existing resource files
+	globPreserveRegions bool
 )
 
 // Client represents a python client
@@ -3,6 +3,7 @@ package python
 import (
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"testing"
 
@@ -47,6 +48,40 @@ func TestGeneratePythonClientFromRaml(t *testing.T) {
 			}
 		})
 
+		Convey("client with query strings", func() {
+			apiDef := new(raml.APIDefinition)
+			err := raml.ParseFile("../fixtures/query_string/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			client := NewClient(apiDef)
+			err = client.Generate(targetDir)
+			So(err, ShouldBeNil)
+
+			s, err := testLoadFile(filepath.Join(targetDir, "places_service.py"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("../fixtures/query_string/places_service.py")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+		})
+
+		Convey("client with form bodies", func() {
+			apiDef := new(raml.APIDefinition)
+			err := raml.ParseFile("../fixtures/media_types/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			client := NewClient(apiDef)
+			err = client.Generate(targetDir)
+			So(err, ShouldBeNil)
+
+			s, err := testLoadFile(filepath.Join(targetDir, "avatars_service.py"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("../fixtures/media_types/avatars_service.py")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+		})
+
 		Reset(func() {
 			os.RemoveAll(targetDir)
 		})
@@ -57,3 +92,20 @@ func testLoadFile(filename string) (string, error) {
 	b, err := ioutil.ReadFile(filename)
 	return string(b), err
 }
+
+// testPyCompile compiles all python files of a directory
+// and returns the errors output, nothing is checked if python3 is not installed
+func testPyCompile(dir string) (string, error) {
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		return "", nil
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.py"))
+	if err != nil || len(files) == 0 {
+		return "", err
+	}
+	cmd := exec.Command(python, append([]string{"-m", "py_compile"}, files...)...)
+	cmd.Env = append(os.Environ(), "PYTHONDONTWRITEBYTECODE=1")
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
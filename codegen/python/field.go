@@ -2,6 +2,7 @@ package python
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -10,6 +11,29 @@ import (
 	"github.com/Jumpscale/go-raml/raml"
 )
 
+var (
+	regNonIdentifier = regexp.MustCompile(`\W`)
+
+	// python keywords, which can't be used as field name
+	pythonKeywords = map[string]bool{
+		"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true,
+		"async": true, "await": true, "break": true, "class": true, "continue": true,
+		"def": true, "del": true, "elif": true, "else": true, "except": true, "exec": true,
+		"finally": true, "for": true, "from": true, "global": true, "if": true, "import": true,
+		"in": true, "is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+		"pass": true, "print": true, "raise": true, "return": true, "try": true,
+		"while": true, "with": true, "yield": true,
+	}
+
+	// wtforms fields and formats of the date and time types
+	dateTimeFields = map[string]struct{ Type, Format string }{
+		"date-only":     {"DateField", "%Y-%m-%d"},
+		"time-only":     {"DateTimeField", "%H:%M:%S"},
+		"datetime-only": {"DateTimeField", "%Y-%m-%dT%H:%M:%S"},
+		"datetime":      {"DateTimeField", "%Y-%m-%dT%H:%M:%SZ"},
+	}
+)
+
 // pythons class's field
 type field struct {
 	Name        string
@@ -17,7 +41,9 @@ type field struct {
 	Required    bool
 	Validators  string
 	Enum        *enum
+	FormKey     string // the form key of the field if it is not the field name, e.g. of a python keyword
 	ramlType    string // the original raml type
+	format      string // format of the date and time field
 	isFormField bool
 	isList      bool                // it is a list field
 	validators  map[string][]string // array of validators, only used to build `Validators` field
@@ -25,9 +51,12 @@ type field struct {
 
 func newField(className string, prop raml.Property) (field, error) {
 	f := field{
-		Name:     prop.Name,
+		Name:     fieldName(prop.Name),
 		Required: prop.Required,
 	}
+	if key := regNonIdentifier.ReplaceAllString(prop.Name, "_"); key != f.Name {
+		f.FormKey = key
+	}
 
 	if prop.IsEnum() {
 		f.Enum = newEnum(className, prop, false)
@@ -43,10 +72,52 @@ func newField(className string, prop raml.Property) (field, error) {
 	return f, nil
 }
 
+// fieldName returns the python name of the field of a property,
+// the characters not allowed in an identifier are replaced by `_`, e.g. `page-size` becomes `page_size`,
+// and python keywords get `_` suffix, e.g. `from` becomes `from_`
+func fieldName(propName string) string {
+	name := regNonIdentifier.ReplaceAllString(propName, "_")
+	if pythonKeywords[name] {
+		name += "_"
+	}
+	return name
+}
+
 // convert from raml Type to python wtforms type
 func (pf *field) setType(t string) {
 	pf.ramlType = t
-	switch t {
+	te, err := raml.ParseTypeExpr(t)
+	if err != nil {
+		log.Infof("invalid type expression `%v`, ignore it", t)
+		return
+	}
+	pf.setTypeExpr(te)
+}
+
+// convert from raml type expression to python wtforms type
+func (pf *field) setTypeExpr(te *raml.TypeExpr) {
+	switch te.Kind {
+	case raml.TypeExprArray:
+		if te.Items.Kind == raml.TypeExprArray { // multidimensional array
+			log.Info("validator has no support for multidimensional array, ignore it")
+			return
+		}
+		pf.isList = true
+		pf.setTypeExpr(te.Items)
+		return
+	case raml.TypeExprMap:
+		log.Info("validator has no support for map, ignore it")
+		return
+	case raml.TypeExprUnion:
+		log.Info("validator has no support for union, ignore it")
+		return
+	case raml.TypeExprName:
+	default:
+		log.Infof("validator has no support for type `%v`, ignore it", te)
+		return
+	}
+
+	switch t := te.Name; t {
 	case "string":
 		pf.Type = "TextField"
 	case "file":
@@ -59,31 +130,15 @@ func (pf *field) setType(t string) {
 		pf.Type = "BooleanField"
 	case "date":
 		pf.Type = "DateField"
-	}
-
-	if pf.Type != "" { // type already set, no need to go down
-		return
-	}
-
-	// other types that need some processing
-	switch {
-	case strings.HasSuffix(t, "[][]"): // bidimensional array
-		log.Info("validator has no support for bidimensional array, ignore it")
-	case strings.HasSuffix(t, "[]"): // array
-		pf.isList = true
-		pf.setType(t[:len(t)-2])
-	case strings.HasSuffix(t, "{}"): // map
-		log.Info("validator has no support for map, ignore it")
-	case strings.Index(t, "|") > 0:
-		log.Info("validator has no support for union, ignore it")
-	case strings.Index(t, ".") > 1:
-		pf.Type = t[strings.Index(t, ".")+1:]
-		pf.isFormField = true
+	case "date-only", "time-only", "datetime-only", "datetime":
+		pf.Type, pf.format = dateTimeFields[t].Type, dateTimeFields[t].Format
 	default:
+		if strings.Index(t, ".") > 1 { // type from library
+			t = t[strings.Index(t, ".")+1:]
+		}
 		pf.isFormField = true
 		pf.Type = t
 	}
-
 }
 
 func (pf *field) addValidator(name, arg string, val interface{}) {
@@ -153,10 +208,14 @@ func (pf field) WTFType() string {
 	switch {
 	case pf.isList && pf.isFormField:
 		return fmt.Sprintf("FieldList(FormField(%v))", pf.Type)
+	case pf.isList && pf.format != "":
+		return fmt.Sprintf("FieldList(%v('%v', [required()], format='%v'), %v)", pf.Type, pf.Name, pf.format, pf.Validators)
 	case pf.isList:
 		return fmt.Sprintf("FieldList(%v('%v', [required()]), %v)", pf.Type, pf.Name, pf.Validators)
 	case pf.isFormField:
 		return fmt.Sprintf("FormField(%v)", pf.Type)
+	case pf.format != "":
+		return fmt.Sprintf("%v(validators=[%v], format='%v')", pf.Type, pf.Validators, pf.format)
 	default:
 		return fmt.Sprintf("%v(validators=[%v])", pf.Type, pf.Validators)
 	}
@@ -0,0 +1,20 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, DateTimeField, FieldList
+from input_validators import multiple_of
+
+from PetCommonAudit import PetCommonAudit
+from PetCommonId import PetCommonId
+from PetTag import PetTag
+
+
+class Pet(Form):
+    
+    age = IntegerField(validators=[NumberRange(min=1)])
+    audit = FormField(PetCommonAudit)
+    id = FormField(PetCommonId)
+    kind = EnumPetKind(validators=[])
+    name = TextField(validators=[DataRequired(message=""), Length(max=64)])
+    nickname = TextField(validators=[])
+    tags = FieldList(FormField(PetTag))
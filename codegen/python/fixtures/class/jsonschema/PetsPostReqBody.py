@@ -0,0 +1,13 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, DateTimeField, FieldList
+from input_validators import multiple_of
+
+from PetsPostReqBodyTag import PetsPostReqBodyTag
+
+
+class PetsPostReqBody(Form):
+    
+    name = TextField(validators=[DataRequired(message=""), Length(min=1)])
+    tags = FieldList(FormField(PetsPostReqBodyTag))
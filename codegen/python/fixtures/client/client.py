@@ -31,4 +31,13 @@ class Client:
         if type(data) is str:
             return self.session.patch(uri, data=data, headers=headers, params=params)
         else:
-            return self.session.patch(uri, json=data, headers=headers, params=params)
\ No newline at end of file
+            return self.session.patch(uri, json=data, headers=headers, params=params)
+
+    def send_form(self, method, uri, data, headers, params, files=None):
+        '''
+        send an application/x-www-form-urlencoded body,
+        or a multipart/form-data body if there are files
+        '''
+        # the content type of the session is replaced by the content type of the form
+        headers = dict(headers or {}, **{"Content-Type": None})
+        return self.session.request(method, uri, data=data, files=files, headers=headers, params=params)
\ No newline at end of file
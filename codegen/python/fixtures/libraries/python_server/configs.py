@@ -2,6 +2,8 @@ from flask import Blueprint, jsonify, request
 
 import libraries.security.oauth2_Dropbox as oauth2_Dropbox
 
+# go-raml:begin imports
+# go-raml:end
 
 configs_api = Blueprint('configs_api', __name__)
 
@@ -14,7 +16,9 @@ def configs_get():
     It is handler for GET /configs
     '''
     
+    # go-raml:begin GET /configs
     return jsonify()
+    # go-raml:end
 
 
 @configs_api.route('/configs', methods=['POST'])
@@ -24,7 +28,9 @@ def configs_post():
     It is handler for POST /configs
     '''
     
+    # go-raml:begin POST /configs
     return jsonify()
+    # go-raml:end
 
 
 @configs_api.route('/configs', methods=['PUT'])
@@ -34,4 +40,6 @@ def configs_put():
     It is handler for PUT /configs
     '''
     
+    # go-raml:begin PUT /configs
     return jsonify()
+    # go-raml:end
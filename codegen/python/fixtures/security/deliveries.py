@@ -3,6 +3,8 @@ from flask import Blueprint, jsonify, request
 import oauth2_Facebook as oauth2_Facebook
 import oauth2_Dropbox as oauth2_Dropbox
 
+# go-raml:begin imports
+# go-raml:end
 
 deliveries_api = Blueprint('deliveries_api', __name__)
 
@@ -15,7 +17,9 @@ def deliveries_get():
     It is handler for GET /deliveries
     '''
     
+    # go-raml:begin GET /deliveries
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries', methods=['POST'])
@@ -26,7 +30,9 @@ def deliveries_post():
     It is handler for POST /deliveries
     '''
     
+    # go-raml:begin POST /deliveries
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries/<deliveryId>', methods=['GET'])
@@ -36,7 +42,9 @@ def deliveries_byDeliveryId_get(deliveryId):
     It is handler for GET /deliveries/<deliveryId>
     '''
     
+    # go-raml:begin GET /deliveries/<deliveryId>
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries/<deliveryId>', methods=['PATCH'])
@@ -47,7 +55,9 @@ def deliveries_byDeliveryId_patch(deliveryId):
     It is handler for PATCH /deliveries/<deliveryId>
     '''
     
+    # go-raml:begin PATCH /deliveries/<deliveryId>
     return jsonify()
+    # go-raml:end
 
 
 @deliveries_api.route('/deliveries/<deliveryId>', methods=['DELETE'])
@@ -58,4 +68,6 @@ def deliveries_byDeliveryId_delete(deliveryId):
     It is handler for DELETE /deliveries/<deliveryId>
     '''
     
+    # go-raml:begin DELETE /deliveries/<deliveryId>
     return jsonify()
+    # go-raml:end
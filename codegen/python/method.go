@@ -2,6 +2,7 @@ package python
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/Jumpscale/go-raml/codegen/commons"
@@ -50,6 +51,9 @@ type clientMethod struct {
 	resource.Method
 	PRArgs string // python requests's args
 	PRCall string // the way we call python request
+
+	QueryStringParams string // comma separated names of the query string parameters
+	FormFiles         string // comma separated names of the files of a multipart/form-data body
 }
 
 func newClientMethod(r *raml.Resource, rd *resource.Resource, m *raml.Method, methodName string) (resource.MethodInterface, error) {
@@ -62,6 +66,17 @@ func newClientMethod(r *raml.Resource, rd *resource.Resource, m *raml.Method, me
 	method.ReqBody = setBodyName(m.Bodies, name+methodName, "ReqBody")
 
 	pcm := clientMethod{Method: method}
+	if mediaType, form := m.Bodies.FormBody(); mediaType == raml.MediaTypeMultipart {
+		pcm.FormFiles = strings.Join(formFiles(form), ", ")
+	}
+	if m.QueryStringType() != nil {
+		var names []string
+		for name := range m.QueryStringProperties(rd.APIDef.Types) {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		pcm.QueryStringParams = strings.Join(names, ", ")
+	}
 	pcm.setup()
 	return pcm, nil
 }
@@ -76,12 +91,29 @@ func (pcm *clientMethod) setup() {
 		prArgs = append(prArgs, "data")
 	}
 
+	// the form bodies are sent with the files of the multipart/form-data body
+	if pcm.ReqBodyIsForm() {
+		prArgs = append([]string{`"` + pcm.Verb() + `"`}, prArgs...)
+		if pcm.ReqBodyIsMultipart() {
+			params = append(params, "files=None")
+			prArgs = append(prArgs, "files=files")
+		}
+	}
+
 	// construct prArgs string from the array
-	prArgs = append(prArgs, "headers=headers", "params=query_params")
+	// the query string dict is merged with the query params
+	if pcm.QueryStringParams != "" {
+		prArgs = append(prArgs, "headers=headers", "params=dict(query_string or {}, **(query_params or {}))")
+	} else {
+		prArgs = append(prArgs, "headers=headers", "params=query_params")
+	}
 	pcm.PRArgs = strings.Join(prArgs, ", ")
 
 	// construct method signature
 	params = append(params, resource.GetResourceParams(pcm.Resource())...)
+	if pcm.QueryStringParams != "" {
+		params = append(params, "query_string=None")
+	}
 	params = append(params, "headers=None", "query_params=None")
 	pcm.Params = strings.Join(params, ", ")
 
@@ -89,7 +121,9 @@ func (pcm *clientMethod) setup() {
 	// we encapsulate the call to put, post, and patch.
 	// To be able to accept plain string or dict.
 	// if it is a dict, we encode it to json
-	if pcm.Verb() == "PUT" || pcm.Verb() == "POST" || pcm.Verb() == "PATCH" {
+	if pcm.ReqBodyIsForm() {
+		pcm.PRCall = "self.client.send_form"
+	} else if pcm.Verb() == "PUT" || pcm.Verb() == "POST" || pcm.Verb() == "PATCH" {
 		pcm.PRCall = fmt.Sprintf("self.client.%v", strings.ToLower(pcm.Verb()))
 	} else {
 		pcm.PRCall = fmt.Sprintf("self.client.session.%v", strings.ToLower(pcm.Verb()))
@@ -102,12 +136,26 @@ func (pcm *clientMethod) setup() {
 	}
 }
 
+// formFiles returns the sorted names of the file properties of a form body
+func formFiles(body *raml.Body) []string {
+	var names []string
+	for name, v := range body.Properties {
+		prop := raml.ToProperty(name, v)
+		if prop.Type == "file" || prop.Type == "file[]" {
+			names = append(names, prop.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 // create server resource's method
 func newServerMethod(apiDef *raml.APIDefinition, r *raml.Resource, rd *resource.Resource, m *raml.Method,
 	methodName string) resource.MethodInterface {
 
 	method := resource.NewMethod(r, rd, m, methodName, setBodyName)
 	method.SecuredBy = security.GetMethodSecuredBy(apiDef, r, m)
+	method.QueryString = queryStringName(m, method.Endpoint+methodName)
 
 	pm := serverMethod{
 		Method: &method,
@@ -145,11 +193,26 @@ func _snakeCaseResourceURI(r *raml.Resource, completeURI string) string {
 	return _snakeCaseResourceURI(r.Parent, snake+completeURI)
 }
 
+// queryStringName returns the name of the query string class of a method,
+// the class of the type is used if the query string is only a type name.
+func queryStringName(m *raml.Method, prefix string) string {
+	qs := m.QueryStringType()
+	if qs == nil {
+		return ""
+	}
+	if name := commons.QueryStringTypeName(qs); name != "" {
+		return name
+	}
+	return commons.NormalizeURITitle(prefix) + commons.QueryStringSuffix
+}
+
 // setBodyName set name of method's request/response body.
 //
 // Rules:
 //  - use bodies.Type if not empty and not `object`
 //  - use bodies.ApplicationJSON.Type if not empty and not `object`
+//  - use the type of the multipart/form-data or application/x-www-form-urlencoded body
+//    if not empty and not `object`, if there is no JSON nor XML body
 //  - use prefix+suffix if:
 //      - not meet previous rules
 //      - previous rules produces JSON string
@@ -165,6 +228,12 @@ func setBodyName(bodies raml.Bodies, prefix, suffix string) string {
 		} else {
 			tipe = prefix + suffix
 		}
+	} else if _, form := bodies.FormBody(); form != nil {
+		if form.Type != "" && form.Type != "object" {
+			tipe = form.Type
+		} else if len(form.Properties) > 0 {
+			tipe = prefix + suffix
+		}
 	}
 
 	if commons.IsJSONString(tipe) {
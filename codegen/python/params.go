@@ -0,0 +1,73 @@
+package python
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/codegen/resource"
+)
+
+// ParamDicts returns the dicts given to params_validator.validate
+// of the parameters of the method which are validated before calling the handler
+func (sm serverMethod) ParamDicts() []string {
+	var dicts []string
+	for _, p := range sm.RequestParams() {
+		dicts = append(dicts, paramDict(p))
+	}
+	return dicts
+}
+
+// paramDict returns the python dict of a request parameter
+func paramDict(p resource.RequestParam) string {
+	items := []string{
+		"'in': " + pyString(p.In),
+		"'name': " + pyString(p.Name),
+	}
+	if p.Type != "" && p.Type != "string" {
+		items = append(items, "'type': "+pyString(p.Type))
+	}
+	if p.Array {
+		items = append(items, "'array': True")
+	}
+	if p.Required {
+		items = append(items, "'required': True")
+	}
+	if len(p.Enum) > 0 {
+		var enum []string
+		for _, e := range p.Enum {
+			enum = append(enum, pyString(e))
+		}
+		items = append(items, "'enum': ["+strings.Join(enum, ", ")+"]")
+	}
+	if p.Pattern != "" {
+		items = append(items, "'pattern': "+pyRawString(p.Pattern))
+	}
+	if p.Minimum != nil {
+		items = append(items, fmt.Sprintf("'minimum': %v", *p.Minimum))
+	}
+	if p.Maximum != nil {
+		items = append(items, fmt.Sprintf("'maximum': %v", *p.Maximum))
+	}
+	if p.MinLength != nil {
+		items = append(items, fmt.Sprintf("'min_length': %v", *p.MinLength))
+	}
+	if p.MaxLength != nil {
+		items = append(items, fmt.Sprintf("'max_length': %v", *p.MaxLength))
+	}
+	return "{" + strings.Join(items, ", ") + "}"
+}
+
+// pyString returns the python string literal of s
+func pyString(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	return "'" + strings.Replace(s, "'", `\'`, -1) + "'"
+}
+
+// pyRawString returns a python raw string literal of s, e.g. for a regular expression.
+// An interpreted string literal is returned if s can't be a raw string.
+func pyRawString(s string) string {
+	if strings.Contains(s, "'") || strings.HasSuffix(s, `\`) {
+		return pyString(s)
+	}
+	return "r'" + s + "'"
+}
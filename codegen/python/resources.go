@@ -50,15 +50,51 @@ func (pr *pythonResource) setMiddlewares() {
 }
 
 // generate flask representation of an RAML resource
-// It has one file : an API route and implementation
+// It has one file : an API route and implementation,
+// the implementation of the handlers is kept in protected regions if the regions are preserved.
 func (pr *pythonResource) generate(r *raml.Resource, URI, dir string) error {
 	pr.GenerateMethods(r, "python", newServerMethod, newClientMethod)
 	pr.setMiddlewares()
 	filename := dir + "/" + strings.ToLower(pr.Name) + ".py"
+	if globPreserveRegions {
+		return commons.GenerateFileWithRegions(pr, resourcePyTemplate, "resource_python_template", filename, "#")
+	}
 	return commons.GenerateFile(pr, resourcePyTemplate, "resource_python_template", filename, true)
 }
 
-// return array of request body in this resource
+// HasQueryString returns true if one of the methods of this resource has a query string
+func (pr pythonResource) HasQueryString() bool {
+	for _, m := range pr.Methods {
+		if m.(serverMethod).QueryString != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasParams returns true if one of the methods of this resource
+// has parameters which are validated before calling the handler
+func (pr pythonResource) HasParams() bool {
+	for _, m := range pr.Methods {
+		if len(m.(serverMethod).RequestParams()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMultipartBody returns true if one of the methods of this resource
+// has a multipart/form-data request body
+func (pr pythonResource) HasMultipartBody() bool {
+	for _, m := range pr.Methods {
+		if sm := m.(serverMethod); sm.ReqBody != "" && sm.ReqBodyIsMultipart() {
+			return true
+		}
+	}
+	return false
+}
+
+// return array of request body and query string classes in this resource
 func (pr pythonResource) ReqBodies() []string {
 	var reqs []string
 	for _, m := range pr.Methods {
@@ -66,6 +102,9 @@ func (pr pythonResource) ReqBodies() []string {
 		if pm.ReqBody != "" && !commons.IsStrInArray(reqs, pm.ReqBody) {
 			reqs = append(reqs, pm.ReqBody)
 		}
+		if pm.QueryString != "" && !commons.IsStrInArray(reqs, pm.QueryString) {
+			reqs = append(reqs, pm.QueryString)
+		}
 	}
 	sort.Strings(reqs)
 	return reqs
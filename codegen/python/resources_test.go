@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/Jumpscale/go-raml/raml"
@@ -32,6 +33,173 @@ func TestPythonResource(t *testing.T) {
 			So(s, ShouldEqual, tmpl)
 		})
 
+		Convey("regenerated resource", func() {
+			apiDef := new(raml.APIDefinition)
+			err := raml.ParseFile("../fixtures/regeneration/api_v1.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			globPreserveRegions = true
+			defer func() {
+				globPreserveRegions = false
+			}()
+
+			_, err = generateServerResources(apiDef, targetdir)
+			So(err, ShouldBeNil)
+
+			// implement the handlers
+			filename := filepath.Join(targetdir, "users.py")
+			s, err := testLoadFile(filename)
+			So(err, ShouldBeNil)
+			s = strings.Replace(s, "return jsonify()", "return jsonify(implemented=True)", -1)
+			So(ioutil.WriteFile(filename, []byte(s), 0644), ShouldBeNil)
+
+			// the code of the DELETE handler is commented out
+			// and the PUT handler is added
+			apiDef = new(raml.APIDefinition)
+			err = raml.ParseFile("../fixtures/regeneration/api_v2.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			_, err = generateServerResources(apiDef, targetdir)
+			So(err, ShouldBeNil)
+
+			s, err = testLoadFile(filename)
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("../fixtures/regeneration/users.py")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+		})
+
+		Convey("regenerated resource without protected regions", func() {
+			apiDef := new(raml.APIDefinition)
+			err := raml.ParseFile("../fixtures/regeneration/api_v1.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			globPreserveRegions = true
+			defer func() {
+				globPreserveRegions = false
+			}()
+
+			// the hand written file is neither overwritten nor moved
+			filename := filepath.Join(targetdir, "users.py")
+			So(ioutil.WriteFile(filename, []byte("# hand written\n"), 0644), ShouldBeNil)
+
+			_, err = generateServerResources(apiDef, targetdir)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "doesn't have protected regions")
+
+			s, err := testLoadFile(filename)
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, "# hand written\n")
+			_, err = os.Stat(filename + ".orig")
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("resource with validated request parameters", func() {
+			apiDef := new(raml.APIDefinition)
+			err := raml.ParseFile("../fixtures/params/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			_, err = generateServerResources(apiDef, targetdir)
+			So(err, ShouldBeNil)
+
+			err = generateClassesFromBodies(getAllResources(apiDef, true), targetdir)
+			So(err, ShouldBeNil)
+
+			s, err := testLoadFile(filepath.Join(targetdir, "users.py"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("../fixtures/params/users.py")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+
+			// typed named parameters
+			s, err = testLoadFile(filepath.Join(targetdir, "UsersIdGetQueryString.py"))
+			So(err, ShouldBeNil)
+
+			tmpl, err = testLoadFile("../fixtures/params/UsersIdGetQueryString.py")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+
+			out, err := testPyCompile(targetdir)
+			So(out, ShouldEqual, "")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("resource with query strings", func() {
+			apiDef := new(raml.APIDefinition)
+			err := raml.ParseFile("../fixtures/query_string/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			_, err = generateServerResources(apiDef, targetdir)
+			So(err, ShouldBeNil)
+
+			err = generateClassesFromBodies(getAllResources(apiDef, true), targetdir)
+			So(err, ShouldBeNil)
+
+			// check  api implementation
+			s, err := testLoadFile(filepath.Join(targetdir, "places.py"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("../fixtures/query_string/places.py")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+
+			// check query string class
+			s, err = testLoadFile(filepath.Join(targetdir, "PlacesGetQueryString.py"))
+			So(err, ShouldBeNil)
+
+			tmpl, err = testLoadFile("../fixtures/query_string/PlacesGetQueryString.py")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+
+			// date types and python keyword
+			for _, name := range []string{"EventsGetQueryString.py", "TicketsGetQueryString.py"} {
+				s, err = testLoadFile(filepath.Join(targetdir, name))
+				So(err, ShouldBeNil)
+
+				tmpl, err = testLoadFile(filepath.Join("../fixtures/query_string", name))
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, tmpl)
+			}
+
+			out, err := testPyCompile(targetdir)
+			So(out, ShouldEqual, "")
+			So(err, ShouldBeNil)
+
+			// query string which is only a type name use the class of the type
+			_, err = os.Stat(filepath.Join(targetdir, "PlacesIdPhotosGetQueryString.py"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("resource with form bodies", func() {
+			apiDef := new(raml.APIDefinition)
+			err := raml.ParseFile("../fixtures/media_types/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			_, err = generateServerResources(apiDef, targetdir)
+			So(err, ShouldBeNil)
+
+			err = generateClassesFromBodies(getAllResources(apiDef, true), targetdir)
+			So(err, ShouldBeNil)
+
+			// check  api implementation
+			s, err := testLoadFile(filepath.Join(targetdir, "avatars.py"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("../fixtures/media_types/avatars.py")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+
+			// check multipart/form-data body class
+			s, err = testLoadFile(filepath.Join(targetdir, "AvatarsPostReqBody.py"))
+			So(err, ShouldBeNil)
+
+			tmpl, err = testLoadFile("../fixtures/media_types/AvatarsPostReqBody.py")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, tmpl)
+		})
+
 		Reset(func() {
 			os.RemoveAll(targetdir)
 		})
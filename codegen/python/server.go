@@ -17,6 +17,10 @@ type Server struct {
 	ResourcesDef []resource.ResourceInterface
 	WithMain     bool
 	APIDocsDir   string
+
+	// PreserveRegions keeps the code of the protected regions of the existing resource files,
+	// they are overwritten otherwise
+	PreserveRegions bool
 }
 
 // NewServer creates a new python server
@@ -34,12 +38,19 @@ func NewServer(apiDef *raml.APIDefinition, apiDocsDir string, withMain bool) Ser
 func (ps Server) Generate(dir string) error {
 
 	globAPIDef = ps.APIDef
+	globPreserveRegions = ps.PreserveRegions
 	// generate input validators helper
 	if err := commons.GenerateFile(struct{}{}, "./templates/input_validators_python.tmpl", "input_validators_python",
 		filepath.Join(dir, "input_validators.py"), false); err != nil {
 		return err
 	}
 
+	// generate parameters validator helper
+	if err := commons.GenerateFile(struct{}{}, "./templates/params_validator_python.tmpl", "params_validator_python",
+		filepath.Join(dir, "params_validator.py"), false); err != nil {
+		return err
+	}
+
 	// generate request body
 	if err := generateClassesFromBodies(getAllResources(ps.APIDef, true), dir); err != nil {
 		return err
@@ -21,11 +21,13 @@ type Method struct {
 	verb         string
 	ReqBody      string         // request body type
 	RespBody     string         // response body type
+	QueryString  string         // query string type
 	ResourcePath string         // normalized resource path
 	RAMLResource *raml.Resource // resource object of this method
 	Params       string         // methods params
 	FuncComments []string
 	SecuredBy    []raml.DefinitionChoice
+	respBodies   raml.Bodies // bodies of the response which has RespBody type
 }
 
 func (m Method) Verb() string {
@@ -58,6 +60,7 @@ func NewMethod(r *raml.Resource, rd *Resource, m *raml.Method, methodName string
 		code := commons.AtoiOrPanic(string(k))
 		if code >= 200 && code < 300 {
 			method.RespBody = sbn(v.Bodies, method.Endpoint+methodName, commons.RespBodySuffix)
+			method.respBodies = v.Bodies
 		}
 	}
 
@@ -69,6 +72,27 @@ func NewMethod(r *raml.Resource, rd *Resource, m *raml.Method, methodName string
 	return method
 }
 
+// ReqBodyIsXML returns true if the request body is exchanged as XML
+func (m Method) ReqBodyIsXML() bool {
+	return m.Bodies.IsXML()
+}
+
+// RespBodyIsXML returns true if the response body is exchanged as XML
+func (m Method) RespBodyIsXML() bool {
+	return m.respBodies.IsXML()
+}
+
+// ReqBodyIsForm returns true if the request body is exchanged as
+// multipart/form-data or application/x-www-form-urlencoded
+func (m Method) ReqBodyIsForm() bool {
+	return m.Bodies.IsForm()
+}
+
+// ReqBodyIsMultipart returns true if the request body is exchanged as multipart/form-data
+func (m Method) ReqBodyIsMultipart() bool {
+	return m.Bodies.IsMultipart()
+}
+
 type ByEndpoint []MethodInterface
 
 func (b ByEndpoint) Len() int      { return len(b) }
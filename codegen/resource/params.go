@@ -0,0 +1,236 @@
+package resource
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Jumpscale/go-raml/raml"
+)
+
+// locations of the request parameters
+const (
+	ParamInURI    = "uri"
+	ParamInQuery  = "query"
+	ParamInHeader = "header"
+	ParamInForm   = "form"
+)
+
+var reURIParam = regexp.MustCompile(`{[^{}/]+}`)
+
+// scalar types of the parameters whose values are checked by the servers
+var paramScalarTypes = map[string]bool{
+	"string":        true,
+	"integer":       true,
+	"number":        true,
+	"boolean":       true,
+	"date-only":     true,
+	"time-only":     true,
+	"datetime-only": true,
+	"datetime":      true,
+}
+
+// RequestParam is a URI, query, header or form parameter of a request
+// and the constraints the generated servers validate before calling the handler
+type RequestParam struct {
+	In        string // location of the parameter: uri, query, header or form
+	Name      string
+	Type      string // scalar type of the values, empty if the type isn't checked
+	Array     bool   // the parameter has several values
+	Required  bool   // always false for the URI parameters, which are always present
+	Enum      []string
+	Pattern   string
+	Minimum   *float64
+	Maximum   *float64
+	MinLength *int
+	MaxLength *int
+}
+
+// newRequestParam creates a request parameter from a named parameter
+func newRequestParam(in string, np raml.NamedParameter) RequestParam {
+	rp := RequestParam{
+		In:        in,
+		Name:      np.Name,
+		Required:  np.Required,
+		Minimum:   np.Minimum,
+		Maximum:   np.Maximum,
+		MinLength: np.MinLength,
+		MaxLength: np.MaxLength,
+	}
+	if np.Pattern != nil {
+		rp.Pattern = *np.Pattern
+	}
+	for _, v := range np.EnumValues() {
+		rp.Enum = append(rp.Enum, fmt.Sprint(v))
+	}
+
+	tipe := np.TypeExpr()
+	if strings.HasSuffix(tipe, "[]") {
+		rp.Array = true
+		tipe = strings.TrimSuffix(tipe, "[]")
+	}
+	if paramScalarTypes[tipe] {
+		rp.Type = tipe
+	}
+	if in == ParamInURI {
+		rp.Required = false
+	}
+	return rp
+}
+
+// HasConstraints returns true if the parameter has to be validated,
+// i.e. if it is required, has facets or isn't a string
+func (rp RequestParam) HasConstraints() bool {
+	return rp.Required || (rp.Type != "" && rp.Type != "string") ||
+		len(rp.Enum) > 0 || rp.Pattern != "" || rp.Minimum != nil || rp.Maximum != nil ||
+		rp.MinLength != nil || rp.MaxLength != nil
+}
+
+// layouts of the date and time parameters
+var paramTimeLayouts = map[string]string{
+	"date-only":     "2006-01-02",
+	"time-only":     "15:04:05",
+	"datetime-only": "2006-01-02T15:04:05",
+	"datetime":      time.RFC3339,
+}
+
+// Check returns the messages of the violations of the constraints of a value of the parameter,
+// they are the messages of the validator of the generated Go servers
+func (rp RequestParam) Check(value string) []string {
+	if rp.Type != "" && rp.Type != "string" && !isParamType(rp.Type, value) {
+		return []string{"must be of type " + rp.Type}
+	}
+
+	var msgs []string
+	if len(rp.Enum) > 0 {
+		found := false
+		for _, e := range rp.Enum {
+			if e == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			msgs = append(msgs, "must be one of "+strings.Join(rp.Enum, ", "))
+		}
+	}
+	if rp.Pattern != "" {
+		if re, err := regexp.Compile(rp.Pattern); err == nil && !re.MatchString(value) {
+			msgs = append(msgs, "must match the pattern "+rp.Pattern)
+		}
+	}
+	if rp.Minimum != nil || rp.Maximum != nil {
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			if rp.Minimum != nil && f < *rp.Minimum {
+				msgs = append(msgs, fmt.Sprintf("must be greater than or equal to %v", *rp.Minimum))
+			}
+			if rp.Maximum != nil && f > *rp.Maximum {
+				msgs = append(msgs, fmt.Sprintf("must be less than or equal to %v", *rp.Maximum))
+			}
+		}
+	}
+	length := len([]rune(value))
+	if rp.MinLength != nil && length < *rp.MinLength {
+		msgs = append(msgs, fmt.Sprintf("must have at least %v characters", *rp.MinLength))
+	}
+	if rp.MaxLength != nil && length > *rp.MaxLength {
+		msgs = append(msgs, fmt.Sprintf("must have at most %v characters", *rp.MaxLength))
+	}
+	return msgs
+}
+
+// isParamType returns true if the value is of the given scalar type
+func isParamType(tipe, value string) bool {
+	var err error
+	switch tipe {
+	case "integer":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "number":
+		_, err = strconv.ParseFloat(value, 64)
+	case "boolean": // only true and false, not the other values accepted by strconv.ParseBool
+		return value == "true" || value == "false"
+	default:
+		if layout, ok := paramTimeLayouts[tipe]; ok {
+			_, err = time.Parse(layout, value)
+		}
+	}
+	return err == nil
+}
+
+// RequestParams returns the URI, query and header parameters of the method which have constraints,
+// sorted by location and name. The URI parameters include the parameters of the parent resources.
+func (m Method) RequestParams() []RequestParam {
+	var params []RequestParam
+	add := func(rp RequestParam) {
+		if rp.HasConstraints() {
+			params = append(params, rp)
+		}
+	}
+
+	for r := m.RAMLResource; r != nil; r = r.Parent {
+		for _, match := range reURIParam.FindAllString(r.URI, -1) {
+			name := match[1 : len(match)-1]
+			if np, ok := r.URIParameters[name]; ok {
+				np.Name = name
+				add(newRequestParam(ParamInURI, np))
+			}
+		}
+	}
+	if m.Method != nil {
+		for name, np := range m.QueryParameters {
+			np.Name = name
+			add(newRequestParam(ParamInQuery, np))
+		}
+		for name, h := range m.Headers {
+			np := raml.NamedParameter(h)
+			np.Name = string(name)
+			add(newRequestParam(ParamInHeader, np))
+		}
+	}
+
+	SortRequestParams(params)
+	return params
+}
+
+// PropertyParams returns the properties of an object type which have constraints
+// as request parameters in the given location, e.g. the properties of a query string type
+// or the fields of a form body. The pattern properties are skipped.
+func PropertyParams(in string, properties map[string]interface{}) []RequestParam {
+	var params []RequestParam
+	for name, p := range properties {
+		prop := raml.ToProperty(name, p)
+		if prop.IsPattern() {
+			continue
+		}
+		rp := newRequestParam(in, raml.NamedParameter{
+			Name:      prop.Name,
+			Type:      prop.Type,
+			Required:  prop.Required,
+			Enum:      prop.Enum,
+			Pattern:   prop.Pattern,
+			MinLength: prop.MinLength,
+			MaxLength: prop.MaxLength,
+			Minimum:   prop.Minimum,
+			Maximum:   prop.Maximum,
+		})
+		if rp.HasConstraints() {
+			params = append(params, rp)
+		}
+	}
+	SortRequestParams(params)
+	return params
+}
+
+// SortRequestParams sorts the parameters by location and name
+func SortRequestParams(params []RequestParam) {
+	order := map[string]int{ParamInURI: 0, ParamInQuery: 1, ParamInHeader: 2, ParamInForm: 3}
+	sort.SliceStable(params, func(i, j int) bool {
+		if params[i].In != params[j].In {
+			return order[params[i].In] < order[params[j].In]
+		}
+		return params[i].Name < params[j].Name
+	})
+}
@@ -19,14 +19,20 @@ var (
 	errInvalidLang = errors.New("invalid language")
 )
 
-// GenerateServer generates API server files
-func GenerateServer(ramlFile, dir, packageName, lang, apiDocsDir, rootImportPath string, generateMain bool) error {
+// GenerateServer generates API server files,
+// typedHandlers and router are only supported by the Go server.
+// If preserveRegions is true, the hand written code of the existing files is kept.
+func GenerateServer(ramlFile, dir, packageName, lang, apiDocsDir, rootImportPath string, generateMain, typedHandlers bool, router string,
+	preserveRegions bool) error {
 	apiDef := new(raml.APIDefinition)
 	// parse the raml file
 	ramlBytes, err := raml.ParseReadFile(ramlFile, apiDef)
 	if err != nil {
 		return err
 	}
+	if err := checkExamples(apiDef); err != nil {
+		return err
+	}
 
 	// create directory if needed
 	if err := commons.CheckCreateDir(dir); err != nil {
@@ -38,13 +44,16 @@ func GenerateServer(ramlFile, dir, packageName, lang, apiDocsDir, rootImportPath
 		if rootImportPath == "" {
 			return fmt.Errorf("invalid import path = empty")
 		}
-		gs := golang.NewServer(apiDef, packageName, apiDocsDir, rootImportPath, generateMain)
+		gs := golang.NewServer(apiDef, packageName, apiDocsDir, rootImportPath, generateMain, typedHandlers, router)
+		gs.PreserveRegions = preserveRegions
 		err = gs.Generate(dir)
 	case langPython:
 		ps := python.NewServer(apiDef, apiDocsDir, generateMain)
+		ps.PreserveRegions = preserveRegions
 		err = ps.Generate(dir)
 	case langNim:
 		ns := nim.NewServer(apiDef, apiDocsDir, dir)
+		ns.PreserveRegions = preserveRegions
 		err = ns.Generate()
 	default:
 		return errInvalidLang
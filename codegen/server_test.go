@@ -14,7 +14,7 @@ func TestServer(t *testing.T) {
 		targetdir, err := ioutil.TempDir("", "")
 		So(err, ShouldBeNil)
 		Convey("simple Go server", func() {
-			err := GenerateServer("./fixtures/server/user_api/api.raml", targetdir, "main", "go", "apidocs", "examples.com/ramlcode", true)
+			err := GenerateServer("./fixtures/server/user_api/api.raml", targetdir, "main", "go", "apidocs", "examples.com/ramlcode", true, false, "", false)
 			So(err, ShouldBeNil)
 
 			rootFixture := "./fixtures/server/user_api/"
@@ -47,6 +47,12 @@ func TestServer(t *testing.T) {
 
 		})
 
+		Convey("invalid example", func() {
+			err := GenerateServer("./fixtures/server/invalid_example/api.raml", targetdir, "main", "go", "apidocs", "examples.com/ramlcode", true, false, "", false)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "invalid example of type `Color`: value yellow is not one of [red green blue]")
+		})
+
 		Reset(func() {
 			os.RemoveAll(targetdir)
 		})
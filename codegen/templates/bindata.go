@@ -17,7 +17,11 @@
 // codegen/templates/enum_go.tmpl
 // codegen/templates/enum_nim.tmpl
 // codegen/templates/enum_python.tmpl
+// codegen/templates/error_go.tmpl
+// codegen/templates/file_go.tmpl
+// codegen/templates/form_go.tmpl
 // codegen/templates/generic_main.tmpl
+// codegen/templates/header_go.tmpl
 // codegen/templates/index.html.tmpl
 // codegen/templates/init_py.tmpl
 // codegen/templates/input_validators_python.tmpl
@@ -29,14 +33,22 @@
 // codegen/templates/oauth2_middleware.tmpl
 // codegen/templates/oauth2_middleware_python.tmpl
 // codegen/templates/object_nim.tmpl
+// codegen/templates/params_go.tmpl
+// codegen/templates/params_nim.tmpl
+// codegen/templates/params_validator_python.tmpl
 // codegen/templates/python_server_resource.tmpl
+// codegen/templates/query_string_go.tmpl
 // codegen/templates/requirements_python.tmpl
+// codegen/templates/response_go.tmpl
+// codegen/templates/router_go.tmpl
 // codegen/templates/server_main_go.tmpl
 // codegen/templates/server_main_nim.tmpl
 // codegen/templates/server_main_python.tmpl
 // codegen/templates/server_resources_api.tmpl
 // codegen/templates/server_resources_api_nim.tmpl
 // codegen/templates/server_resources_interface.tmpl
+// codegen/templates/server_resources_typed_api.tmpl
+// codegen/templates/server_resources_typed_interface.tmpl
 // codegen/templates/struct.tmpl
 // codegen/templates/struct_capnp.tmpl
 // codegen/templates/struct_input_validator.tmpl
@@ -127,7 +139,7 @@ func templatesBindataGo() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClass_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x90\xcf\x6a\x02\x31\x10\xc6\xef\x79\x8a\x41\x3c\xb4\xb0\xfa\x00\x82\x97\x22\x82\x20\x1e\x44\xe8\x71\x99\x76\x27\x6b\x30\xff\x9a\xcc\xfa\x87\x90\x77\x2f\x1b\xb3\x42\xe9\x29\x33\x99\x5f\xbe\xef\xcb\xa4\xd4\x91\x54\x96\x60\xf6\xad\x31\xc6\xd6\x3f\xf8\xec\xec\x2c\x67\x21\x83\x33\x20\x35\xc6\x4b\x7b\x63\x09\xca\x78\x17\x18\xb6\x2e\x98\xe7\xe8\xc6\xd2\x05\x13\x97\x57\xd4\xaa\x43\x76\x21\x4e\xcc\x06\x19\x8f\xf4\x33\xa8\x40\x5d\x03\x7b\xb2\x3d\x9f\x1b\x38\x52\x4f\x77\xdf\xc0\x61\x30\x5f\x14\x8e\x68\x7b\x6a\x20\x54\xec\x8f\xe4\xa4\x73\xa2\x3b\x6f\x15\xe9\xae\x29\xb6\xb5\xdc\x59\xa6\x9e\xc2\x34\xd0\x0e\x5f\x90\xd2\x54\xcb\x0f\xe7\x34\xa1\xad\xdd\x06\x99\x5e\x0c\xe9\x6e\xaf\x22\x3f\x1d\x95\xf5\x03\xb7\xff\xbf\x60\x06\xcd\xca\x6b\x6a\x9d\x14\x22\xa5\x30\xa6\x85\xf9\xa5\x81\xf9\x15\x56\x6b\x58\xee\x0a\x16\x61\x91\xb3\x48\x69\x7e\x2d\x07\x90\xed\x72\x16\xa2\x6c\x12\x52\x5a\x1e\xd0\x50\xce\x6f\x63\xf8\xf7\x95\x00\x00\x48\x09\x26\x2d\x7a\x8c\x6a\xa8\x8b\x5e\x89\x15\x73\xae\xd0\x78\x5f\x5f\xc3\x7a\xea\x3f\x4f\xdb\xd3\xc3\xd3\x0b\x5a\x8c\x7e\x50\x9c\x9f\xc6\xbf\x01\x00\x00\xff\xff\xef\xd0\x1b\xa6\xcc\x01\x00\x00")
+var _templatesClass_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x51\xd1\x6a\x23\x21\x14\x7d\x9f\xaf\xb8\x74\x03\x49\x8a\x99\x0f\x08\xe4\x65\x29\x81\xb2\xdd\x3e\x84\xc0\x3e\x2c\xcb\x60\xea\x35\x91\x38\x3a\xab\x4e\xd2\x20\xfe\xfb\x72\x8d\x66\x9b\x16\x87\xd1\x7b\xee\xf1\x78\x8e\xc6\x28\x50\x2a\x83\xf0\xf0\xa6\xb9\xf7\xdd\x70\x09\x07\x6b\x1e\x52\x6a\xa4\xb3\x3d\x48\xcd\xfd\xb1\x3b\x07\x09\xaa\x1f\xac\x0b\xb0\xb6\xae\xbf\xb6\xce\x41\x5a\xd7\xfb\xf6\xc4\xb5\x12\x3c\x58\xe7\x2b\xe7\x89\x07\xbe\xc1\xbf\xa3\x72\x28\x18\xbc\xa0\xd9\x87\x03\x83\x0d\xee\xf1\x7d\x60\xf0\x3a\xf6\x3b\x74\x1b\x6e\xf6\xc8\xc0\x15\xda\x9d\x64\xd5\xd9\xe2\x7b\x58\x2b\xd4\x82\xe5\x63\xcb\xf2\xd9\x04\xdc\xa3\xab\x0d\x6d\xf9\x8d\xa4\x34\x96\xe5\x77\x6b\x35\x72\x53\xaa\x27\x1e\x6a\x83\x96\x5b\xd5\xd7\x32\x4f\x2f\xca\x87\xab\x01\x65\x86\x31\x74\x5f\x13\xf5\xa3\x0e\x6a\xd0\xd8\x59\xd9\x34\x31\x3a\x32\x0f\x93\x23\x83\xc9\x09\x96\x2b\x68\x9f\x33\xcd\xc3\x22\xa5\x26\xc6\xc9\x29\x4f\x80\x46\xa4\xd4\x34\xf9\x62\x21\xc6\xf6\x95\xf7\x98\xd2\x8c\xb2\xcc\x97\x0d\x00\x40\x8c\x50\xb5\xf0\x42\x6a\x5c\x67\xbd\x6c\xcb\xa7\x54\x48\x84\x97\xdd\xb0\xaa\xf5\xaf\xed\x7a\x7b\x19\xf0\x46\x5a\x00\x6a\x8f\x50\xea\x81\x7b\xff\xbf\x61\x04\xe1\x31\x2e\x40\x49\x68\xc9\xc0\x0f\xbc\x78\xc2\x32\xe7\xea\xf0\x27\x06\x7e\xb5\x45\xe3\x1b\xd0\x5b\xc0\x91\x78\x56\x42\x38\x20\xc8\xec\x0a\xce\x07\xf5\x76\x00\xee\x10\x8c\x0d\x60\x78\x8f\x02\xb8\x0c\xe8\x88\xa4\x1c\x6d\xb9\xa9\x90\x46\x97\x35\x56\x10\x6f\x68\xf5\x55\xb2\x93\x04\xcb\x57\x70\x0d\xff\xc1\x5e\x65\xd3\x98\xc6\x98\xa9\x29\x4d\x97\xb9\x38\xe2\x25\xa5\x29\xfb\x22\x5b\xe2\x56\xa8\x84\xa4\x4f\xa0\x84\x9d\x32\xa2\xcb\x51\x66\x1e\xb5\x64\x39\x27\x83\xd1\xec\xec\x58\x3b\x0c\xec\x10\x94\x35\xbe\xbc\x53\x1d\x05\xfd\x3d\x25\x1f\xd3\x3f\xb0\x02\x92\x68\x6f\x29\xdb\x3d\x86\xd9\x27\x12\x83\x4f\xc0\xfc\x4e\xd2\x61\x18\x9d\xb9\x3f\xbe\x25\x8f\x33\x52\x5d\xd1\x8f\xc1\xe3\x63\xd1\x98\x37\x77\xef\x89\x46\xa4\xd4\xfc\x1b\x00\xf1\x5b\x34\x99\xc4\x03\x00\x00")
 
 func templatesClass_pythonTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -187,7 +199,7 @@ func templatesClient_initpy_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClient_nimTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x54\x51\x4f\xdc\x3c\x10\x7c\xf7\xaf\x18\xf9\x43\xa7\x04\x1d\xf9\xfa\x1c\x29\xa5\x94\x56\x05\xa9\x95\x28\x02\xf5\x01\xa1\xca\x97\xec\x35\x86\x60\xfb\xec\xcd\xa1\xeb\xe9\xfe\x7b\x65\x27\xe1\x0e\x95\xc7\x3e\xc6\x3b\x3b\x3b\x33\x6b\x67\xbb\x3d\x41\x43\x4b\x6d\x08\xb2\xee\x34\x19\xfe\x69\xf4\x93\xc4\xc9\x6e\x27\xf4\x93\xb3\x9e\xd1\x32\xbb\xa1\x34\x47\x60\xdf\xb3\xee\xc2\x1c\xac\x16\x1d\x05\x21\x78\xe3\x48\x00\xe7\x09\x70\x8c\x0a\x76\xf1\x40\x35\x0b\x00\x58\xa8\x40\xb7\xd7\x97\xc7\x65\xec\xd3\xe6\x57\x3a\x6c\xeb\x12\x17\xcc\x6e\xe8\x10\xa2\xb6\x26\x70\xd4\xa0\xfa\x8e\x3f\x0e\x1d\xa8\x20\xb7\xdb\xe2\xec\xea\xf2\x13\x2d\x8b\xf1\x70\xb7\x93\x42\x38\x6f\x6b\x18\x7a\x1e\xe7\x65\x8b\x97\x86\xd7\x0c\x79\x39\x4a\x42\x25\x80\xff\x50\x7b\x52\x4c\x21\xb6\x62\x30\x23\x80\xb5\xf2\xa8\x51\x8d\xc8\x89\xab\x9c\x74\xcf\x93\x56\x43\xcf\x7b\xb9\x59\x9e\x0b\xa0\x2e\xda\xba\x68\x49\x35\xe4\x03\xaa\x09\x71\x31\x1c\x64\x5b\xc8\x73\x6b\x98\x0c\x9f\xdc\x6c\x1c\xc9\x12\x52\x39\xd7\xe9\x5a\xb1\xb6\xe6\xff\x87\x60\x8d\xc4\x2e\xf2\x78\xe2\xde\x1b\xd4\xa3\xad\x40\x7c\xd6\x73\x3b\xf0\x1c\x67\xf5\x64\x61\x8e\xb5\xea\x7a\x9a\x52\xcc\x93\xa5\x7f\xa5\xe1\x90\xa7\x50\x4d\x93\xc9\xa8\xc1\x7a\xfd\x3b\x41\xe5\x38\x3c\x1f\x35\xaa\xa6\xf9\xde\x93\xdf\x5c\x29\xaf\x9e\x42\xd6\xfb\x6e\x92\x35\xc7\x6a\x5f\x28\x71\x13\xef\x47\x8e\xa9\x3a\xae\x41\x35\xcd\x00\x83\x4b\x38\xb0\x05\xb7\x04\x4f\xab\x9e\x02\xe3\xf6\xfa\x6b\xca\x25\xf4\x1d\xa3\x42\xef\x3b\x01\xe8\x25\x3a\x32\xd9\x01\x7d\x8e\xaa\xc2\xbb\x32\xdd\xa7\x21\x44\x31\xae\x73\xe5\x4a\x04\x5a\xdd\x0d\x53\xef\x51\xe1\xc3\xdd\xbd\x00\x96\xd6\xe3\x71\xbe\x06\xb4\x39\xd4\x59\x38\xa5\x7d\xc8\xf2\x81\x6a\xe5\x52\x02\x47\x8f\x98\x41\x56\x12\x33\x1c\xad\xf3\x89\x39\x90\xdb\x9b\x81\x3c\x95\x62\x90\xd6\xfb\xae\x58\x6a\xd3\x64\xf2\x54\xe6\x78\x3f\xc9\x0a\xe4\x22\x6c\x96\x60\x87\x86\x30\x4b\xb5\x59\x9c\xf6\x60\xb5\xc9\xe4\x4c\xe6\x62\x8c\x77\xcc\xe1\xd5\xf2\xc9\x34\xce\x6a\xc3\xfb\xa0\xe3\x8b\xfc\x46\xdc\xda\x26\x8e\xf8\xf2\xf9\x46\xce\xb1\xb0\xcd\x26\x7e\xc9\xb7\xf6\x70\x37\x75\xee\x63\xd1\x46\xf3\x9b\xb5\x2c\xcf\xcb\x83\x37\x5f\x5c\x53\x70\xd6\x04\x4a\x1b\x8c\x41\x1c\xec\x1c\xd5\x8b\xba\x21\x0c\x63\x39\x05\x12\x58\x79\x0e\x3f\x34\xb7\x99\x8c\x5c\x72\x4c\x38\xfa\xaf\x50\x17\xd3\xb3\x9d\xa5\x15\x8b\xa9\xf0\xf7\xed\x7a\xe5\xe6\xf0\xcd\xc4\x6b\x3b\xa6\x35\xe0\xf6\xa1\x0c\x61\xe4\x22\xfe\xdb\xc8\x34\xe9\x5f\xf6\x27\x00\x00\xff\xff\xcf\x6e\x08\x08\xe8\x04\x00\x00")
+var _templatesClient_nimTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\xd1\x8e\xdb\x36\x10\x7c\xe7\x57\x0c\x98\xc0\x90\x0e\xb2\x92\xbe\x1a\x50\xd3\x34\x69\x9b\x00\x17\x20\x35\xce\xe8\xc3\xc1\x28\x68\x89\xaa\x79\x91\x49\x99\x5c\x9d\xeb\x1a\xfe\xf7\x82\x14\x65\xcb\x6e\x5a\x04\xe9\xb5\x6f\x77\xbb\xcb\xe5\xcc\x70\x76\xad\xc3\x61\x8a\x4a\xd6\x4a\x4b\xf0\xb2\x51\x52\xd3\xaf\x5a\x6d\x38\xa6\xc7\x23\x53\x9b\xd6\x58\xc2\x9a\xa8\xed\x53\x19\x1c\xd9\x8e\x54\xe3\x32\x90\x58\x35\xd2\x65\xe8\xac\x62\x8c\xf6\xad\x64\xc0\x9b\x50\x75\x83\x02\x66\xf5\x20\x4b\x62\x00\xb0\x12\x4e\x2e\xe6\xef\x6f\x66\xfe\xb0\xd2\xbf\x85\xe0\xba\x9c\xe1\x1d\x51\xdb\x9f\x60\xac\x34\xda\x91\x07\x22\xba\x86\xbe\xef\x4f\xa0\x00\x3f\x1c\xf2\xd7\x1f\xdf\xbf\x95\x75\x1e\x83\xc7\x23\x67\xac\xb5\xa6\x84\x96\xbb\x78\x5f\x12\xaf\x40\x71\xd5\x21\x9d\x45\x48\x28\x18\xf0\x0c\xa5\x95\x82\xa4\xf3\x47\xd1\x33\x62\xc0\xa3\xb0\x28\x51\xc4\xca\xa1\xd7\x6c\xc0\x9d\x05\xac\x5a\xee\xce\x70\x93\x34\x65\x40\x99\xaf\xcb\x7c\x2d\x45\x25\xad\x43\x31\x54\xbc\xeb\x03\xc9\x01\xfc\x8d\xd1\x24\x35\x4d\xef\xf6\xad\xe4\x33\x70\xd1\xb6\x8d\x2a\x05\x29\xa3\x5f\x3c\x38\xa3\x39\x8e\xbe\x8f\x95\xd4\x59\x8d\x32\xd2\x72\x92\x5e\x77\xb4\xee\xfb\xdc\x24\xe5\x40\x21\xc3\xa3\x68\x3a\x39\xa8\x98\xa2\x78\x42\x0c\xe3\x3e\xb9\xa8\xaa\x84\x7b\x0c\xc6\xaa\x3f\x42\x29\x8f\x97\xa7\x11\xa3\xa8\xaa\x9f\x3b\x69\xf7\x1f\x85\x15\x1b\x97\x74\xb6\x19\x60\x65\xd8\x9e\x13\x33\xdc\x79\x93\xa4\x18\xb2\xf1\x19\x44\x55\xf5\x65\x68\x43\x03\x90\x01\xad\x25\xac\xdc\x76\xd2\x11\x16\xf3\xdb\xa0\x8b\xeb\x1a\x42\x81\xce\x36\x0c\x50\x35\x1a\xa9\x93\x51\xfb\x14\x45\x81\x97\xb3\xe0\xa7\x5e\x44\x16\x9f\x73\xdb\xce\xe0\xe4\xf6\xbe\xbf\x75\x89\x02\xdf\xdd\x2f\x19\x50\x1b\x8b\x4f\xd9\x23\xa0\xf4\x18\x67\xde\x0a\x65\x5d\x92\xf6\xad\xb6\x6d\x50\xe0\xf9\x27\x4c\xc0\x0b\x8e\x09\x9e\x3f\xa6\x43\x67\x27\xdb\x33\x19\xf0\x57\xdc\x27\x54\xed\x31\xe6\xb5\xd2\x55\xc2\x5f\xf1\x14\xdf\x0e\xb0\x9c\x6c\xbd\x8b\x27\x9c\x5d\x11\xc2\x24\xe4\x26\xd8\xb6\xf9\x83\x51\x3a\xe1\x13\x9e\xb2\x28\x6f\xd4\x61\x31\xbf\x1d\x3f\xbf\xd4\x55\x6b\x94\xa6\x7f\x92\x3a\x32\xce\x62\xc9\x32\x3d\x83\x0d\xca\x2f\xe6\xb7\x30\xf5\x85\xd8\x64\x20\xf4\xa9\x79\xa4\x39\x7a\x51\x14\xe3\xa4\xaa\xa1\x0d\x05\xba\x8e\x84\x25\xf7\x8b\xa2\x75\xc2\xfd\x8a\xe0\x51\x3f\xcf\xae\x40\x99\xc7\xf9\xc1\xc4\x57\xb3\xb3\xd1\xff\x6a\x9e\x0b\x26\xe9\xa5\x08\x17\x13\x30\x00\x19\xb0\x65\x61\x37\x7d\x90\xb4\x36\x95\xd7\xf9\xa7\x1f\xee\x78\x86\x95\xa9\xf6\xfe\x3f\xfe\x25\x0a\xa1\x80\xd2\x8a\x3e\x9b\x4b\xd2\x74\x36\xda\x7e\xf9\x5c\xba\xd6\x68\x27\x51\x9c\xd9\x84\xc9\x89\x58\x93\xd3\x5f\xfe\xe9\x06\xb0\x97\xec\xc6\x90\x7b\xa8\x57\x84\x7f\x34\x76\xf3\xc5\xa4\xcf\xb1\xda\xd8\x4d\x6f\xfa\x64\x08\xc5\x45\xb1\xfc\x4f\x65\x78\x06\x27\x75\xe5\xbc\x87\xc6\xbb\xe5\xf7\xe9\x6e\xb7\x9b\x7a\x50\xd3\xce\x36\x52\x97\xa6\x92\x55\xa0\x1b\x1d\x56\x2b\xd9\x54\xee\xef\xc7\x34\xe4\xfd\x9c\xfa\x1e\xbd\xb1\x42\xc8\x85\xe1\xec\x1b\x2e\x6c\x93\x84\xe0\xfd\xcb\x65\x7a\x9a\xd6\xeb\xdc\x37\xcb\xb0\xae\x1b\x49\xf8\xda\x4d\xf9\x59\x36\x57\xeb\xfb\x5f\xf9\x20\x52\x3b\x6d\x82\x6c\x80\x7a\x65\x8e\x0f\x5d\x43\xaa\x15\x96\xbe\xda\x21\xa7\x0e\x6f\x05\x89\xff\xc7\x19\xd8\x0c\x77\xbe\x08\x12\x56\x82\x44\xb0\x42\x16\x16\x51\xd9\xff\x4c\xc2\x7f\x43\x40\x39\x38\x49\x58\xed\x43\xca\x8f\xca\xf9\x87\xfa\x49\x94\x3e\x61\x29\x3c\x96\x94\xf9\xef\x1f\xa9\x2b\x4c\x8f\x47\xf6\xe7\x00\x15\x23\x12\x64\x0c\x09\x00\x00")
 
 func templatesClient_nimTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -207,7 +219,7 @@ func templatesClient_nimTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClient_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x94\x41\x8f\xd3\x30\x10\x85\xef\xf9\x15\xa3\x68\xa5\x34\x52\x1a\xee\x95\x72\x80\xd5\x22\x71\xd9\x03\x0b\xe7\x68\x48\xa6\xd4\x90\xd8\xc6\x33\x8e\x28\x96\xff\x3b\x72\x1a\xd2\x16\xad\xc4\x2e\x82\xf5\xb1\x7e\xf3\xde\x9b\xaf\x91\x43\xd8\x42\x4f\x7b\xa5\x09\xf2\x6e\x50\xa4\xa5\xb5\x47\x39\x18\x9d\xc3\x36\xc6\x4c\x8d\xd6\x38\x01\x47\xdf\x3c\xb1\x70\x16\x02\x38\xd4\x9f\x09\x6e\xbe\x56\x70\x33\xc1\xae\x81\xfa\x81\xdc\xa4\x3a\x62\x88\x31\xdb\x3b\x33\x42\x1d\xc2\xcd\x54\xbf\x55\x03\x69\x1c\xe9\xde\xdc\x7d\x97\x18\x61\xb1\x82\xf9\xf2\x1e\x47\x8a\x11\x42\x20\xdd\xc7\x98\x65\x59\x37\x20\x33\xdc\xce\x0d\x76\x19\x00\xa4\x56\xd0\xb6\x4a\x2b\x69\xdb\x0d\xd3\xb0\xaf\xe0\x13\x32\xb5\xde\x29\x68\x20\x0f\xa1\x7e\x83\x4c\x1f\xdf\xbf\x8b\x31\x2f\x4f\x23\xe9\x24\x65\xbd\x08\x07\x68\xd6\x99\x6b\x01\x13\xb3\x32\x1a\x9a\x75\xb5\xfa\xe1\xf4\xd3\xa6\x7c\x54\x59\x1f\x08\x7b\x72\x5c\x7b\xdb\xa3\xd0\x26\xe4\xb7\x46\x0b\x69\xd9\x7e\x38\x5a\xca\x77\x90\xa3\xb5\x83\xea\x50\x94\xd1\xaf\xbe\xb0\xd1\x79\x3c\x3b\xfd\x09\xdb\x55\xe2\x0c\xe8\x4e\xf7\xd6\x28\x2d\x0b\xa8\xe6\x12\xdb\x4c\xa3\xfc\xc5\x2e\xcd\xad\xc0\x98\xa4\x45\x2f\x87\xf6\x54\x77\xe1\x36\xe1\x70\x41\xa8\x28\x8a\xa4\x83\xa4\x33\x4e\xfd\x98\x2b\xc3\x69\x20\x49\x3d\x15\x45\xf1\x44\x08\xaf\x2f\x3d\xf2\xdd\x84\x43\x2c\xb3\xb5\x8d\x35\x2c\x4b\x05\xef\x54\x05\x3d\x0a\x56\x4b\x12\x57\x60\xd1\xe1\xc8\x17\xcd\xd4\x1e\xe4\x68\x69\x93\x74\x25\x28\x06\x16\x77\xbe\x4d\xc7\x91\x78\xa7\xaf\x4b\xcd\x29\xab\x7f\x73\x15\xd2\xfc\x16\xd6\x2c\x99\xab\x29\x0d\x4c\xcf\x89\x48\xff\xec\x13\x23\xce\x18\xfc\x4b\x50\xf0\xff\x12\xc2\xe3\xd6\x7f\xb5\x3c\x4a\x77\x78\x81\xf5\xe7\x98\xff\xfc\x15\x9c\x33\x9e\x43\x22\x3d\xb1\xa4\xfb\xf9\x3d\xfd\x19\x00\x00\xff\xff\x59\x7f\x48\x4b\x6f\x05\x00\x00")
+var _templatesClient_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x95\x51\x6f\x9b\x30\x14\x85\xdf\xf9\x15\x47\xac\x52\x92\x0a\xe8\x7b\x24\x1e\xb6\xaa\x93\xf6\xd2\x87\x75\x7b\x46\x2e\xbe\x19\xde\x8c\xcd\x6c\x93\x36\x43\xfe\xef\x93\x1d\x4a\xc9\x9a\xad\x59\x55\x55\x48\x91\x12\xce\xbd\xe7\xf8\xbb\xe4\x32\x0c\x39\x38\x6d\x84\x22\xa4\xb5\x14\xa4\x5c\xd5\xed\x5c\xa3\x55\x8a\xdc\xfb\x44\xb4\x9d\x36\x0e\x86\x7e\xf6\x64\x9d\x4d\x86\x01\x86\xa9\x6f\x84\xb3\x1f\x19\xce\xb6\x58\x97\x28\x6e\xc8\x6c\x45\x4d\x16\xde\x27\x1b\xa3\x5b\x14\xc3\x70\xb6\x2d\x3e\x0a\x49\x8a\xb5\x74\xad\xaf\xee\x9d\xf7\x18\x5b\x21\xde\xbc\x66\x2d\x79\x8f\x61\x20\xc5\xbd\x4f\x92\xa4\x96\xcc\x5a\x5c\xc6\x04\xeb\x04\x40\x48\x85\xaa\x12\x4a\xb8\xaa\x5a\x5a\x92\x9b\x0c\xb7\xcc\x52\xd5\x1b\x81\x12\xe9\x30\x14\x1f\x98\xa5\xaf\x9f\x3f\x79\x9f\xae\xf6\x25\xe1\x0a\xca\x62\x14\x4a\x94\x53\xcd\xa1\xc0\x92\xb5\x42\x2b\x94\xd3\xd1\x8a\x9b\xfd\x4f\xcb\xd5\x51\x65\xd1\x10\xe3\x64\x6c\xd1\x77\x9c\x39\x5a\x0e\xe9\xa5\x56\x8e\x94\xcb\xbf\xec\x3a\x4a\xd7\x48\x59\xd7\x49\x51\x33\x27\xb4\xba\xf8\x6e\xb5\x4a\xfd\x63\xa7\xe7\xb0\x3d\xe8\x62\xf8\x08\xe8\x4a\xf1\x4e\x0b\xe5\x46\x50\xe5\x1c\x5b\xa4\xb1\x7a\x60\x17\xea\x26\x60\x96\x5c\xc5\x7a\xd7\x54\xfb\xb8\x23\xb7\x2d\x93\x33\x42\x8b\xc5\x02\x96\x1c\x82\x4e\x1b\xf1\x2b\x46\xc6\xbe\x00\x5b\x26\x7b\x5a\x2c\x16\x27\x42\x78\x3f\xef\x91\xae\xb7\x4c\xfa\x55\x32\xa5\xe9\xb4\x75\x63\x84\xde\x88\x0c\x9c\x39\x96\x8d\x4e\x36\x43\xc7\x0c\x6b\xed\x2c\x99\xd8\xc0\xed\x3a\x5a\x06\xdd\x0a\xc2\xc2\x3a\xf3\x78\x37\x5c\x86\x5c\x6f\xd4\x61\xa8\xe8\x32\xf5\x2f\x0f\x4c\xca\x3f\xcc\xca\xd1\x73\x6a\x4a\xd2\xd2\xff\x58\x84\xc9\x9e\x68\xf1\x88\xa1\x7f\x0b\x0a\xfd\x6b\x42\x38\xde\xfa\x45\x87\x67\xae\x6e\xde\xe0\xf8\xd1\xe6\xf5\x00\x3c\xe3\xf1\x12\x12\x96\x14\xaf\x36\xda\xb4\x23\x8d\x96\x5c\xa3\xf9\xbf\xa8\x64\xd8\x08\x49\xb6\xbc\xd6\x8a\x66\x84\x0e\xff\x9e\x8a\x83\x29\xcc\xb7\xcf\x7d\x7e\x77\x77\x97\x07\xa7\xbc\x37\x92\x54\xad\x39\x71\xdc\x6a\xbe\xcb\xa6\x3a\x6d\xc0\xd0\xf6\xd2\x89\x8e\x19\x77\x11\xc5\x81\x59\x94\xc5\x47\xb0\x21\x43\x60\x86\xf6\x19\x8e\x9a\xbf\x83\x6b\x08\xf5\x7e\x11\xc6\xa9\x41\xc7\x4a\x8c\xc8\xc2\xd3\x6b\xa8\x93\xac\x0e\x01\x76\x7f\x95\x07\xfb\xa9\xeb\x08\x01\x25\xb8\xa8\xdd\xf2\xe1\xab\x36\x18\x7c\x86\xf3\xf3\x27\xab\x37\xe0\x99\x2d\xdb\x63\xa3\x1b\xb7\xfc\xf2\x09\xf3\x71\x88\xf1\x8c\x65\xfc\x3c\x61\xa2\xe1\xa5\x49\x8a\x23\xf7\x3e\xf9\x3d\x00\xe7\x2b\x9f\x7f\x41\x07\x00\x00")
 
 func templatesClient_pythonTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -227,7 +239,7 @@ func templatesClient_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClient_service_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x09\x53\xec\xdf\x1c\xfb\x7d\xd0\x97\xb6\xd9\x28\x74\xa5\x94\x6c\x7b\x2c\xae\x7d\x9d\xa8\x75\x24\x47\x92\x53\x82\xa6\xef\x3e\xf4\xa7\x89\x9b\x96\x32\x06\x7d\x5b\x20\x10\xe9\x1c\x9d\x7b\xce\xbd\x97\x18\x33\x83\x16\x3b\xc6\x11\x68\xd3\x33\xe4\xfa\x4e\xa1\xdc\xb2\x06\xef\x96\x82\xc2\xcc\x5a\x32\xd4\xcd\x63\xbd\x44\x30\xa6\xbc\x09\x3f\xaf\xeb\x35\x5a\x4b\x88\x31\x69\x24\x33\x77\x05\x9f\x4f\xa1\x8c\x18\x5b\x0f\x42\x6a\xc8\x48\x42\x91\x37\xa2\x65\x7c\x59\x3d\x28\xc1\x29\x49\x28\x47\x5d\xad\xb4\x1e\x28\x21\x00\x00\xc6\x80\xac\xf9\x12\x21\x7d\x2c\x20\xdd\x7a\x95\x2b\x76\x7f\xe9\x15\x6e\x6a\xbd\x52\xde\x86\xa3\x52\x63\xd2\x47\x6b\x69\x7c\x87\xbc\xf5\x50\x4e\x88\xde\x0d\xde\x61\x28\x0f\xd1\x16\x71\x1e\x5f\xab\x7f\x43\xbd\x12\xad\x02\x6b\xa7\x70\xe7\xf0\xce\x11\xd2\x6d\xf9\x65\xe4\xcd\xb9\x58\xaf\x91\x6b\xcf\xab\x2a\x30\x26\xdd\x76\xd6\x86\xba\xd6\x92\x6e\xe4\x0d\x64\x0a\xfe\x3f\x6a\x83\xb5\xb9\xe7\xc6\x32\xe1\x2a\xf3\x37\x37\xb5\xac\xd7\xca\xda\xdc\x9f\x6e\x51\x8f\x92\x2f\x76\x03\x2a\x27\x1b\x43\xcd\x80\x75\x80\x1b\x67\xe2\x07\xca\x7b\xa0\x5f\xe7\x0b\xea\x2c\x24\x89\x31\xac\x03\x8e\xe0\xdf\xaa\xe1\x4c\xb4\x3b\xa0\x0e\x83\x6d\x2d\x61\x84\xa8\x1a\x90\x89\x53\xaf\xec\x3e\x12\xd5\x50\x00\x4a\xe9\x52\xaa\x32\x0c\xbc\x6c\xc5\x2d\x6e\xae\x85\x7b\x94\xf9\x6a\xc5\x01\x3b\xab\x15\x7e\xbf\xbd\x84\x97\xa5\xc5\x28\x1b\x74\xa3\x89\xe5\x3f\x3d\x97\xda\x5b\xd8\x33\xac\x2d\x60\x85\x75\x8b\x52\x15\xb0\x19\x51\xee\x42\x13\x72\x92\x24\x2e\xa8\x94\xf0\xdf\x29\x70\xd6\x83\x21\xc9\x7b\x11\xa5\xef\x16\x8c\x85\x23\xfb\x10\x81\x8f\xbd\xc2\x03\xfc\x12\x9b\xc1\xf3\x8a\x24\x89\xfb\xb6\xd8\xa1\xf4\x5d\x28\x9d\x72\x79\xde\x0b\x85\x59\x4e\xde\xeb\xad\x53\x3a\xd4\x0e\x1d\x74\x9b\x5c\x5e\xe3\xd3\x05\x36\xa2\x45\x99\xed\x15\xf3\x32\x5c\x65\x27\x63\x4e\x0e\xee\x26\x1a\x41\x80\xb3\x9e\x1c\x19\x0c\xdc\xe3\xe1\x5f\xcc\xaf\xe6\x8b\x39\xf5\x12\x55\x05\x8d\xc4\x5a\x23\x48\xdc\x8c\xa8\x34\x88\xfb\x07\x6c\x34\xd9\x8b\xbf\x3d\xd2\xa8\xf1\x7a\xaa\x1f\x31\xd4\x49\xe4\x8f\x5c\xd7\x9f\x4c\xaf\x42\x3a\xaf\xe2\x9a\x65\xed\xdf\x47\x7c\x33\xe1\x8b\xb7\x9b\x68\xdf\xda\x93\x48\x0e\x37\xbf\x60\x21\xae\xc4\x13\x4a\x27\x12\xa2\x73\xd6\x47\xd9\x7f\xab\xff\x87\xab\x7f\x38\x10\xf7\x9f\x3c\x39\x4e\x0f\xbf\x03\x00\x00\xff\xff\x3d\x5a\x89\x7e\xad\x06\x00\x00")
+var _templatesClient_service_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\xcf\x6f\xeb\x36\x0c\x3e\x5b\x7f\x05\x67\x18\x0f\xf6\x96\x3a\xf7\x01\xbd\xbc\xbe\x74\x28\x90\x14\x5d\x96\x6d\xbd\x15\xae\x4d\x27\x6e\x13\xc9\x91\xe4\x74\x81\xa7\xff\x7d\xa0\x2c\xff\x48\xe2\x76\xbb\x14\xd8\xe1\x01\x39\x44\xe4\x47\xf2\xe3\x47\xd2\x75\x7d\x05\x19\xe6\x05\x47\xf0\xd3\x6d\x81\x5c\x3f\x29\x94\x87\x22\xc5\xa7\xb5\xf0\xe1\xca\x18\x56\x26\xe9\x6b\xb2\x46\xa8\xeb\xf8\xa1\xf9\x7b\x9f\xec\xd0\x18\xc6\xea\x3a\x70\xe0\x82\x4c\xf0\xf3\x35\xc4\xce\x57\xec\x4a\x21\x35\x84\xcc\xab\x6b\x90\x09\x5f\x23\x04\xaf\x13\x08\x0e\x16\x74\x23\x32\x4c\xef\x2c\xe4\x21\xd1\x1b\x65\xeb\x78\x7e\x5d\x07\xaf\xc6\xf8\x14\x83\x3c\x73\x46\x8e\x7a\xba\xd1\xba\xf4\x19\x03\x00\x18\x4b\x37\x2f\x9e\xcf\x93\x11\xb4\xcb\x47\x8f\x3e\x65\xc4\x98\x3e\x96\xb6\x9f\x86\x2c\xb8\x26\x18\x63\x63\xd9\x17\xa8\x37\x22\x53\x60\xcc\xd0\x9d\x53\x33\x39\x75\x13\x1c\xe2\xdb\x8a\xa7\x37\x62\xb7\x43\xae\x2d\x6e\x3a\x85\xba\x0e\x0e\xb9\x31\x4d\x5d\x63\x58\x5e\xf1\x14\x42\x05\x3f\x9e\x89\x66\x4c\x64\xb1\xae\x4c\xc3\x28\xb4\x96\x87\x44\x26\x3b\x65\x4c\x64\x5f\x4b\xd4\x95\xe4\xab\x63\x89\x8a\xd2\x3a\x31\xae\xa0\xc8\x21\x38\xc4\xbf\x56\x28\x8f\xbf\x69\x59\xf0\x35\x11\xf0\xf6\xf4\x6e\x12\x4c\x00\xa5\x24\xa2\xfb\x1e\xd3\x78\xc2\x81\x65\x02\x83\x90\x88\x79\x45\x6e\xc3\x7e\xb8\x06\x5e\x6c\xa1\x66\x9e\xe7\x8a\x71\xa4\x7a\x4b\x54\xe5\x57\x91\x1d\xc1\xf7\x6d\x3d\xef\x90\x48\xa8\xc0\x31\x6d\x7c\xd6\x2e\x2d\x6d\xa8\x26\x94\xc7\x52\x71\xa9\x70\xab\x70\x88\x38\x77\xf3\x8c\xbc\xa6\x1d\x3a\x4d\xcf\x98\x61\xd7\x42\x42\x88\x7b\x22\xf3\x07\xca\x67\xf0\x7f\x99\xad\xfc\x08\xc2\x84\x67\xa7\xf6\x6f\xb3\xf9\x6c\x35\x23\xd7\x05\xf5\x28\x6a\xc8\xd7\xf5\x3b\x8d\xc1\x68\x5b\xdd\x50\x2d\x1d\xfa\x49\x54\x65\xa7\xb3\x8a\x9b\x4b\x8a\x33\xb1\xc4\xfd\xbd\xa0\x62\x21\x2d\x63\xc3\xc8\x18\x7f\xd2\x63\xbe\x26\x0a\x7f\x5f\xde\xc1\x29\x05\x51\xc9\x14\x69\x9b\x1d\x8d\x9f\xda\x92\x1d\x95\x0e\x61\xcc\x04\x36\x98\x64\x28\xd5\xf9\x0c\x47\x86\xf8\x41\xab\xa3\x93\xa2\x53\xb4\x83\x82\xcb\x31\xb5\x73\x6a\x0e\xd5\x23\x21\x33\xcc\x51\x5a\x35\x62\xca\x1c\xdf\x6c\x85\xc2\x30\x62\xad\xc6\xc1\x21\xa6\x0d\xce\xa8\xb4\xe0\x0a\xed\xb5\x78\x9e\x47\x2c\xaf\xa1\x8a\x33\x4c\x45\x86\x21\x25\x88\xa8\x7a\xcf\xa9\x53\xd8\xa6\xa2\xe5\xf9\x60\x19\x2f\xe2\xda\xe2\x2d\xf2\x4e\x3d\x2e\xe6\xc6\xfc\xb5\xdb\xb6\xfd\xbd\x28\xc1\x9d\xc6\xf1\x3d\xbe\x7d\xb3\x44\x64\xd8\xb5\x12\xc5\x8d\x29\xfc\x52\x45\x1d\x85\x93\x62\x04\xb5\xda\xf5\x1b\xdc\x28\xd3\xd3\x1d\x59\x4b\x02\x78\xd3\x29\xa4\x12\x13\x8d\x20\x71\x5f\xa1\xd2\x20\x9e\x5f\x30\xd5\xfd\x75\x8c\xef\x94\xcb\x71\xb9\x4e\x9f\xb1\x4d\x83\x96\x3f\xe9\x5e\xfa\x29\xed\x07\x43\xb2\x57\xf4\x67\xa1\x37\x8f\x8b\x39\x99\x7b\x39\x87\xd0\x45\xb5\xd5\x45\x99\x48\x3d\x08\xe8\x6c\xef\x87\xdd\x0a\xb9\x1b\x44\xd0\xb3\x07\x0f\x1c\xce\x48\x27\xf8\x6f\xa7\xfc\x1f\xb5\x1f\x95\xfe\x24\x76\xef\x74\x35\xe6\x8b\x03\x37\x96\xbf\x61\x25\xe6\xe2\x0d\xa5\x31\x2d\x4f\x5e\x6c\x5d\xda\xef\x1f\x83\xff\xfb\xc7\xa0\x7f\x30\xc3\x4e\x9e\xc3\xc7\x3f\x03\x00\xa9\x39\x9f\x45\x99\x09\x00\x00")
 
 func templatesClient_service_goTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -247,7 +259,7 @@ func templatesClient_service_goTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClient_service_nimTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x91\xcd\x6a\xeb\x30\x10\x85\xf7\x7a\x8a\x21\x78\xe1\x98\xc4\x0f\x60\xf0\x2a\xab\x2c\xee\x25\xa4\x74\x55\x4a\x90\xe5\x69\xe2\x56\x3f\xee\x48\x11\x04\xa1\x77\x2f\x96\xe2\x3a\x90\xae\x0c\x23\x9f\xef\x7c\x1a\x85\xb0\x85\x1e\x3f\x06\x8d\xb0\x12\x72\x40\xed\x4e\x16\xc9\x0f\x02\x4f\x7a\x50\x2b\xd8\xc6\xc8\x06\x35\x1a\x72\xa0\x38\xd9\x0b\x97\x1b\x70\xbc\x93\x68\xe7\x71\x08\xf5\x2e\x05\xff\x73\x85\x31\xb2\x10\x80\xb8\x3e\x23\x14\x5f\x1b\x28\x3c\x34\x2d\xd4\xfb\xf4\xab\x85\x05\x16\x42\xe1\x63\x0c\x01\x75\x1f\x23\x63\x21\x14\xf7\xda\x89\x92\x32\x77\x9c\xbb\x8d\xc8\x60\x6a\xc9\x83\x59\xaf\x82\x16\x4c\xf7\x89\xc2\x31\x00\x80\xec\x5e\x35\x90\x5d\xd2\x4c\x73\x85\x55\x03\xd6\xd1\xa0\xcf\x8c\x8d\x64\xc4\xc2\x79\x21\x5f\x95\x02\xe6\xc0\x1a\x9a\xe7\x0e\x80\x96\x01\x10\xba\x2b\xe9\xe7\xd3\x32\x77\x36\x62\x93\x9a\x1a\x51\x77\xdc\xe2\xeb\x71\xbf\x66\x8f\x4b\x50\xd3\x16\x54\xba\xd2\x3f\x74\x17\xd3\xdb\x18\x67\x97\xc2\xab\xfb\x30\xa3\xab\xd2\x92\x9f\x44\x1e\xb7\xb1\x34\xe6\x40\x16\x3e\x90\x11\x07\x4e\x5c\xd9\x18\xb3\x7c\x3a\x33\xda\xa1\x76\x47\x74\x9e\xcb\x18\x93\xbf\x44\x07\x84\x76\x84\x16\x2c\xf9\x3a\x5b\xd7\x84\xdf\x57\xb4\xae\x7c\x64\xee\xb8\x94\xbf\xcc\xe5\xe6\xce\xbc\xfd\x49\x7f\x2f\x27\x6c\xdd\x99\xfe\xb6\x66\xf3\x53\xce\xdf\x9f\x00\x00\x00\xff\xff\x49\x91\xf1\xc0\x59\x02\x00\x00")
+var _templatesClient_service_nimTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x53\xd1\x6e\x9b\x30\x14\x7d\xf7\x57\x1c\x55\x79\x20\x51\xca\x07\x20\x21\x4d\xeb\x34\x2d\x0f\x9d\xda\x54\x7b\x8a\xa2\xca\x81\x4b\xc3\x06\x06\x6c\x87\x29\xb2\xfc\xef\x93\x8d\x09\xd0\x76\x6f\xd8\xd7\xe7\xdc\x73\xcf\x3d\x18\x73\x8f\x9c\x8a\x52\x10\xee\xb2\xaa\x24\xa1\x5f\x15\xc9\xbe\xcc\xe8\x55\x94\xf5\x1d\xee\xad\x65\x65\xdd\x36\x52\xa3\xe6\x52\x9d\x79\xb5\x85\xe6\xa7\x8a\x14\x73\xd0\xb2\x40\x23\x11\xff\xe0\xea\xf9\x42\xf2\xfa\xa2\x65\x29\xde\xfc\xf9\x7b\x23\xeb\xaf\x4d\x7e\xc5\x44\xa0\xb4\xbc\xe8\xb2\x52\x5b\x28\xea\xc2\x97\x2e\xeb\x40\x45\x22\x77\x6f\x03\xab\xa3\x78\xbc\x54\xba\x6c\xb9\xd4\xef\x78\xce\x5a\xb7\x83\xd6\x39\x30\x14\x8d\x89\x1f\x7c\xed\x27\xaf\xc9\xf3\x41\x72\xf1\x46\x58\xfd\xd9\x62\xd5\x23\x49\x11\xef\xfc\x53\xb5\x40\xad\x7a\x6b\x8d\x21\x91\x5b\xcb\x98\x31\xab\xe0\x82\x63\xf1\x98\x40\xa7\xaf\x2d\x31\xc0\x98\x70\x31\xba\xb5\x41\x8a\xe6\xf4\x9b\x32\xcd\x00\x60\x90\xb7\x49\x30\x68\xf1\x77\x82\xd7\xb4\x49\xa0\xbc\x47\x8c\xb5\xb2\xc9\x26\x9e\x17\xd9\x6f\xa2\x0c\x23\x60\x8d\xe4\x63\x0f\x20\x65\x80\x24\x7d\x91\xe2\x63\x35\x1a\x7a\x26\xd9\xd6\x77\x4a\xb2\xf8\xc4\x15\xfd\xda\xef\xd6\x6c\x6e\x42\xed\x5c\xa8\xfd\x48\x8f\xa4\xcf\x4d\xae\xac\x1d\xb5\xac\xfa\x3a\x5c\x0e\xd4\x9b\x48\xc9\x3e\xc1\xd2\x8d\xa9\xe3\x00\x18\x04\x3f\xc9\x26\x7b\xe2\x92\xd7\xca\xda\x41\xbc\xaf\x35\x42\x93\xd0\x7b\xd2\x3d\xaf\xac\xf5\xfa\xc3\x82\x5d\x79\x9e\x19\x6b\x19\xd0\x73\x89\xce\x5d\x0e\x54\x48\xe7\xa7\x80\x1d\x07\xe9\xdc\x20\x9d\x1b\x64\x52\xf1\x3c\xc3\x7a\x42\x27\xa3\x9b\x16\x0b\xcc\x02\x73\x93\xc2\x45\xee\x3c\x89\xf7\xd4\xf9\xa4\xcd\xbe\x77\x3e\xc7\x8b\xd7\x8b\xea\x2d\xa2\x93\xfe\xc2\x01\x52\x08\xfa\x7b\x2b\x7e\xe3\x9a\x47\xeb\xb1\x7b\xa5\x68\xf9\x3a\x71\xff\xc3\x21\x1a\x82\xb1\x0d\x01\x59\x1f\x91\xe2\xcb\xe1\xf8\x99\xe6\xd1\x82\xc2\x59\x50\x2c\x2d\x70\x7a\xdf\x3b\x50\xfc\xd7\x81\x8a\x34\x24\xa9\x16\x29\x94\xec\xe3\x21\x42\xf1\x7c\xb1\x7b\xea\x2e\xa4\xfc\x7e\xad\x8d\xe6\x95\x07\x5e\x55\xb7\x95\x4f\xc1\xd4\xcd\xe1\xd3\xe5\x1f\x23\xd7\x28\x3e\x35\xf9\x75\xcd\x46\x39\xc6\x90\xc8\xad\x65\xff\x06\x00\xc3\x8e\x33\x58\x87\x04\x00\x00")
 
 func templatesClient_service_nimTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -267,7 +279,7 @@ func templatesClient_service_nimTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClient_service_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x91\xc1\x6a\x03\x21\x10\x86\xef\xfb\x14\x83\xec\x21\xa5\xc9\x3e\x40\x20\x87\x12\x5a\xe8\xa1\x25\xe4\xd0\xab\x98\xdd\x31\x2b\x75\xc7\xa0\xee\x42\x11\xdf\xbd\xcc\x9a\x04\x3d\x0d\xff\x38\xdf\xef\xef\xa4\xb4\x83\x01\xb5\x21\x04\xd1\x5b\x83\x14\x65\x40\xbf\x98\x1e\xe5\xed\x2f\x8e\x8e\x04\xec\x72\x6e\x9a\xde\xaa\x10\x20\xa5\xee\x5b\x4d\x98\xf3\xbe\x01\x00\x1e\x04\x29\x0d\x99\x28\xe5\x26\xa0\xd5\x5b\x28\x8c\x97\xd2\xe7\xc3\x72\x57\x54\x38\xdc\xdb\x4d\x93\x12\x78\x45\x57\x84\xf6\x77\x0b\xed\x02\xfb\x03\x74\x5f\x18\x47\x37\x04\x60\xbb\x07\x3d\xa5\x76\xb9\x37\x8a\xf1\x66\x55\x4e\xca\xab\x29\xe4\x5c\xf9\x08\x21\x2a\xa8\x66\xaa\x66\x6c\xbb\x74\x1f\x33\xf5\x47\x37\x4d\x48\x71\xa5\x3f\x46\x18\xa5\x73\x4e\x09\x69\xa8\xe4\xcf\x08\x26\xc0\xb4\x9a\x82\x76\xbe\x3c\xe2\x07\xfd\x25\xe7\x52\xbf\xd3\x70\x73\x86\x62\x35\x24\x84\x78\xd6\xb3\x37\x70\xa8\x83\x77\x17\x15\x50\xce\xde\xc2\x6b\x01\x9c\x31\xb8\xd9\xf7\x78\x52\x71\xac\x20\x1e\xe3\xec\xa9\x5c\x39\x9d\x8f\xca\xda\x67\xe0\xf3\x9b\xbf\x72\x60\xfe\x39\xa4\x81\x63\xf0\xea\xb8\xe4\xfd\xfc\x07\x00\x00\xff\xff\x2c\x30\xa1\x2a\xc7\x01\x00\x00")
+var _templatesClient_service_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\xc1\x6a\xe3\x30\x10\x86\xef\x7e\x8a\x41\xf8\x90\x65\x63\x3f\x40\x20\x87\x25\x6c\x60\x0f\x5b\xd2\x14\x7a\x15\x8a\x3d\x8a\xd5\xda\x52\x2a\xc9\x86\x30\xe8\xdd\x8b\xa4\x24\x75\x4a\x4b\xf1\x45\xfc\x33\xf3\x7d\x46\x23\xa2\x0a\x5a\x94\x4a\x23\xb0\xa6\x57\xa8\x3d\x77\x68\x27\xd5\x20\x3f\x9d\x7d\x67\x34\x83\x2a\x84\xa2\x68\x7a\xe1\x1c\x10\xd5\x0f\x62\xc0\x10\x56\x05\x00\xc4\x41\xe0\x5c\x69\xe5\x39\x5f\x38\xec\xe5\x12\x32\xe3\x57\xae\xc7\x2f\xc6\x75\x4e\x61\x7d\x29\x17\x05\x11\x58\xa1\x8f\x08\xe5\xeb\x12\xca\x09\x56\x6b\xa8\xff\xa3\xef\x4c\xeb\x20\xea\xae\x74\xa2\x72\xba\x14\xb2\x78\x91\x92\x9d\xb0\x62\x70\x21\xcc\x3c\x8c\xb1\x19\x54\x46\xaa\x8c\xd8\x72\xaa\xb7\xa3\x6e\x36\x66\x18\x50\xfb\x44\xbf\x8e\x44\x94\x0c\x81\x08\x75\x3b\x8b\xff\x79\x50\x0e\x86\x24\x05\x69\x6c\xea\xab\x9f\xd1\x1e\x42\xc8\xe7\xbf\xba\x3d\x19\xa5\xfd\x1d\xab\x02\x25\x93\xcd\xd8\x61\xab\x7a\xbc\x53\xc9\x18\xac\xa0\x55\x8d\x07\x23\xc1\x77\x98\x22\x30\x87\x17\x6c\xbc\x8b\x19\xd1\xb7\xd3\x71\x49\xa8\xdb\xcf\x51\xf6\x3d\x8e\x68\xcf\x4f\xde\x2a\x7d\xcc\xd7\x32\x6f\x7b\x8b\x45\xee\x52\xf5\x43\x4f\xf4\xe3\xe0\x17\x4a\xc6\xd8\xed\x3c\x5a\x05\xeb\xf9\x6e\xeb\x83\x70\xc8\x47\xdb\xc3\xef\x7c\x47\x7b\x74\x66\xb4\x0d\xee\x84\xef\x66\x10\x8b\x7e\xb4\x3a\xb7\xec\xf6\x1b\xd1\xf7\xb7\x9d\xee\xff\xd8\x63\xdc\x69\x7c\x1c\x17\xf5\xf5\x2f\xaa\x10\x8a\xf7\x01\x00\x2f\xd1\x6d\xa2\xaa\x02\x00\x00")
 
 func templatesClient_service_pythonTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -287,7 +299,7 @@ func templatesClient_service_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClient_utils_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x56\x5d\x6f\xdb\x36\x14\x7d\x16\x7f\xc5\x9d\x80\x01\x64\xa2\xd2\x0f\x79\xca\x80\x3c\x74\xeb\x8a\x62\xd8\xb2\xcc\x4e\xb1\x87\xa2\xa8\x69\xe9\xca\xe6\x62\x91\x12\x75\x95\xcc\x33\xf4\xdf\x07\x52\xb2\x23\xb9\xb6\x91\x01\x19\xd0\x97\xc4\xfc\x38\xbc\xe7\x9c\xfb\x01\x6d\xb7\x6f\x20\xc3\x5c\x1b\x84\x38\x5d\x6b\x34\xf4\xa5\x21\xbd\xae\xbf\x2c\x6d\x0c\x6f\xda\x96\x95\x2a\x7d\x50\x4b\x84\xed\x56\xde\x75\x3f\x6f\x55\x81\x6d\xcb\x98\x2e\x4a\xeb\x08\x38\x8b\xe2\xc5\x86\xb0\x8e\x59\x14\xa3\x49\x6d\xa6\xcd\x72\xf2\x57\x6d\x8d\xdf\xd0\xd6\xff\x35\x48\x93\x15\x51\xe9\x7f\xe7\x05\xf9\x7f\xa4\x0b\x8c\x99\x60\x2c\x6f\x4c\x0a\x01\x87\x3f\xda\x6c\xc3\x33\x45\x0a\xb4\x21\x74\xb9\x4a\x71\xdb\x0a\xe0\xda\xca\x29\xaa\x0c\x5d\x02\xe8\x9c\x75\x02\xb6\x2c\x5a\x84\x05\xfc\x70\x03\x3e\x96\xfc\x4d\xb9\x7a\xa5\xd6\x01\x2e\x58\xa4\xf3\x70\xfa\xdd\x0d\x18\xbd\xf6\xd7\x23\x87\xd4\x38\xe3\x97\x01\xc8\xa2\x96\xed\xf6\x02\x7d\x79\x8b\x4f\x5d\x14\xbe\x10\x89\xbf\xc7\x5a\xc6\x26\x13\xc8\x2c\x7c\xb8\xbf\xbf\x03\x87\x55\x83\x35\xc1\x93\xa6\xd5\x7e\xb1\xb0\xd9\xa6\x93\xc0\x53\xef\x51\x67\x8e\xc8\xec\x14\xab\x3f\x35\xad\x82\xa4\x02\x69\x65\xb3\x04\x1a\xb7\x9e\x91\x83\x9a\x9c\x36\xcb\x04\x0e\x95\x26\xb0\x0a\xf1\xeb\x04\xaa\x06\xdd\xe6\x4e\x39\x55\xd4\x50\xa8\xf2\x53\x07\xf9\x3c\xb6\xe5\xc2\x5b\x2a\xa7\x58\x97\xd6\xd4\x38\xf2\xc6\x66\x9b\xbd\x3d\x07\xde\xbe\xd4\x1c\x00\x80\x7e\x3b\x95\x41\xcf\x81\x8e\x04\xba\x30\xc7\x48\x8b\x67\xef\x3c\xc9\x91\x77\xb6\xa1\x17\xd9\x77\x6b\xcf\x99\xf7\x4a\x56\xbd\x40\x65\x30\xe5\xa4\xc8\x13\xe4\x4f\xd1\xf6\x82\xe1\xb9\x9e\x5f\x2b\xe1\x93\x09\xa4\x0e\x15\x21\xd0\x0a\x77\xee\xfa\xfa\xae\xf6\x65\x10\xb0\xa1\xc6\xc3\xe1\xd1\x64\xfe\xb7\xda\xa8\xe4\xc7\xe9\xaf\x72\xaa\x9e\xfe\xf0\xe4\xe1\x06\x16\x8d\x5e\x67\x61\x31\x0b\xf4\x79\x88\x3f\x72\x2c\x40\x75\x0e\xa9\x7c\xdb\xd0\xea\x43\x90\xef\x83\xc5\x71\x9f\x8c\xdd\xd3\xdd\x91\x9c\x21\xf1\xd8\x5f\xb5\x4e\xff\xa3\x48\x5b\x13\x27\x23\xb0\x08\xa8\x96\x45\xb9\x75\xf0\x90\xc0\xa3\xd7\xea\x94\x59\xe2\x2e\x67\x41\x44\x74\xf8\xe8\x43\x02\x79\x41\x72\x56\x3a\x6d\x28\xe7\xf1\xf7\x8f\x71\xf2\x28\x84\x57\xb7\x9f\x0b\xa9\xec\x46\xa2\x7c\x67\xbd\x94\xe7\x7c\x1f\x13\x0a\xbb\xec\x04\x7b\x13\xa8\x4e\x27\xb2\xdb\xeb\xf4\x56\x81\x6f\xef\x65\x78\x92\x0b\x16\x85\xa3\xaf\x15\x55\xf5\xc0\xa5\x4a\xbe\xcd\xb2\xa3\x3a\xa0\x17\x32\x28\xee\x4a\xfe\x1c\xc6\x00\xef\x3b\xf3\x9d\xaf\x15\x87\xa5\xc3\x1a\x0d\xc1\xf4\xfd\x4f\x57\x57\xd7\xd7\x7e\x24\x21\xa3\x4d\x89\x10\x2e\xf8\x21\x2d\xef\x75\x81\x1e\xd2\x4f\xd8\x5f\x66\xbf\xdf\x82\x7d\x44\xe7\x74\x86\x50\x3c\x6f\xf6\xad\x40\x70\xe1\xb1\x02\x06\xf7\xb9\x00\xfe\xe9\xb3\x1f\xb3\xc3\xa2\xed\xa9\x75\x07\x7c\x1f\x8b\x5f\x90\x90\xef\xad\x2b\x14\xf1\x79\x3c\x87\xcb\x8e\x46\xa0\x78\x75\x0d\x97\x30\x8f\xe7\x62\x38\xa1\xfb\x40\xf7\xf8\x37\x7d\x45\xcc\x6f\x9e\x20\xe6\x8f\xfe\x5f\x62\x1f\x4d\x71\xcc\xb3\xc6\x9c\x71\x6d\x84\xe1\x8b\x9e\x84\xe8\xd8\x79\x72\x54\xef\x5b\x3a\x84\xbf\x53\xae\x46\xcf\xe7\x72\xc8\xe6\x72\x1e\xcf\x93\xbe\xd0\xf8\x42\x9c\x6b\xec\xbe\xa7\x59\x74\x41\x70\x13\xf2\xce\xa9\x16\x6c\xd0\xf7\x07\x6a\xc6\x46\x37\xe6\x8c\xd5\x23\xcc\x37\xa4\xe6\x80\x66\xdf\xc5\xbb\xce\x1c\xd4\xc0\x38\xf9\xbb\x7b\xfe\x09\xff\xe5\x84\x26\x0b\x1f\x49\xff\x06\x00\x00\xff\xff\x85\x5d\xe0\x5b\x46\x09\x00\x00")
+var _templatesClient_utils_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5b\x53\xdc\xc8\x15\x7e\x96\x7e\xc5\xb1\xaa\xe2\x55\x83\x10\xde\xf2\x93\xf1\x4e\xaa\x1c\x6c\xb2\x4e\x0c\x4b\x00\x67\xb7\x42\x51\xa6\x19\xb5\x98\x36\x92\x5a\xd3\x6a\xcd\x98\x8c\xe7\xbf\xa7\xce\xe9\xd6\x6d\x66\x80\x71\x76\x53\x8e\x1f\x30\x7d\x39\xf7\xef\x5c\xd4\x2c\x16\x7b\x90\x88\x54\x16\x02\x82\x71\x26\x45\x61\x3e\xd5\x46\x66\xd5\xa7\x5b\x15\xc0\xde\x72\xe9\x97\x7c\x7c\xc7\x6f\x05\x2c\x16\xf1\xa9\xfd\xf5\x84\xe7\x62\xb9\xf4\x7d\x99\x97\x4a\x1b\x08\x7d\x2f\xb8\xb9\x37\xa2\x0a\x7c\x2f\x10\xc5\x58\x25\xb2\xb8\xdd\xff\x5c\xa9\x62\xb0\xf1\x25\xcf\x70\x2d\x15\xfe\xcc\x65\x2e\xf6\xf3\x3a\x33\xb2\xe4\xda\xe0\x4e\x21\xcc\xfe\xc4\x98\xb2\xf9\xbd\xd6\x74\x3d\xcd\xe9\x54\x8b\x34\x13\x63\xfa\xb5\x32\x5a\x16\xb7\x24\xcc\xc8\x5c\x04\x3e\xf3\xfd\xb4\x2e\xc6\x40\x92\xc4\x5f\x54\x72\x1f\x26\xdc\x70\x90\x85\x11\x3a\xe5\x63\xb1\x58\x32\x08\xa5\x8a\xcf\x04\x4f\x84\x8e\x40\x68\xad\x34\x83\x85\xef\xdd\xd0\x02\x0e\x46\x80\xea\xc6\xc7\x5c\x57\x13\x9e\x11\x39\xf3\x3d\x99\xd2\xe9\xb3\x11\x14\x32\xc3\xeb\x9e\x16\xa6\xd6\x05\x2e\x89\xd0\xf7\x96\x7e\xb3\x47\x1e\x88\x4f\xc4\xdc\x4a\x09\x6f\x58\x84\xf7\xfc\xa5\xef\xef\xef\x43\xa2\xe0\xe7\x8b\x8b\x53\xd0\x62\x5a\x8b\xca\xc0\x5c\x9a\x49\xbb\xb8\x51\xc9\xbd\x35\x21\x1c\xa3\x9b\xad\x7f\x59\xa2\xce\xc4\xf4\x57\x69\x26\x64\x52\x2e\xcc\x44\x25\x11\xd4\x3a\x3b\x37\x1a\xac\x17\x22\x58\xb5\x34\x82\x09\xc9\xaf\x22\x98\xd6\x42\xdf\x9f\x72\xcd\xf3\x0a\x72\x5e\x5e\x5a\x92\xab\xa1\x5b\x76\xd0\xe7\xf1\x99\xa8\x4a\x55\x54\x62\xe0\x1b\x95\xdc\xb7\xee\x59\xf1\xed\xb6\xce\x01\x00\x70\xdb\xe3\x98\xec\x59\xb1\x23\x22\xe3\x37\x2b\xcd\x1e\xf3\xdd\x6f\xc7\x1f\xb6\xf6\xdf\x6f\xc7\x1f\xbe\x93\x0b\x5b\xff\x7d\xc9\xb3\xff\x0e\x5d\x5f\xf2\xec\x67\x1b\x50\x44\xe9\x66\x1d\x16\xc1\xa1\x2a\x8c\x28\xcc\xde\xc5\x7d\x29\x82\x03\x08\x78\x59\x66\x72\xcc\x8d\x54\x05\xe5\xdd\xd2\xf7\x52\xa5\xe1\x2e\x82\x19\xb2\xd1\xbc\xb8\x15\x8d\x95\xa8\x68\x4f\xcc\xe5\xdd\x15\x8c\x60\xb6\x6d\xf4\x36\xa0\xbe\xe3\xb5\x7d\x38\x07\x0a\xef\xcd\xe7\xf3\xbd\x54\xe9\x7c\xaf\xd6\x99\x45\x5e\xb2\x75\xb0\x8f\x94\xce\xbf\x4f\xb4\x67\x3c\xab\x45\x15\xc1\xa7\x36\xea\x68\xc3\x91\x14\x59\x52\x7d\x5b\xd0\x91\xee\xf7\x46\x7d\x93\x13\x9f\xc6\x41\x4f\x72\x0f\x08\xde\x13\x28\x70\x25\xb9\x87\x03\xeb\x8b\xf8\x1d\xc9\x0d\x19\x8b\xa0\xc7\x79\x7b\x58\xb4\x1d\x62\x1f\xc9\xf7\xd0\x89\x03\x20\x44\x58\x5b\xcd\x44\x40\x2a\x33\x51\x01\xd7\x02\x0b\xa3\xe0\xb9\x48\xc0\x28\x3a\xd9\x16\x37\xc7\x8d\xa8\xef\x0b\x1e\x32\xe4\x77\x03\xa8\xd4\x11\x94\x73\x0c\xb1\x54\xf1\xa9\x2c\x45\xc8\x7c\x2f\xa7\x8d\xd6\xa5\x18\xad\x5f\xb5\x34\x42\x87\xe5\x9c\xf9\xde\xad\x02\x4c\xab\x90\x1a\xa3\x57\xce\xe3\xc3\x4c\x55\x02\x5d\xf3\x0e\xfb\x65\x38\xc7\xbb\xad\x97\xc2\x7c\x1e\xc1\x40\x69\xc6\x7c\x6f\x19\x32\xdf\xf7\x5a\x11\xdf\x8e\xe1\x7c\x1e\x63\x02\xbf\xe5\x86\xbb\x13\x2c\x69\x21\x7b\x12\xb8\xab\x32\x87\xe8\xad\xca\xd6\xa5\x0f\x61\x18\x5d\xb6\xca\x64\x05\xa8\x1b\x9c\x5f\xea\x55\x37\x09\xad\x19\xec\xef\x43\x65\x54\x49\x00\x24\xbf\x69\x90\x29\xad\x10\x86\x20\xab\xe2\x07\x03\x5a\xf0\xa4\x9f\x5d\xad\x9a\x5d\x46\x60\x73\x6e\x11\x8c\x33\x83\xaa\xcd\x56\x88\x3e\x51\x8f\xc1\xf8\x0f\x02\xed\x16\xfd\x81\x50\xb9\x49\x1c\x35\xf7\x07\x94\x7f\x48\x6d\x34\x18\xba\x39\xee\x0f\xb2\xc2\xdb\xdf\x87\xb1\x16\xdc\x88\x7e\xbd\x40\xd4\x4c\x5b\xd0\x60\x1c\x30\x5f\xce\xec\xe1\x9a\x99\xa8\xd9\xb6\xc9\x69\xbd\x36\x8d\x3f\x9e\x7d\x88\xcf\xf8\xfc\x1f\xa8\x3c\x8c\xe0\xa6\x96\x59\x42\x8b\x73\x52\x3f\x24\xf9\x03\x8f\x11\xa9\x4c\x61\x1c\xbf\xa9\xcd\xc4\x26\x17\xb6\x92\x20\x70\xc1\x68\x58\xdb\xa3\xf8\x5c\x98\x30\xc0\xab\x4a\xcb\x7f\x53\x6f\x0d\xa2\x01\x31\x23\xaa\x27\x73\xcb\x5b\x65\x7a\x17\x41\x9a\x9b\xf8\xbc\xd4\xb2\x30\x69\x18\xfc\x69\x16\x44\x33\xca\x7f\xbf\x45\xf3\x38\xb6\x5f\x13\xf1\x5b\x85\xa6\x74\xf1\xde\x64\x28\x34\xd1\x21\xf7\x46\x30\x7d\x38\x90\x76\xcf\xda\x3b\xc5\x82\xd6\xf8\x92\x7c\x87\x75\x8e\x8e\xd6\x2d\x9a\x56\x3d\x2f\xc9\xb4\x2d\x5e\xea\x0e\xef\xcc\xe2\xf0\x72\x20\xe8\x35\xa8\xbb\x1e\x41\xc3\xf3\x93\xab\x7a\x1d\x5f\x5a\xf6\x79\x37\xff\xa6\xf1\x9b\x24\xd9\xe8\x2b\xcb\x82\xb1\x01\xc9\x72\xb0\x1a\xab\xc2\xc8\xa2\x16\xfe\xfa\xf1\x63\x7c\x19\x5b\x19\xdc\xa6\x6d\x0f\x76\x55\x65\xda\x79\xde\xa5\x8c\xbd\x59\x11\xfa\xe9\x14\x4a\x7b\x80\x05\xc7\x75\x57\x9c\x61\x40\xa5\xc0\x6d\xb2\xb9\x28\xe0\x7f\xf5\xd8\xb4\x5d\xb8\xe0\xb9\xa0\x6b\x48\xe4\x98\x60\x4f\xc6\xe5\xdf\xce\x7f\x39\x19\x5e\x48\xa9\xb1\x59\x50\xac\x69\x15\xf6\x76\x86\x1d\x77\xab\x6c\xdf\x7c\xb0\xc5\xa7\x5f\x4f\xec\xb6\x09\xed\x39\x4b\x1f\xee\x72\x4b\xdf\x4b\xc4\xb8\x95\x75\x22\xe6\x6f\x05\x0e\x46\x3a\x5c\x9f\x9f\x19\xdd\x8d\x3f\x56\xe2\xa4\xce\x6f\x84\x0e\x3b\x35\x0e\x46\x80\x47\x96\x36\x7c\x6e\xc5\xb2\xd7\xdb\xa8\xb8\x21\x1d\x7a\x6e\x44\x2a\xcb\x6d\xc3\xd0\x67\x0f\xfa\x1f\xb3\x38\x8b\x1d\xc9\x4c\x80\xac\x80\xd3\xe8\x85\x31\xe7\x1b\xa7\x35\xac\x8c\xbe\xb9\x2f\x45\x47\x65\x41\x83\xde\x44\x38\x38\x28\xf9\x1e\xf1\x41\xb6\x03\x29\x08\x11\x87\x65\x8b\x50\x97\x6e\xbc\x48\x1c\x88\x70\xf0\x23\xf1\x28\x80\xe4\x3d\x0a\x4b\x87\x65\x07\x4b\xa9\x7b\xc0\xb4\x50\x5c\x99\xb9\xfa\xe0\x63\x10\xd6\x3a\x8b\xff\xe9\x2a\xc7\xe5\x55\x63\x53\x1f\x58\x4e\xc1\x83\x11\x74\x77\x17\x4b\xdc\xd7\x4e\xdb\x8e\x8e\x42\x4b\x7e\x1a\xad\xc5\x6f\x30\x5c\x59\xf7\x63\x54\x1c\x10\x78\x92\x1c\x75\x8a\xba\xe7\x91\xf8\x7d\x91\x48\x2d\xc6\xa6\xdd\x20\x55\x7f\x49\xc9\x12\xc6\xba\x89\xed\x39\x71\x65\xfe\x03\xc2\xdc\x10\x42\xfe\x18\x4a\xaa\x66\x30\xe0\xdd\xb0\xec\x19\xeb\xb8\xc0\x4e\x67\x27\xb3\xfe\x41\xf7\x54\x06\x31\x58\xcd\x62\x3b\xd8\x59\x68\x4a\xdc\x7b\xf1\x1a\x24\xfc\x04\x95\x89\x4f\x6a\x2b\x2e\x64\xaf\x41\xee\xee\x22\x99\x47\x71\xc3\x6b\x95\x89\xed\xa1\x64\xb8\x3d\x73\xec\x7a\x7b\x32\xb5\x15\x2b\x7e\x53\xa8\xe2\x3e\x57\x75\x05\x0b\x1c\xc9\x64\x31\x11\x38\x8c\x25\x80\x80\xc4\xc6\x86\x37\x67\xf1\xdf\x65\x91\x84\x0c\x46\xa3\xd6\xb4\xf3\x16\xa2\x9e\xd7\xcb\xbe\xa1\x2b\xd2\x59\xe7\x4f\xb2\x78\x3d\x15\xbd\x36\x9a\xe8\x51\x5c\x2f\xfd\xe6\x47\x5b\xe1\xed\xda\xf0\x5b\xb2\xc4\x7d\x4a\x9d\x97\x99\x34\xa1\x35\xe3\x82\xdf\xc6\x7f\xc5\x5e\x8e\xd5\x23\x60\x11\x04\x51\x80\xb6\x23\x68\x91\xc6\xf0\xdb\xcb\x17\x57\xd6\x70\xda\x1b\xd1\x4c\xf0\xf5\x6b\xb7\xda\x0b\xe0\xeb\x57\x90\xd5\xbb\xbc\x34\xf7\xad\x0d\x64\x42\x26\x8a\xd0\xf0\x5b\x06\x7f\x86\x1f\xe1\xf9\x73\xe2\xf6\xe3\x15\x7a\x23\x50\xb9\x34\x02\x29\x02\xfb\x51\x30\xd4\xd9\xf7\xbc\x6a\x2e\xcd\x78\x02\x29\x6a\x91\xce\xe2\xf7\x4d\xaa\x84\x2c\x0e\xd1\xc7\x96\x6c\xcc\x2b\x01\x08\x83\x03\x34\x7e\x87\x7c\x05\x23\xe0\x65\x29\x8a\x24\xdc\x71\x98\x6b\xb0\xb2\x40\xa5\x23\x48\x97\xac\x21\xdd\xf9\x56\xda\x9d\x1e\xf1\xe5\x55\x4b\xed\xfa\x37\xd2\x74\x75\x30\x75\x81\xda\x56\x2d\x99\x09\x62\x4e\x41\x4b\x44\xca\xeb\xcc\x1c\xac\x61\xe9\x59\x0f\x4b\x99\x1c\x8b\x87\xa0\x44\x39\x14\x36\x28\x72\x22\x66\x5b\x03\xa9\x1f\x10\x52\x88\xb2\xe9\xb3\xcb\xa6\xcf\xf0\x13\xc2\xfb\x83\x28\x30\x91\x3e\xef\xee\x7e\x9b\x16\x58\x4d\xc4\x97\xf0\x33\xfb\x26\x5c\x2f\xfb\xbd\xa3\xeb\x19\xab\xd0\xeb\x6a\xba\xae\x45\xf3\x71\x44\x70\xc7\xae\x42\xed\x55\x69\x20\xf0\x51\xad\x47\x28\x1a\x91\xd8\x12\xbd\x01\xc7\xab\x55\x09\xef\xd3\x25\xb8\x51\x2a\x63\xf4\x13\xcd\x6f\x10\xdb\x46\x6a\xe1\x5b\x9c\x34\xf4\xa7\xf8\x31\xd8\x2c\x5a\x40\x77\x5b\xc7\xbc\xec\x16\x14\xdb\x03\x57\x72\x66\xf1\xfb\xea\x44\x66\xee\x03\xba\x71\x81\xd1\x4d\x8a\x2f\xa9\xda\x3f\xeb\x34\xeb\x15\xfb\x94\x67\x95\x68\xae\x6c\x2e\x49\x28\x0a\x93\x78\xe3\xe9\x31\x2f\x07\xec\x5c\xd4\x31\x8b\x5f\xf4\x03\xd2\xdc\x7f\x2b\x44\xf9\x6e\x5a\xf3\x2c\x1c\xa6\x6d\x67\xda\xbf\x84\x56\x78\x68\xcb\x34\xeb\x5f\x6a\x9e\x6c\xfa\xe8\x41\x28\xf5\x1a\xb4\xeb\xc8\x18\x9c\xc8\x3d\xcc\x27\xc0\x2b\x90\xe6\x87\xca\x76\x5c\x7b\xad\xf9\xa6\x9d\xd6\xca\x34\x0d\x78\x03\x28\x07\xdd\xa5\x37\x35\x20\x4c\x5b\x8d\x09\xc5\xbd\x4e\x33\x5b\xaf\x4a\x8d\x7b\x0f\x79\xf1\x26\x49\x34\x86\x0a\xdc\x90\x40\x4a\xe5\x76\x0a\xcc\x84\x6e\xc6\x85\x84\x1b\x51\xc1\x84\xe3\xa4\x53\x2a\x9a\x05\x40\x8b\xb1\x90\x33\x81\xc0\x9f\x01\x15\x3e\xcb\x6c\x28\x69\xf9\xe0\x8c\x39\x7b\x6c\xb2\x6c\x26\x36\x1c\x17\x2a\x67\x66\x7f\x02\x24\x4e\x1f\x0b\xa7\x69\x78\x13\xc1\xf3\x0d\x33\x20\xd6\x48\x4b\x1b\xde\x30\xc7\x0f\xb3\x9b\x3e\x1d\xd0\x81\x11\x54\x6c\x43\x9a\x0e\x1f\x79\xec\xf2\xa9\xb9\xeb\xc1\xb1\x8f\x72\x75\xed\xd9\x08\x76\x5a\x82\xd8\xbe\x3f\x3d\x32\x43\x6c\x1c\x21\xb0\xc6\x59\x1b\x66\x3c\xab\xba\x52\xee\xb8\xb8\x77\x4c\x7c\x87\x9d\x0d\x0e\xdd\xd7\x6c\xe7\xcb\x7c\x6e\x35\xb0\xcd\xd0\xb1\xdc\x54\xed\xfa\xb1\xe9\x97\x39\x27\x26\xed\xc4\xd8\x2e\xe2\x06\x6a\xd3\xc6\x3f\x9f\xc7\x87\xf4\xc6\x70\xe4\xcc\x09\xd3\xd8\xd5\xd9\x18\x49\xe8\xfd\xc3\xcd\x2e\xab\xd2\x07\xc2\x97\xae\xd8\x58\x2a\xfb\xda\xda\x1f\x1e\x87\x2d\x9a\xee\x76\xef\xd1\x52\xc5\x87\xaa\xbc\x0f\xad\x6a\x03\x1e\xec\xf5\x93\x72\xbb\x2a\x92\xbb\xc7\xc1\xe6\xa3\xf2\x2d\x3e\x9e\x68\x51\x6a\x51\x89\xc2\xc0\xd9\xd1\xe1\xcb\x97\xaf\x5e\xe1\xdf\xa6\x84\x9d\xfd\xe9\x02\xfe\xb5\x2e\xbe\x90\xb9\x40\x12\x97\x0b\x94\x75\x6a\x26\xb4\x96\x89\x00\x07\x6a\xdc\x74\x0f\x5b\x06\x76\x90\x96\x41\xef\x7e\xc8\x20\xbc\xbc\xc2\x2f\xa7\xfe\xd8\xed\x54\xb3\x07\x61\x2b\x2b\xdc\x31\x8c\x9e\x14\xb9\x09\xaf\x83\x6b\xd8\x05\x3a\x22\x15\x5f\xbe\x82\x5d\xb8\x0e\xae\x59\xff\x4f\x75\x4e\xd0\x85\xf8\x62\xd6\x14\xc3\xcd\x07\x14\xc3\xa3\xff\xad\x62\x6d\xce\x0f\x7d\x56\x17\x8f\x78\x6d\x40\x13\xde\x38\x25\x7a\xa9\x64\xaa\x16\x1c\xa4\xd9\x29\xd7\x95\x40\x7d\x76\xfb\xda\xec\x5e\x07\xd7\xcd\x2b\xbf\xfd\x44\x5d\x87\xe9\xb0\x7c\xf9\xde\x8e\x81\x11\xa0\x16\xa1\xd9\x54\x69\x5a\xcd\x86\x8e\xae\x8b\x47\x5c\x3d\xa0\xf9\x3f\xb2\x66\x45\x4d\xf7\xac\xd5\x3c\x55\xf5\x30\x30\x0c\x7e\x73\x0f\x1d\x82\x7f\x85\x17\x45\x02\x7b\xcb\xa5\xff\x9f\x01\x00\x2a\x42\x43\xe8\x92\x1f\x00\x00")
 
 func templatesClient_utils_goTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -447,6 +459,66 @@ func templatesEnum_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesError_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\xdf\xab\xd3\x30\x14\xc7\x9f\x9b\xbf\xe2\x90\xa7\x16\x6a\x77\x1f\x7c\xba\x8f\xca\x05\x11\x9c\x72\x27\xfa\x20\xe2\x42\x73\xb6\x45\xd7\x24\xe6\x9c\xad\x8c\xd2\xff\x5d\x92\x74\xb5\xd3\xf9\x60\xa1\x90\x26\xe7\xd7\xe7\xfb\x4d\x87\x41\xe3\xce\x58\x04\x89\x21\xb8\xf0\x6d\xef\xe4\x38\x0a\xaf\xda\x1f\x6a\x8f\x30\x0c\xcd\x87\xbc\x5c\xab\x0e\xc7\x51\x08\xd3\x79\x17\x18\x4a\x51\x48\xb4\xad\xd3\xc6\xee\x57\xdf\xc9\x59\x29\x0a\x69\x91\x57\x07\x66\x2f\x45\x25\xc4\x6a\x05\x4f\xb1\x22\x18\x02\x3e\x20\x78\x75\x39\x3a\xa5\xc1\xed\xd2\x67\xea\x06\x01\xc9\x3b\x4b\x48\x75\x8c\x9f\xf7\x09\x54\xc0\x14\x76\x36\xee\xa8\xd8\x38\x4b\xd7\xc4\xd6\x59\xe2\xa0\x8c\xe5\x79\x2b\xe0\xcf\x13\x12\x83\x57\x41\x75\xc8\x18\x48\xf0\xc5\xe3\xd4\x9f\x38\x9c\x5a\x86\x41\x14\xef\x90\x28\x52\x11\x07\x63\xf7\x90\x9e\x6d\x1c\xfe\x31\xc3\xcb\xad\x28\x52\x0e\x01\x7c\xf9\xfa\xe9\xda\xfa\x26\x86\x6a\xd7\x19\xc6\xce\xf3\x45\x6e\xc5\x98\x38\x3f\x07\xc3\x98\x12\xa1\x8f\x4b\x02\x65\xff\x00\xbc\x8e\xba\x37\x67\xb4\x40\xac\xf8\x44\xd0\x3a\x8d\xb7\xe0\x51\xac\x24\x2b\x6a\x50\x04\x0a\xde\x6e\xde\xaf\x33\x47\x23\x76\x27\xdb\x2e\x7a\x95\x3d\x44\xb1\x9b\xe7\xa9\x45\x3a\x09\x75\xaa\x0a\xc6\x72\x1d\x4b\xc6\xd7\x85\x2a\xd2\xf7\x8b\xcc\x1c\x55\xe7\xca\xc3\xa4\xcb\x63\x0c\x6e\xd2\x56\x59\x8d\xd5\x12\x6e\xd6\x82\xae\x84\x11\xe6\xe5\xc3\xc3\x0d\xa0\x9a\x9d\xe8\x0f\x8e\x70\xe1\x47\xf2\xd3\xd8\xb3\x3a\x1a\x3d\x13\x2f\xbc\x8d\xc7\x47\x43\x8c\x1a\x8c\x5d\xde\x83\x49\xb6\xfb\x32\xfc\x9e\xea\x5f\x5a\x2c\x5a\x2c\x0c\xbd\x23\x47\x52\x72\x93\x6c\x79\xa5\xf4\x73\xc6\xf8\x4b\x1f\x39\x21\xdc\xb9\x71\x72\x0a\xa6\xc7\x45\xd3\x2c\x62\x1a\xb8\xff\x1f\xdf\x72\xa9\x3c\x66\xf3\x06\x95\xc6\x50\x56\xcd\x06\xb9\x94\xaf\x9d\x65\xb4\xfc\xe2\xe3\xc5\xa3\xac\x41\x2a\xef\x8f\xa6\x4d\x58\xf9\x3f\xac\x44\xd1\x37\xa9\xe6\x94\x18\xeb\x56\xa2\x88\x87\xcd\x1a\xfb\xa7\x74\xbd\x42\xd9\x57\x4d\x5e\x96\x18\xa7\x1c\x06\xb4\x7a\x1c\xc5\xaf\x01\x00\x3b\x1e\xad\x03\x10\x04\x00\x00")
+
+func templatesError_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesError_goTmpl,
+		"templates/error_go.tmpl",
+	)
+}
+
+func templatesError_goTmpl() (*asset, error) {
+	bytes, err := templatesError_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/error_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesFile_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\x8d\x94\xc1\x8e\xd3\x30\x10\x86\xcf\xf1\x53\x0c\x39\xa0\x04\x95\xe4\x82\x38\xb0\xea\x01\x09\x90\x40\xa2\x20\xca\x9e\x10\x62\x9d\x64\xd2\x35\x9b\xd8\xc1\x76\x40\x55\xd4\x77\x67\xc6\x71\x76\xdb\xdd\xb2\xa2\x87\x68\xea\xcc\x3f\x33\xff\x37\x56\xa6\xa9\xc1\x56\x69\x84\xb4\x55\x1d\xfe\xd8\x99\xf4\x70\x10\x83\xac\x6f\xe4\x0e\x61\x9a\x8a\xcf\x73\xb8\x91\x3d\xd2\x0b\xa1\xfa\xc1\x58\x0f\x99\x48\xd2\x6a\xef\xd1\xa5\x14\xa0\xae\x4d\xa3\xf4\xae\xac\xa4\xc3\x97\x2f\x4e\x8e\x7e\x3a\xa3\xf9\x40\x99\xf9\x59\x2a\x33\x7a\xd5\xa5\x22\x17\xa2\x2c\xe1\x1d\xf5\x04\xe5\x40\x02\x77\x07\xd3\x52\xd4\x8f\x9d\x57\x83\xb4\xbe\x6c\x8d\xed\x9f\x37\xd2\x4b\xa8\x4c\xb3\x2f\x58\xf0\xde\x73\xba\xf3\x16\x69\xa0\x06\x5a\x6b\xfa\xd2\x1b\xf0\xd7\x18\x72\xb8\x02\xc7\x16\x7f\x8d\xe8\xfc\x8a\x25\x2a\x48\xc2\x44\xa4\x90\xdc\x6c\x1e\x94\xcb\xd0\x8c\xa0\x74\xd0\x18\x7a\x58\xe0\x9e\xd2\xbb\x15\x60\xb1\x2b\xe0\xc3\xf6\xd3\xa6\x10\x7e\x3f\xe0\x3c\x2a\x29\xc6\xda\xc3\x24\x12\x06\x02\xb0\x94\xa0\x1f\x75\xd2\x7c\x16\x27\x60\x3f\x22\xf9\x82\xb2\xa1\xa2\xca\x14\x31\xa2\xac\xda\x68\x8f\xda\x9f\x24\x1e\x02\x8d\x8f\xd2\xba\x6b\xd9\x71\xd3\x38\xaf\x0b\x29\x67\x14\x67\x7c\x04\xb3\xc7\xe9\xe4\x9a\x30\xcd\x90\x20\xf6\x97\xba\x81\x1b\x1c\x3c\x9b\xee\xb1\x37\x96\xb0\xb6\xa3\xae\x21\x6b\xe1\x19\x5b\xcc\x8f\xa7\xc8\x72\xc8\xbe\x7d\xe7\x45\x13\x0f\x6b\x8d\xcd\xd9\xba\x6a\xa1\x5d\xfc\xac\xd7\xa0\x55\xc7\xa7\x89\x45\x3f\x5a\x0d\x73\x7e\x76\x95\xa6\x57\xf9\x8a\x5f\x8a\xe4\x20\x92\x2a\x14\x80\x57\x6b\x98\x2f\x40\xd0\xbf\xee\xba\x6c\xa9\x94\x87\xba\x9c\xf3\xe4\x41\x49\xfa\x1b\xe4\xa1\xd2\x5d\x6b\x08\x37\xb0\xd8\xe0\x9f\xf9\x24\xab\xa8\x48\x94\xf0\xbd\x2b\xa2\x93\x6c\xc6\x54\x6c\x7d\xf3\x36\xde\xcb\x22\x04\xf8\xd5\x6c\x03\x3a\x52\xe6\x71\x07\x97\xba\x3f\xda\x42\x83\x8f\x6f\x21\xa0\xbd\xb7\x87\xfb\x3c\x4f\x2a\x66\x55\x04\x94\xcf\x40\xd9\xe6\x6f\x69\xc1\x2d\xe2\x85\x02\x91\x0a\x1e\x6e\xd5\x19\x21\x7c\xea\xf2\x8b\x7f\x30\x5a\xf0\xc4\x29\x6f\x71\x9f\xf1\xfe\x26\x98\x8a\xce\xdd\x63\xe0\xff\x83\x79\xec\x77\x47\x9e\x37\x7e\x10\xd3\x84\xba\xa1\x0f\xc6\x5f\x77\x75\xfc\x38\x5f\x04\x00\x00")
+
+func templatesFile_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesFile_goTmpl,
+		"templates/file_go.tmpl",
+	)
+}
+
+func templatesFile_goTmpl() (*asset, error) {
+	bytes, err := templatesFile_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/file_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesForm_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\x9d\x56\xdb\x6e\xdb\x46\x10\x7d\x16\xbf\x62\x4a\x20\x29\xe9\xd0\x94\x91\xbe\x29\xce\x43\xd1\x26\x6d\x51\xd8\x71\x63\xa3\x2f\x86\x11\x6c\xc8\xa1\xb5\xb6\xb8\x54\x97\x4b\xda\xaa\xa2\x7f\xef\xcc\x2c\x29\x53\xb4\xe4\xa2\x05\x2c\x93\xdc\xb9\x9d\x39\x73\x21\xd7\xeb\x1c\x0b\x6d\x10\xc2\xa2\xb2\xe5\x97\xdb\x2a\xdc\x6c\x82\xa5\xca\xee\xd5\x2d\xc2\x7a\x9d\x5e\xf8\xdb\x73\x55\x22\x09\x02\x5d\x2e\x2b\xeb\x20\x0a\x26\x61\x51\xba\x90\x2e\xa5\x2e\xb1\xbf\x4e\xcb\x66\xe1\xf4\x52\x59\x91\x18\x74\xd3\xb9\x73\xcb\xfe\xbe\xb1\x0b\xbe\xb5\x58\x2c\x30\x13\x8d\xda\x59\x6d\x6e\xeb\x30\x88\x83\x60\x3a\x85\x52\x3d\x7e\x24\x10\x67\x58\x56\x76\x05\xba\x06\x37\x47\x3e\xd4\x65\x53\x42\xad\xff\x46\xa8\x0a\x50\xb0\x0d\x32\x65\xc8\xc7\xb9\x72\x0a\xbe\x56\xf9\x0a\xee\x71\xe9\x40\x1b\x28\xc5\x41\xc2\x2e\xd9\x83\xc5\x52\x69\x43\x81\xa0\xd0\x0b\xac\x41\x59\x84\xda\x55\x16\x73\x56\x76\xc8\x29\x29\x0a\x28\xd2\x34\xc8\x2a\x53\xbb\x11\x96\xf7\xf0\xc3\x5b\x38\x3d\x85\xb7\x27\x41\xd0\x2a\x2b\xaa\x57\xab\x25\x92\xa0\x4b\x27\xe5\xc7\x4f\x45\xf4\x91\x24\xeb\x8d\xcf\xe7\x67\xcc\xaa\x1c\xd9\x0d\xe4\x72\xdb\x65\xb4\x07\x7f\x65\x41\x2d\x97\x0b\x9d\x29\xa7\x2b\x33\x7d\x3c\x7e\x78\x78\x38\x16\x31\xb1\x86\x86\x8d\x73\x49\x92\xfd\x0a\x0b\x16\xff\x6a\xb0\xe6\x7c\x5d\x25\x6e\x89\xcc\x26\x73\xb0\xac\xe8\x84\x94\xe9\xf4\xeb\x0a\xda\x94\x0d\xae\x48\x5c\x68\x5c\xe4\x3e\x79\x0f\x26\x07\x55\x33\x01\x1e\xe5\x1f\x0d\xda\xd5\xa5\xd4\x23\x11\x77\x9c\x48\x67\xc4\x2e\xd8\xce\xa2\xca\xa1\xb0\x55\x29\x0a\x9e\xcc\x17\x2b\xb2\x0d\x3e\x22\xb9\xf3\x56\x56\x2d\xa3\x70\xe2\x0e\x4d\xce\xce\x7c\xc1\x24\xb5\x34\x28\x1a\x93\x0d\x58\x8c\x2c\x1c\x71\x43\xa5\x9f\xbd\x42\x02\x2d\xa7\x8f\xb6\x50\x19\x93\x0e\x68\x2d\x11\xb9\x0e\x26\xb6\x85\xd9\x53\x69\xfe\x54\x8b\x86\x6b\xd3\xc6\xc1\x44\x17\x60\xdb\xf4\x77\x6d\xf2\x28\x86\xef\x9e\x74\x2e\x9c\x85\x6f\xdf\x58\xf6\x61\x81\x65\x14\xef\x51\xb9\xf4\x04\x93\xfb\x89\x45\xd7\x58\x03\x34\x02\xe9\x07\x8e\x59\x44\x61\xa6\xcc\xf7\xae\xa3\x16\x98\x06\x5f\x99\x57\x57\x21\xc1\xa4\xc0\x34\x3c\x93\x12\x73\xad\xb8\x51\x12\xf8\x42\x7f\x8c\x91\xc7\x86\x86\xcc\xd6\x78\xd6\x0b\x23\x9b\xfe\x4a\x4c\xa3\x4d\x7f\x41\x17\x85\x3f\x55\x94\xa2\x71\xc7\x2c\x0a\x63\x9f\xc2\xd6\x11\xe3\x0b\xf7\xd0\x1f\x0a\x4c\xd2\x24\x4a\x84\x0a\x1f\x43\x58\x8c\xdf\xc9\x29\x59\x1a\xbd\x10\xbd\x3e\x1f\x3a\xa6\xa7\xcd\x53\x82\xf9\xb8\x37\x3c\x07\x84\xf0\xa2\xaa\x1d\x7b\x4b\x9e\x28\xeb\x92\x7c\x16\xf4\xac\x87\x27\xd1\x77\x06\xeb\x39\x94\x21\x92\xcd\xd0\xd9\x21\x28\x34\x1f\xbe\xc0\x35\xa1\xda\x09\xe5\x8f\xe3\x21\xc2\x7f\x0b\xb7\x93\x36\xfb\xe0\x29\x78\xee\x98\x4f\x77\x12\xdf\xc8\xbc\x8f\xcc\xa0\x46\x57\x6f\x67\x29\x81\x23\x19\x29\x45\x7d\x7e\x7d\x33\x98\x2e\x3f\x42\x7e\x7a\x7d\xc7\x8f\xc3\xfb\x99\x29\xd5\xf2\xda\x2f\xcc\x9b\xeb\x9b\xa3\x6d\xcd\x05\x8c\xef\x97\x04\xea\x76\xb7\xe9\x07\x23\x41\xab\x82\x58\xac\x5b\x59\x54\x11\xd5\x8a\x7a\x05\x34\x9f\x9d\xbc\xa3\xeb\x29\x21\x48\xcf\x1b\x8a\x48\x98\xb8\x45\xf4\x9b\x37\xc2\x90\x80\x14\x53\x0e\xc5\x42\x1d\xfb\xd6\x12\x49\xfa\xa3\xa9\xcc\xaa\xac\x9a\x1a\xd6\x40\x1c\x68\x33\x47\xab\x65\x01\x51\x1c\xee\x2d\xd2\xa4\xa8\xbd\x69\x3f\x57\xef\xf7\xce\xd5\xe4\x59\xc1\x47\x2c\x24\x43\x5f\x7b\x1a\x79\xd4\xca\xbe\x99\xfd\x3f\xda\xeb\x4e\x9b\x06\xbb\x0e\x37\xf4\x46\xf3\x69\xc9\x3b\x28\xbd\xa4\xed\xeb\x22\x9f\xd3\x95\xba\xf5\xb3\x77\x57\x57\x26\xa4\x0e\x0a\x93\x30\xbe\x3e\xb9\x21\xb3\xb9\x30\x5d\xb3\xa5\x00\xba\x66\x3f\x37\x9e\x10\x71\x49\x89\x85\x21\xaf\x92\xed\xd3\xb1\x3c\xd2\x16\x8f\x3a\x63\xc9\xfe\xc4\x03\xde\x41\xc5\x74\xb7\x5d\x99\x06\x5c\xd7\x0f\xda\x65\x73\xd1\xcf\x54\x4d\x7d\xd3\x17\x91\xfd\xf4\xaf\xa2\xd9\x40\xfa\x9c\x63\x5e\x6f\xaf\x5f\x3f\x59\x76\x9d\x3b\x76\x40\xe1\xd3\x4b\x4a\xbc\x37\x3b\xc7\x87\xa8\x97\xf3\x7c\x0b\xbe\xf7\xec\xc6\xdb\xbf\x18\xf3\x92\x5e\x67\xf8\x7f\xa2\x9e\xa9\x7b\x14\xe3\x68\x6b\x99\xec\xf0\xb7\xfb\xd4\x01\xa3\x76\xbe\x4b\xa0\x98\xcb\xe6\x51\x86\x3e\x5f\xfa\x5a\x8d\x3b\xab\x5a\xa2\xe9\xfb\x2a\x2a\xe6\x09\x03\xfc\xcd\xe4\xf8\x18\xdd\xbd\xd8\x53\xc3\x75\xaf\x4d\xab\x16\x3a\x97\x3c\xe0\x55\x3b\xa3\x1f\x2d\x7a\xae\x79\xc2\x0e\xe2\xc3\xcd\x47\xdf\x5b\x8a\x86\x77\xb6\xa7\x27\x0f\x40\xec\xf2\xa0\x06\x64\xa8\x87\xd7\xf7\x7f\xc4\xb7\x19\xae\x3c\xf2\xc5\x5b\x4c\xf6\xcf\x88\x1f\x38\xb0\x6c\x8a\xc3\xcb\xa6\x48\xfa\x44\x8a\x79\xfa\x89\xdc\x45\xf1\x76\x97\x1f\xde\xbe\xa3\x36\xe8\x5f\xdb\xf2\x49\x45\xda\xfc\x0d\x3a\x03\x60\x97\x7c\x24\xb9\xd0\xf1\x67\x81\x33\x83\x82\x1e\x36\xdc\x0b\x3b\x19\xad\xd7\xf4\x61\x41\x5f\xae\xff\x00\x47\x3c\x4e\x84\xe8\x0a\x00\x00")
+
+func templatesForm_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesForm_goTmpl,
+		"templates/form_go.tmpl",
+	)
+}
+
+func templatesForm_goTmpl() (*asset, error) {
+	bytes, err := templatesForm_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/form_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _templatesGeneric_mainTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x1c\xc9\x41\x0a\xc4\x20\x0c\x05\xd0\xfd\x3f\x85\xb8\x9a\x39\x95\x04\xfd\x4a\x68\x0d\x52\xec\x2a\xe4\xee\xa5\xdd\x3d\x78\xee\x8d\x5d\x8d\x29\x0f\x1a\x2f\xad\x65\x8a\x5a\xd9\x9c\xeb\x94\xcd\x1c\x81\x25\xf5\x90\xc1\xf4\x06\xd0\x6f\xab\x1f\x7f\x7f\x07\x02\xee\xb4\x16\x81\x27\x00\x00\xff\xff\xdc\x57\x73\x81\x49\x00\x00\x00")
 
 func templatesGeneric_mainTmplBytes() ([]byte, error) {
@@ -467,6 +539,26 @@ func templatesGeneric_mainTmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesHeader_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\x51\x6f\xdb\x36\x10\xc7\x9f\xc9\x4f\x71\x11\x90\x56\x32\x34\x79\x05\x8a\x3e\x64\xf0\xc3\x36\xac\x59\x36\xac\xeb\x90\xf5\xa9\x28\x0a\x4a\x3a\x49\x5c\x24\x52\x21\x69\x05\x86\xa0\xef\x3e\x1c\x29\xd9\xb1\xe7\x24\xc3\xf6\x60\x58\x94\x78\x7f\x1e\xef\xfe\xbf\x1b\xc7\x12\x2b\xa9\x10\xa2\x06\x45\x89\xe6\x6b\xad\xa3\x69\xe2\xbd\x28\xee\x44\x8d\x30\x8e\xd9\xc7\xf0\xf8\x41\x74\x38\x4d\x9c\xcb\xae\xd7\xc6\x41\xcc\x59\x84\xaa\xd0\xa5\x54\xf5\xfa\x2f\xab\x55\xc4\x59\x54\x75\x8e\xfe\x14\xba\x75\xe3\x5c\x4f\xcf\xd6\x99\x42\xab\x21\xe2\x09\xe7\xeb\x35\xdc\xa2\xfb\xd9\x9f\x03\x16\x9d\x05\xd7\x20\x84\x73\x41\x57\x7e\x55\xcb\x01\x15\x28\xd1\x21\x38\xed\xdf\x0c\xa2\xdd\x22\xf4\x5a\x2a\x87\x25\xbd\xcc\x77\x30\x64\x24\xf6\x67\x83\x60\x9d\x91\xaa\xb6\x29\xa8\x6d\x97\xa3\xb1\x20\x54\x09\xb9\xd6\x2d\x0a\x65\x41\x18\x84\x07\x23\x9d\x43\x05\xc2\x82\xb4\x29\xc5\x91\xaa\x76\x0d\x1a\x70\xbb\x1e\x6d\x0a\x98\xd5\x99\x3f\xab\x14\x8e\xd6\x14\xe6\x2f\x87\x25\x85\xfd\x72\xfb\xfb\x87\xe5\xa0\x8c\x57\x5b\x55\x1c\xee\x11\x37\x40\x57\xcd\xc2\x2a\x0d\x99\x87\xbd\x29\x0c\x40\x49\x9b\x4a\x14\x38\x4e\x09\xa0\x31\xda\xc0\xc8\x99\x7d\x90\xae\x68\xa0\x87\xab\x0d\x0c\x59\x4c\x59\x24\xf4\xbe\x10\x16\x61\x15\xa2\xaf\x38\x63\x4d\x76\x8b\x2e\x26\xc9\x14\x56\x7d\xb2\x6c\x90\xca\x9d\x7e\x9d\xcb\x9c\xdd\x38\x2d\xe2\x55\x9f\x3c\xde\xfb\xee\xed\x53\xbb\xdf\x6b\xd3\x09\x77\xa3\x5c\xbc\xea\x53\x78\xf3\xed\x21\xac\x6a\xb5\x78\x31\xf0\x3d\x6d\xf2\xa1\xaf\xeb\xd7\x29\x7c\xf3\x26\x85\x77\x6f\x0f\x1a\xd4\x85\xe7\x05\x7e\xd0\xba\x9d\xb3\x2d\xb1\x12\xdb\xd6\xdf\x2b\x4f\xa9\x52\x54\x1b\xf2\x55\xf6\x9b\x30\xb6\x11\x6d\x3c\x24\x9c\x31\x59\xf9\x6f\x17\x1b\x50\xb2\xa5\x92\x31\x66\xd0\x6d\x8d\x82\xaa\x73\xd9\x4f\x54\xe0\x2a\x8e\xa4\x1a\x44\x2b\xcb\xc5\x5a\x97\xc3\x15\x5c\x0e\x51\x68\x8e\x17\x27\xa9\x89\x33\x66\xf7\x47\x2d\x89\x7d\x52\xf7\x5b\xed\x30\x0e\x4d\x88\xf3\xe4\xcc\xa9\xb0\x5e\x83\xd2\x0e\xc4\x63\x67\x50\x2a\x16\x36\xf3\x2a\xce\x97\x23\x8e\x6e\x9f\x70\x36\xf1\x25\x63\x25\x5b\x3e\x79\x2a\xae\xf7\x54\x94\x48\xae\x7b\x01\x0c\xa9\x9e\x43\x63\x6f\xf1\x39\x5e\xda\x59\xd5\x7b\x79\xa1\x21\xdf\x1d\x3c\xec\x61\xba\x71\x10\xf2\x22\x84\x66\xab\xca\xea\x44\xa8\x93\xd6\x4a\x55\xcf\x10\x5c\xff\x2f\x08\x64\x05\x5f\x53\xd0\x77\x54\xfd\xe6\xb3\x8f\xff\x51\x28\xad\x64\x21\xda\x90\xd7\xaf\xb8\xf3\xbe\x4b\xbe\x7c\x07\x17\xfa\x8e\x82\xce\x75\x7b\x4e\x6a\x49\x73\xdf\xe9\x50\x6c\xeb\xf5\xb3\xeb\xb9\x09\x09\xe7\x6c\x10\x86\xd2\xa0\x9f\x36\xff\x9e\xc6\x55\x4f\xed\x3d\x81\x90\xdc\x4f\x5a\x07\x07\x7d\xef\xb4\x8c\xed\x9e\x82\x3d\x80\xff\xdc\xf9\x51\x18\x8b\x44\x9f\x25\xf8\x3c\x3c\x67\xf8\x7b\x22\x2e\xc0\x67\x8f\xa2\x3c\x71\xe4\x4e\xad\xda\x1d\x38\xb3\x45\x3f\x0e\x2b\xd1\x5a\x4c\xbd\x65\x0f\xa3\xcf\x8f\x55\x0b\xa2\x28\xb0\xa7\xc1\x9a\xef\x8e\xf5\x89\xcd\x60\x7d\x0b\x17\x1b\x88\x48\x2d\x82\x57\xaf\xe6\xa5\xd7\x8c\xa8\x4c\x8c\x85\xec\xce\x01\x38\x0f\x62\xb8\xbc\x8f\x82\xfb\xd9\xb4\x2f\x24\x6c\x16\xd5\x23\xfa\x83\x98\x27\xff\x93\xea\x66\xf6\x3f\x7f\xc9\x77\x01\x4a\x9f\xe0\x1f\x01\xd1\x24\x49\x81\xa6\xc2\xc4\xcf\xcc\x85\xff\x38\x16\x4e\xf1\x1c\x47\x54\xe5\x34\xf1\xbf\x07\x00\xb9\xc9\x50\xd1\x28\x07\x00\x00")
+
+func templatesHeader_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesHeader_goTmpl,
+		"templates/header_go.tmpl",
+	)
+}
+
+func templatesHeader_goTmpl() (*asset, error) {
+	bytes, err := templatesHeader_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/header_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _templatesIndexHtmlTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x90\xc1\x4e\x03\x21\x10\x86\xef\x7d\x8a\xc9\xde\x65\xd2\x1e\x0d\xc5\x98\x78\xd1\x93\x89\x7d\x01\x0a\xb3\x05\x03\x0b\x01\xd6\xb8\x21\xbc\xbb\xd9\xa2\xd9\x3d\x78\xfb\x08\xf3\xf1\x0f\x7f\xad\x9a\x46\x3b\x11\x0c\x76\xd2\xf4\xcd\x4c\xf1\x6e\x68\xed\xc0\x57\x10\x07\x00\x00\x6e\x48\xea\x8e\xf7\x63\xb1\xc5\x91\xa8\x95\x5d\x56\x68\x0d\x3e\x28\x7d\x51\xe2\xd8\x2f\xba\x83\x9b\xc4\xaf\x41\x2f\x3b\xdf\x1c\x05\xfc\x63\x9b\xe3\x6e\x26\x6e\x7c\x31\x36\x43\xbe\x0f\x81\xcd\x20\xe7\x12\xbc\x2c\x56\x49\xe7\x16\xb8\xd1\x44\x49\x16\xd2\x30\xa6\xe0\x81\x25\xe9\x1d\x8c\xd6\x11\x5c\x17\xe0\x12\x4c\xa2\xf1\x3c\x98\x52\x62\x7e\x44\xbc\xd9\x62\xe6\x2b\x53\xc1\xe3\xdb\xec\x63\x56\xd2\x11\xde\xc2\xc3\x6a\x0d\xe2\x17\x38\x4a\x01\x31\x85\x4f\x52\x85\x6d\x1b\xe1\x6e\x25\x8e\x26\xed\x3f\x74\x12\x5b\x96\x8c\x56\x07\x95\x71\xab\xf3\x69\x7d\xf5\x2c\xa3\x65\x3d\xe7\xf9\xfd\x15\x5e\x82\xca\xb0\x26\x71\x34\xa7\xbf\xca\x7a\x4f\x1c\x7b\xf5\xb5\xd2\xa4\x5b\x3b\xfc\x04\x00\x00\xff\xff\x56\x0e\xa1\x8a\xa2\x01\x00\x00")
 
 func templatesIndexHtmlTmplBytes() ([]byte, error) {
@@ -687,7 +779,67 @@ func templatesObject_nimTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesPython_server_resourceTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x52\x4d\x6b\xdc\x30\x10\xbd\xfb\x57\x0c\xc1\xe0\x5d\x70\x4c\x0e\x3d\x05\x16\x9a\x94\x16\x02\x6d\x09\xa5\xf4\x12\x8a\x51\xe3\x51\x56\x8d\x25\x39\x23\xd9\x61\x51\xe7\xbf\x17\x7d\x38\x59\x36\xf1\xc5\x1a\xcd\xcc\x7b\x33\x4f\x2f\x84\x73\x18\x50\x2a\x83\x70\x46\xe8\xec\x4c\xf7\xd8\x4f\x07\xbf\xb7\xa6\xf7\xa8\xa7\x51\x78\x3c\x83\x73\xe6\x2a\x56\xd6\x62\x52\xdf\x85\x46\xb8\xdc\x41\x97\x0e\x31\x23\xc9\x6a\x90\xa3\x70\x8f\xa0\xf4\x64\xc9\xc3\xf5\x38\xe3\x44\xca\xf8\x16\xfe\x3a\x6b\x94\x3c\xb4\x40\xf8\x34\xa3\xf3\x55\x08\x40\xc2\x3c\x20\xd4\x8f\x2d\xd4\x4b\x82\xfa\xa6\x86\x61\xc4\x67\x41\xe8\xae\x88\x98\xab\x82\x13\x42\xbd\x74\x37\xe9\x7c\x2b\xfc\x9e\x19\x84\xcb\x97\x91\x9c\x39\x04\x40\x33\x40\x9a\xee\x2d\xea\x0f\x7c\xba\xb6\x83\x42\x07\xeb\x90\xb1\x95\x19\x8e\xd0\x8f\x31\xaa\x10\xf2\x52\xff\xe0\xa7\xfd\x6a\x9f\x91\x80\xb9\x17\x93\x82\xdd\xeb\x46\x9b\xe6\x4d\x55\x2e\x6a\x5a\xe8\x7b\x23\x34\xf6\xfd\xf6\xfd\x25\xd1\xef\xed\x90\x86\xa9\x3e\x86\xf0\xa2\xe5\x09\x4e\x47\x76\xf6\x18\x69\xea\xa5\xfb\x6c\x86\xc9\x2a\xe3\x99\x9b\x16\x74\x06\xd8\xdd\xe5\xdc\x2f\xa4\x3f\xcc\xcd\xef\xc8\xb6\x92\xe9\xc8\xa6\x23\x5d\xbd\x9c\xa8\x9a\x9e\x2a\xf2\x2e\xba\xa8\xd7\x1d\x07\x9b\xbb\x1c\x5d\xd1\x83\x63\x4e\xa0\x51\x96\xd8\x34\xa0\xcc\xa2\xe7\x0d\x4a\x7d\xba\xb9\x15\x24\xb4\x63\xde\x5e\x56\x00\x00\x4d\xd3\xa4\xff\xeb\x40\x32\x0e\x24\xcb\x40\x5f\x66\x73\xff\xc9\x6a\x8d\xc6\xbb\x84\x9c\x6b\xeb\x45\xbe\x9c\x57\xce\x18\xdd\x78\x50\x0e\xf6\xc2\x0c\x23\x12\x48\x4b\x70\xb4\x37\x9c\xe8\x73\xc2\x0f\x4a\xae\x06\x38\x40\xc9\x2a\x33\xcd\xde\xc1\x0e\x42\x58\x53\xcc\x5d\x34\x46\x1f\x5d\xba\x29\x0e\xed\x1e\xd0\xe7\x8b\xed\x36\xf7\x49\x30\xd6\x97\xf6\x6e\x11\xa3\x1a\x84\xc7\x4d\xd9\x39\x7e\x84\x7e\x26\xb3\x7a\x7d\x83\x44\x96\xdc\xae\x34\xe4\x68\xdb\xc2\x87\x8b\x8b\x75\xba\xe2\xb8\x77\x7a\x93\x77\x56\x15\x8e\x1e\xe1\x7f\x00\x00\x00\xff\xff\xdd\xde\x3a\xff\xa9\x03\x00\x00")
+var _templatesParams_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x6d\x6f\xdc\xb8\x11\xfe\x2c\xfe\x8a\x89\x80\xf8\x56\x8e\xa2\xac\x2f\xb9\x00\xdd\x74\x0f\xb8\x06\x17\xc4\x6d\x52\xb8\x8e\x73\xfd\x60\x18\x17\x5a\x3b\xda\x65\x23\x51\x32\x49\xad\xbd\xd8\xdb\xff\x5e\xcc\x90\x7a\xb3\xe3\xc3\xb5\xb8\x7c\x88\x25\x92\x33\xf3\xcc\x33\x2f\x1a\xee\x7e\xbf\xc2\x42\x69\x84\xb8\x91\x46\x56\xf6\xd7\x75\x1d\x1f\x0e\xa2\x91\xf9\x57\xb9\x46\xd8\xef\xb3\x33\xff\xf8\x4f\x59\xe1\xe1\x20\x84\xaa\x9a\xda\x38\x98\x89\x28\x2e\x2a\x17\x8b\x28\xd6\xe8\x5e\x6c\x9c\x6b\xe8\xd9\xe0\x1a\xef\xf8\xc9\x3a\x93\xd7\x7a\x1b\x1e\x95\x5e\x5b\x7a\x74\xaa\xc2\x58\x24\x42\xbc\x78\x01\x65\x9d\x4b\xa7\x6a\x6d\xa1\x2e\xc0\x6d\x10\x0c\xde\xb4\x68\x1d\x30\x12\x74\x68\xac\xc8\x6b\x6d\xd9\xd8\xa9\xfe\x7c\x7e\x0a\x00\xb0\x84\xb8\x35\x2a\x16\xd1\xa9\xfe\x57\x8b\x66\xc7\x2b\x37\xf4\xc4\x6b\xef\x51\xae\xd0\xd0\xda\x86\x9f\x7a\x63\x72\x57\xb7\xae\x37\xb5\x92\x0e\x41\xea\x15\x10\xa0\xb1\xc1\xad\x34\xfe\xf5\x42\x55\xf8\x21\x08\x2d\xa1\x92\xcd\xa5\x77\xe3\xca\xff\xd9\x8b\x28\x26\x25\xcf\x6b\x5d\xee\xe2\x05\x21\x83\xf8\xfb\xf9\xfc\xf5\xf3\xf9\xc9\xf3\xf9\xf7\x71\x1a\x9c\x9d\xec\x9f\xfc\xb0\x98\xbf\x5a\xcc\x7f\xe0\x5d\x92\x1e\x9f\x18\x49\x5f\x7c\xf3\x60\xd0\xc2\x90\xb3\xf3\x77\x6f\x5f\xbe\x7c\xf9\x97\x54\x1c\x98\xcb\x33\x82\x0c\xca\x82\x84\xcf\xe7\xa7\x29\x30\x21\x50\x1b\xf0\x2c\x0c\x1e\x12\x01\xb2\x67\x9a\x18\x50\xee\x3b\x0b\xcc\xb3\x91\x4a\x3b\x2b\xdc\xae\xc1\xa0\xd0\x3a\xd3\xe6\x0e\xf6\xc4\x2c\x84\x7f\xde\x7f\x18\x05\xb0\x23\xb5\x37\xb2\x80\xd6\xa8\x07\x20\x44\x44\x39\x34\x56\x22\xa2\x8b\x5d\x33\x59\x21\xb5\x36\x97\xa5\x34\xc0\x30\x82\xe6\xad\x2c\x5b\xb4\x29\x60\xb6\xce\x40\x69\x87\x6b\xf2\xc4\x40\x1f\x81\x37\xa0\x6b\x07\xf9\x06\xf3\xaf\xb8\x02\x55\x00\x56\x8d\xdb\x89\xe8\x27\x63\xe4\x8e\xf5\x5f\xd7\x75\x09\x40\xfa\x27\x50\x61\x23\x2d\x58\xdc\xa2\x91\x65\x30\x23\xa2\x73\xbc\x69\x95\xc1\x95\x97\x12\xd1\xcf\xba\xad\x48\x07\xc0\x65\x08\xbf\x88\xce\xa4\x73\x68\x34\x00\x1c\xfb\xa4\xcf\xce\xf9\x8f\x88\x3e\x2a\xad\x2a\x16\x38\x2e\xca\x5a\xba\xd7\xaf\x44\xf4\x51\xde\x3d\x5c\x53\xfa\x03\xea\xb5\xdb\xc0\xb1\xd2\x8e\xcf\x8c\xdf\x7d\x60\x7f\x51\x75\xe9\x49\xe6\xe0\x0e\x71\x9a\x06\x72\xf0\xe7\x76\xa3\xf2\x0d\x28\xab\xbf\x73\x60\xa5\x53\xb6\x50\xb8\xf2\x31\x1d\x74\x3d\x8c\x6b\xa0\xff\xcb\x7f\x6c\xad\x17\xb1\xd2\xf1\x97\x21\x5c\xd3\x3d\x2d\x2b\xa4\xdd\x8f\x68\x2d\xb5\x88\xe9\x6e\xe5\x57\xe3\x2f\x01\xff\x3b\x62\x00\x0c\xba\xd6\x68\x72\xa0\xa9\x29\x7c\x06\x5c\x0d\x45\x4a\xff\xaf\x30\x2f\xa5\x41\x0e\x73\x15\x98\xa3\xb4\xac\x02\x63\xec\x26\x67\xa3\x28\x5a\x9d\x7b\x85\xb3\x02\x02\x8d\x49\x4f\x28\x79\xe3\xed\xc0\x51\x11\xac\x9f\xea\x47\x6c\xab\x3f\x64\xbb\xf4\xe1\xb8\x0f\xe1\x54\xbb\x99\xa2\x34\x4c\x38\x52\x63\xc3\x2a\x18\xfe\x45\x96\x8a\x92\x93\x81\x5b\x5f\x98\x95\x5a\xad\x4a\xbc\x25\x67\x7d\x90\xb6\xe1\x90\x9d\xa6\xe4\xfd\x7e\x68\xe1\x1a\x8b\xda\x20\xe4\xb2\x2c\xa9\x46\x34\xde\xb9\x94\x92\xa3\x35\x2a\x18\xe8\x9c\x24\x45\xd4\x28\xa7\xca\xfa\x3c\xc9\x48\xea\xa7\xee\x0d\x6e\x95\xdb\x80\xd2\x8c\x63\x2c\xb2\x46\x47\x80\x5f\xcd\xe7\x60\xd0\x36\xb5\xb6\x1d\xe4\x52\x11\x1e\x59\x96\x0c\x70\xdb\x25\x94\xcd\x3c\x33\x53\xb7\x67\xac\xd2\xc2\xe5\x15\xbf\xa7\x23\xbc\x74\x7a\x76\x4c\x5f\x8d\x8c\x8a\x0d\xad\x4b\x1e\xf6\xd8\x94\x3d\x05\x3e\xf5\x5e\xea\x55\x89\x26\x99\xbc\x8d\x98\x1f\x2f\xbf\x23\xe5\x6c\xe1\x16\x82\x09\xef\xc4\xbf\x8d\x72\x68\x52\x30\x70\xcf\xf4\x5e\x44\x91\x2a\x46\xfe\xc0\x62\x09\x6f\xa9\x9b\x04\x4f\xcc\x08\xfc\xcc\x24\xa9\x67\xcb\x26\x6f\xa0\x44\x3d\x1b\xe4\x12\xf8\x11\xe6\x04\x2b\x8a\xd8\x58\x5f\x72\x76\x76\x9b\x8e\xf4\x27\x74\xc2\x43\x17\x51\x74\x10\x51\x44\xae\x66\x9f\xd0\x6c\xf1\xfd\xc5\xc5\x19\x9d\x36\x89\x88\x0e\x49\x48\xa8\x11\x98\x3e\xa3\xa7\x21\xe8\xb2\x66\xd4\xca\xbb\xa5\x47\xb2\xc1\xc7\x6c\xe2\xe6\x94\x98\x71\xc4\xbe\x11\x9d\x69\x78\x13\xb8\xbc\x1a\x3a\xcc\x5e\x44\xf4\x29\x1d\xc1\x1b\xed\x8a\xa8\xa8\x0d\xfc\x9a\x42\x43\x3c\x1b\xa9\xd7\x01\xa3\x65\xea\x58\x90\x5b\xf1\xa8\xdf\x46\xf6\x56\xb9\x7c\x03\x4d\x76\xca\xda\xa3\x5c\x5a\x04\x1e\x0b\x16\x44\x26\x85\x2f\x85\xfa\x2b\x69\xec\x51\x5f\x36\x19\x35\xb1\xab\x37\xb4\x41\x42\x51\xe4\x7b\x3c\x2c\x7b\xd5\xfb\x2d\xd1\x1f\x1d\x06\x95\x3c\x57\x2c\xc4\xf8\xb0\xc9\x3e\x9f\x7f\xc8\x78\x63\x96\x74\x5a\x07\x09\x3f\x75\xdc\x17\xf1\xab\x97\xcc\xe8\x5b\xa9\x6b\xad\x72\x59\xfa\xc5\x7f\xe0\x6e\xe6\xb5\x24\x57\x21\x01\x54\x01\x4f\x9a\xcc\x7f\xb0\x8e\x8e\x7c\x5e\xb1\x2e\xca\xa9\x13\xd8\x4f\x94\xfb\x87\xcb\xc5\x89\x97\xf6\xe2\x63\x91\xe5\xb2\xcb\x43\x55\x80\xcf\x74\xfe\xa2\x05\x12\x86\xb0\x2c\x41\x36\x0d\xea\xd5\x28\x89\xd3\xe1\x4b\xb1\x3f\xd5\x0b\xa6\x3c\x05\x02\x4b\xcf\xf4\x37\x85\xd0\xfd\x17\x10\x2b\xcb\xfd\x84\xb4\xc7\x87\xa4\xa3\x32\xca\x6b\xed\x94\x6e\x31\x78\x17\x02\xbe\x1d\x02\x1e\x7c\x61\x40\x61\xb7\xb2\xeb\x51\x42\x64\xfc\x39\x9f\x6d\x93\x2e\x72\x7f\x1e\xe8\xca\xae\x07\xa8\x07\x11\x1d\xfa\x3e\x32\x28\x0c\x85\xc7\x20\x26\x25\x17\x3e\x71\x7d\xc1\x0d\x22\x8f\x94\xa0\xf4\x83\xc5\x83\x6a\xf4\xf5\x37\x6b\x20\xd4\x4f\xf0\x97\xcf\xfa\x52\x4b\xfa\x2c\xed\x0a\xaa\xb2\x6b\xdb\x2f\x0a\x41\x61\x6f\x32\x9e\xa2\x9e\x2c\x21\x8e\xe1\xe8\x68\xfc\xee\x8f\xc5\x5d\x7f\x43\x63\x88\xdf\xbc\xaf\x78\x12\x9c\xf9\xf3\xa9\x07\x99\xbc\xe1\x53\x4f\x96\xa0\x55\x09\xfb\xa1\x4d\xf5\x46\xf7\x71\xd5\x5a\x07\xd7\xde\x1f\xb2\x14\xc3\xb3\x60\x74\xa0\x33\xa4\x63\x93\xd1\xec\x34\x74\xc5\xa2\x6e\xf5\x8a\x30\x14\xb2\xb4\x38\xe4\x05\x8e\xe3\x4e\x22\x7d\xee\x22\x2c\x43\xba\x87\x34\xf0\x2a\x96\xe0\x0c\x27\x57\x14\x5d\x1b\x94\x5f\x47\xc1\x24\x4f\x9f\xf8\x53\x2c\xc1\x94\xf5\x19\x43\x6f\x29\x0c\x3e\x68\xf6\x23\x7e\xe6\xa9\xb2\xd9\xdf\x6b\xd5\xc1\x4e\x21\x4e\x21\x4e\x92\xb1\x53\x4d\xd6\x0d\x7e\x81\xa2\xa3\x23\x78\xd2\x2f\x66\x1f\xa5\xcb\x37\x9f\x58\x95\xaf\x5e\x9f\xbc\x8f\x43\xa8\x48\x20\xa4\x05\xab\x80\xf8\xd9\xa0\x2e\x68\x4a\x92\xc1\x7c\x37\x5d\x06\xf3\xbf\xfd\x06\x4d\xd6\x4d\x97\x61\x2d\x44\xbb\x48\xbb\x80\x87\xbb\x58\x76\x26\x8d\x45\x3f\x40\x31\xb8\x14\x5e\xbf\x0a\x01\x5f\x8e\x02\xfe\x2d\x3b\x47\x47\x50\xc0\x5f\xe1\x78\xd8\xe0\xa3\xdf\xf4\xac\xa8\x5c\xf6\xa9\x31\x4a\xbb\x62\xd6\x13\xbd\x36\x28\x79\xf8\xda\x48\x4d\xa3\x3b\xde\xb4\xb2\xa4\x21\xec\xe9\x36\x4e\x47\x7a\x93\xa1\x85\xa8\xe2\xa1\x6f\x8c\xe3\x47\x38\x1e\x36\xfe\x47\x1c\x25\x5a\xfb\x3b\x20\xe4\xdd\x14\x84\x0f\x7c\x18\x04\x17\x4b\x4e\xea\xcb\x2b\xd3\x6a\x0c\xf1\x4d\x86\xb8\x84\xe9\x7d\x40\x1a\xc4\x3a\xda\xc2\xfe\x5e\xfc\x51\xb8\x1b\xb9\x45\x90\x0e\x4a\x94\xd6\xc1\xd3\x2d\xe4\x1b\x69\x64\xee\xd0\xd8\x9e\x33\xaf\x74\x92\x21\xf2\xee\x31\x24\x1d\x71\xff\x37\x92\xaa\x7e\x04\x88\xbc\x9b\x00\x09\x3d\x83\x1c\x1b\xf7\xd1\xbe\xe9\xf4\x0d\x55\x6a\x4a\xbf\xda\x80\x1a\xdd\xf4\xc2\xf5\x25\xf4\xcb\xb5\xda\xa2\x1e\xdf\x0a\x7d\xdf\xbc\xd7\xc5\x9c\xea\x7b\x58\xb8\x92\x24\x41\x73\xe8\x9c\x54\x0b\xbc\x20\xba\x51\x82\x44\x88\x03\x9e\x24\xe2\x70\xad\x8c\xe9\x2b\x4e\x1d\x89\x8a\x62\x5a\x39\xa7\xba\xaf\x9b\x93\x39\xd7\x4e\x27\xab\xdb\xea\xfa\x77\x45\xef\x17\x5d\x27\x48\xf7\x4b\x94\x3a\x5e\xd0\xc5\x94\x7e\x2a\xe0\xb6\xc6\x97\x10\xee\x90\x29\xdf\x6b\x89\x98\xda\x6d\xb0\x1f\x8b\x64\x9e\x63\xe3\x70\x05\xd7\xbb\xa9\x9d\xbf\xf1\x7d\x95\xf2\x80\x4f\x52\x06\xc4\xa4\x91\x3f\x0c\xc3\x12\xeb\xf6\x9f\x85\xc8\xa3\xa5\xe4\xfb\x99\xd8\x29\x66\x71\x77\x23\x08\xe0\xe0\xe9\x4d\xdc\x7d\x1d\x7c\x2b\x5c\x61\x21\xdb\xd2\x2d\xc2\xd8\xc1\xbf\x92\x74\xb3\xd7\xfd\x1f\x4f\x2e\x89\xe5\xd1\x00\xd6\xf3\xc3\x3f\x63\x30\x39\xb3\x4e\xc3\xd8\xc6\x90\x44\x68\x8c\x38\x88\xfd\x1e\xf5\xea\x70\x10\xff\x1d\x00\x1f\x99\xf1\xa0\xb1\x12\x00\x00")
+
+func templatesParams_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesParams_goTmpl,
+		"templates/params_go.tmpl",
+	)
+}
+
+func templatesParams_goTmpl() (*asset, error) {
+	bytes, err := templatesParams_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/params_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesParams_nimTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x5f\x6f\xe3\xb8\x11\x7f\xd7\xa7\x98\x2a\xe9\x59\x0a\x64\x5f\x0a\xf4\x49\x3d\x1f\xb6\x45\x7b\xb8\xbd\xeb\x5e\x8b\x74\x77\x1f\x6a\x04\x05\x23\x8d\x6c\x66\x25\x4a\x21\x29\xc7\xde\x20\xdf\xbd\x98\x21\xa9\x3f\x71\xdc\xdd\x02\xfb\x94\x68\x38\x9c\xdf\xf0\x37\x7f\xfd\xf4\xb4\x84\x12\x2b\xa9\x10\xe2\x4e\x68\xd1\x98\xff\x28\xd9\xc4\xb0\x7c\x7e\x8e\x64\xd3\xb5\xda\xc2\x3d\x1a\x8b\x3a\x83\x7b\xd3\xaa\x0c\x1a\x61\x77\x19\x68\xcc\xc0\x58\xdd\x5b\x59\x9b\x0c\xac\xb8\xab\x91\xfe\xca\x06\x4d\x14\xd9\x63\x87\x11\xc0\x3f\xc9\xdc\x15\xac\xa1\xbd\xbb\xc7\xc2\x46\x00\x00\x17\x17\xf0\xe1\xe6\x6d\x06\x0f\x3d\xea\x63\x06\x3b\x14\x25\x6a\x68\x35\x54\xad\x6e\x80\x1d\x40\x4b\x92\x0a\x04\x68\x7c\xe8\xd1\x58\x10\xaa\x04\x69\x17\x06\x8a\x56\x19\xab\x85\x54\xd6\xb0\xb5\xba\x2d\x84\x95\xad\xba\xca\xc9\x19\xa9\xb6\x70\x01\xbd\x96\x67\xcc\xf3\x15\x25\x1a\x1c\xd4\x59\xf2\x49\xaa\x72\x6a\xc0\x14\xa2\x16\x1a\xe8\x11\xe4\x86\xdd\x21\xec\x45\xdd\xd3\xfb\x70\xb5\x5d\x81\x54\x16\xb7\xe4\xa2\x86\x52\x58\x5c\xb6\xaa\x3e\xfe\x09\x54\x6b\xa1\xd8\x61\xf1\x09\x4b\x90\x15\x60\xd3\xd9\x23\x9b\x17\x5a\x8b\xe3\x55\x0e\x77\x6d\x5b\xc3\xc5\xc4\x1c\x08\x8d\x60\x90\xde\x6c\xb1\x84\xbb\x23\x14\x6d\xd3\x08\xf7\x32\x7a\xba\xd4\x58\xfa\x8b\x2c\x43\xd5\x37\x1f\xf9\x2a\xb9\x8b\x0f\x1b\xf7\xe6\x5b\x3e\xec\x84\xb5\xa8\x47\x26\x58\xb8\x13\xe6\x9d\x54\xb2\xe9\x9b\xab\x8c\x3f\xc4\x81\x3f\x26\x46\x9b\xe1\xbc\x19\x0e\xab\xba\x15\x76\x62\xe0\xef\xa8\xb6\x76\x37\x98\xf0\x9f\x73\x23\x83\x4e\x33\x51\x90\xca\x46\x11\xc0\x47\xd9\xd6\x2e\x4e\x27\xc9\x30\x46\x74\x1e\xf2\x31\x13\x1e\x77\xb2\xd8\x81\x34\x6a\x61\xc1\x08\x2b\x4d\x25\xb1\x7c\x3d\xfa\x67\x02\xdc\xa0\x31\x62\x3b\x11\x46\x17\x9c\x0f\xc2\x9a\x10\x60\x0a\x24\xa7\x99\x95\x0d\x8e\xe0\x26\x62\xff\x38\xaf\x7f\xf2\x37\xd6\xb0\x89\x00\x92\x78\x88\x7d\x9c\x41\x7c\x3c\x1e\x8f\xcb\x77\xef\x96\x65\x19\xa7\x19\x1f\xd3\x95\xe1\xf8\xe7\x9f\xf3\xa6\xc9\x8d\x09\x87\x74\x77\xa6\x30\xde\x5f\xbc\x5f\x9c\xd3\x3e\xab\xf8\xf9\xf3\xe7\xaf\xd6\x5d\xfc\x7b\x41\xba\xb7\x51\xd4\xe9\xb6\xf0\x3c\xfc\xd6\x37\x77\xa8\x93\xca\x87\x3e\x0d\x4c\xc1\x3a\xe2\x28\x05\xb6\x04\x28\xd6\x04\x61\x38\x91\x5b\xbb\x43\x0d\x06\xf5\x1e\xb5\xc9\xe0\x51\xda\x5d\xdb\x5b\x3e\x2a\xb1\x90\x8d\xa8\x99\x61\xa1\x42\xd1\x44\x40\xc5\x51\xc1\x7a\x4d\x48\xad\x4e\xaa\x34\x87\x4b\xa9\x6c\x52\xa5\x80\xb5\xc1\x1c\x2e\x2b\xef\x9a\x34\xbf\x4a\x55\x26\x54\x9e\x99\x2b\x99\xe0\x56\xea\xab\x89\xbc\x2b\x84\x41\x20\x9d\x08\x08\x2a\xf6\x40\x71\xce\xa1\xb7\xfa\xe8\xfe\x01\x28\xa5\x29\x84\x2e\x29\xb8\x06\xdf\x2a\x9b\xb0\xc9\xd4\x9f\x6a\xb4\xbd\x56\x60\x75\x4f\x9d\x0b\x00\x0f\x05\x76\x16\xb8\xdc\xfe\xa6\x75\xab\xf3\xb9\x62\x25\x6a\x83\x1e\xd2\x71\xf2\x05\xc4\x9f\xa8\xa6\xbe\x1d\x26\xbd\x1f\x85\x8a\x73\xb8\x00\xca\x32\xf6\x9c\x33\x98\xb5\x32\xee\x47\x63\x88\x42\xc7\x29\x08\xc1\x35\x1b\xf6\xea\x2f\xa1\x82\x3d\x04\xeb\x51\x74\x62\xb2\x17\x53\x8f\x1b\x45\x6c\x39\x8e\xc0\x05\x8a\x7d\xdb\x0b\x0d\xd2\xbc\xa7\xa2\x59\x0f\xfe\x01\x65\x15\x54\x20\xd5\xb4\x70\xc2\x63\x28\xfe\x9b\xeb\x5b\xf8\xdd\x9a\xc3\x16\xc4\x40\xcd\xdd\x4a\xe5\xb9\x80\xd1\xec\xc0\xcf\x8c\xdc\x17\xf4\x3a\x66\x33\xa8\x36\x7f\xb8\x0d\xfc\x9e\x32\xfc\x3f\x38\x1e\xec\x4d\xe9\x20\x12\x9d\x1f\x3e\x27\xb9\xc3\x73\x52\x24\x5d\xee\x06\xdc\x69\x6e\x4e\x5a\x73\x28\x20\xe7\x88\x2b\x1a\xdf\x8e\x86\xde\xb3\x0f\xdd\x71\x90\x4c\xc6\x1c\x89\x84\x8f\x81\x3f\x1d\xba\x53\x44\x0f\x34\x7d\x6d\x61\x0d\x6f\x36\x34\x06\x64\x05\xdd\x8a\x58\x25\x76\xe3\x98\xf3\x61\x22\x70\x4e\x39\xb1\x7b\x1a\x57\x58\xb7\x9a\xd4\x58\x9a\x4f\x09\x78\xb3\x89\x9b\xde\x58\xb8\x43\x72\x84\x47\x62\x0c\xdf\x79\x90\x01\x71\x1c\x4c\xab\x1a\x15\xfc\x08\xd7\x0c\xc1\xf6\x28\x11\xa5\x9a\x29\x05\x04\x72\x7d\x25\xca\x32\x19\x31\x14\xe3\x38\x88\x89\xd5\xfb\x56\xaa\x84\x5a\x5a\x9a\x06\x4c\x3f\xef\x26\x0f\x65\xb8\x55\x45\x2f\xd2\x98\x0c\x1a\x69\x0a\x3f\xc0\xf5\x19\xcc\x46\xd8\x62\xe7\x59\x65\x6d\x8f\x1d\xee\x06\xb4\x71\x90\x52\x49\xb8\x6f\x37\x2e\x4f\xaa\xbe\x46\x0b\x15\xac\xcf\x56\xfd\x4b\x7b\xc4\x54\x05\x3f\x40\xb7\xf2\xa3\x78\xcc\xc8\xd7\x28\xda\x6a\x14\x34\x16\xed\x4e\x28\xf2\x05\x1f\x7a\x51\x83\x6d\xd9\xf1\x59\x37\x1f\x0c\xa6\x27\xd0\xe2\x30\x81\xfe\x91\xa0\xc5\xe1\xcb\xd0\x35\x1a\xf3\x75\xb8\xe2\x30\xc1\x3d\x5b\x72\x63\xc1\x4d\x38\x71\xbb\xc3\x24\xa0\x94\x51\x9e\x1d\x77\x76\x26\x94\x3b\xb1\x47\x10\x16\x6a\x14\xc6\xb2\x57\x97\x93\x4b\xf0\x1d\xc4\x50\xec\x84\x16\x85\x45\x6d\xe2\x59\x64\xc5\xe1\x55\x54\x4f\xcc\x57\xa1\x36\xed\x04\x54\x1c\xce\x81\xba\x2e\xc2\x15\xec\x52\x3b\xd1\xf8\x90\xc3\x8d\x5b\x74\x33\x08\x4d\xe5\x95\x2e\x42\xad\x76\xd6\x69\xc2\x0b\xc2\x1a\xc4\x6d\xdb\x2d\xd4\x7e\x16\xc9\x8a\xaa\x8f\xd6\xe8\x95\x93\x1b\x7a\xee\xaf\x78\x4c\xba\x15\xed\xc1\x69\x3e\xd6\xf9\xad\xef\xe7\x54\xb2\xeb\xe9\x95\x8d\xd3\xa5\x73\xac\x4f\x01\x29\xe5\x5c\x47\xa1\x3b\xf4\xf5\x57\x61\xc5\x09\xce\x05\x34\x7d\x6d\x65\x27\xb4\xfd\x9e\x94\x96\xa5\xb0\x02\x2a\x89\x75\x79\x02\x1c\x8c\x04\xe4\xd5\x5d\x5b\x1e\xc3\xd8\xf1\x2b\xf4\x87\x9b\xb7\x0c\xca\x8b\xfe\x64\x61\xcb\x58\x4a\x05\x2d\xba\xae\x96\x8e\x99\xef\x0f\xcb\xc7\xc7\xc7\x25\xd9\x5d\xf6\xba\x46\x55\xb4\x25\x96\x0e\xdd\xbc\x64\x8a\x6d\xfd\x5f\x44\xb9\x1b\x13\x9e\x98\x25\xde\xfb\x73\x9f\x4e\xa6\xab\xa5\x4d\x16\xd9\x22\x6c\x39\x6f\x36\x7c\x10\xd6\x30\x9e\x2b\x3c\x4e\xcc\xd5\xcb\x94\x60\xa9\xcb\x07\xd6\xb8\xf5\xc9\x31\x6c\xd5\xaf\x4e\x99\x2f\x4e\x95\xd9\x28\x31\xb3\xed\xfb\x74\xb0\xd0\x40\xef\x68\xa0\x7b\x6f\xa2\xd0\xe9\xfc\x62\xb1\x7e\x99\xd3\x19\x74\x69\x60\x76\x3f\x8e\x86\xf5\x3a\xb4\x62\xcf\x52\xf8\x9d\x13\x84\xb3\x12\x1b\x5e\x98\x84\x94\xcb\x27\xe9\x97\xf1\xaa\x4f\x12\xfa\x9b\x85\xb9\x9a\x43\x2c\xcd\xf0\xfb\x29\x1e\x9a\xdf\x6c\xbd\xa8\x86\xc5\x46\xfa\xa5\x67\xd8\x4f\xe8\xa8\x31\x5b\x7a\xec\x74\xda\xcf\xc7\xe3\xb7\x71\xb4\x31\xdb\x34\xf4\x84\x31\x5e\x37\x68\xba\x56\x19\xbc\x4a\x46\xd9\xcb\x88\x9f\x6c\xe8\xd3\xd0\xff\xf2\xaf\x7f\xfc\x06\x9d\x38\xd6\xad\x28\x43\xa4\xff\x78\x7d\x4d\xcc\xb2\xe1\xf9\x2f\xad\xc7\x5d\x6b\x66\x99\x40\x3f\x4b\xa5\xda\x8b\x5a\x96\xbe\xed\x20\x2d\xc0\x06\xd6\xa0\xf0\xf1\x97\x3f\x53\x5e\x27\xa9\x4f\x8a\x3d\xf1\x34\xf1\x93\x49\x74\xfa\xcc\xcc\xef\xaf\x9e\x62\x49\x6b\xea\x7e\x42\x47\x4c\x01\x63\x19\xfd\x93\x41\xec\x19\x61\x91\xff\xff\x39\x9d\x66\xe1\x25\x1b\x62\xbb\x31\x05\xd8\xb9\x37\xbc\x61\xf4\x9e\x96\x04\x56\x33\x71\xee\xfd\x7e\x4e\xa3\xa7\x27\x40\x55\xc2\xf3\x73\xf4\xdf\x01\x00\x7f\xa2\xf8\x37\xef\x10\x00\x00")
+
+func templatesParams_nimTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesParams_nimTmpl,
+		"templates/params_nim.tmpl",
+	)
+}
+
+func templatesParams_nimTmpl() (*asset, error) {
+	bytes, err := templatesParams_nimTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/params_nim.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesParams_validator_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x6d\x6f\xdb\x38\x12\xfe\xae\x5f\x31\xe8\xd5\xa0\xdc\x38\x46\x7b\xed\x97\x33\x4e\xd7\x2b\xae\x09\xae\x1f\xba\x05\xda\xec\x02\x5b\xc7\x6b\x30\xd2\xc8\x62\x2b\x91\x2a\x49\x25\x31\x0c\xff\xf7\xc5\x50\xa4\x5e\x6c\x27\x6d\x77\xe1\xc0\xb1\x38\xc3\x67\x66\x9e\x79\x11\xb9\xdb\x65\x98\x0b\x89\xf0\xa4\xe6\x9a\x57\x66\x7d\xcb\x4b\x91\x71\xab\xf4\xba\xde\xda\x42\xc9\x27\xfb\x7d\x24\xaa\x5a\x69\x0b\x1a\xa3\x5c\xab\x0a\x32\x6e\xd1\x8a\x0a\xc1\xaf\x87\xe7\x56\x9a\x37\x32\xb5\x4a\x95\x26\x88\xef\x34\xaf\x4d\xe4\x85\x25\x37\x5f\x83\xe0\x8b\x51\x52\xe4\xdb\x19\x68\xfc\xd6\xa0\xb1\x51\xf4\x0f\xc8\x95\xae\xb8\x35\xa0\x72\xb0\x05\x3a\x53\xc0\x65\x06\x84\x0f\xce\x45\xb4\xa8\x4d\x74\xf5\xee\xfd\xc5\xfa\xf2\xc3\xc7\xf7\x6f\xae\x3e\x41\x02\xbb\x08\x00\x80\x91\xfa\xb9\x92\xe5\x96\x2d\x60\xc9\x26\xbf\x9f\x4f\xaa\xf3\x49\xc6\x56\xb3\x56\x4c\x20\x03\xf1\xff\x17\x93\xf7\x8b\xc9\xa7\x4e\x1c\xe2\x38\x46\xb8\x7a\x50\xf7\xb4\xda\x67\x36\x83\xe3\xd5\xf9\x24\xff\x4c\xc6\xf6\x14\xe8\xc7\xcb\xff\xbd\x7c\xf9\xf2\x5f\x3d\x99\x77\xc2\x16\xc0\xdb\x40\x55\x9e\x1b\xb4\x33\xb8\x2b\x44\x5a\x80\xb1\xba\x76\xcb\x99\x42\x23\x99\x25\x1e\x0c\x46\x6f\xdf\x5c\x5d\x38\x1a\x3e\x5c\x5e\x7e\xba\xb8\x82\x04\x34\xce\x53\x55\xd5\xa2\xc4\x58\xb3\x3f\xe2\xeb\x6c\xf7\x6a\x7f\x7e\x9d\xed\xfe\xe9\xbf\xaf\xdc\xf7\x62\xf0\x3d\x8d\xaf\xe7\xd7\xd9\xd9\xf4\xf5\xf2\xec\x7c\x35\x58\x7f\xca\xa6\x51\x14\x65\x98\x83\xaf\x07\x8c\x1d\xf9\x66\xba\x68\xe3\x67\xcc\xfd\x7f\x8b\xa9\xd2\x54\x2d\xde\xd5\xa0\x6d\x5c\xf6\x7e\xfd\xf8\x6e\x06\xdf\x1a\xd4\x5b\x97\xc3\x02\x79\x86\x7a\x90\xc5\x90\xe5\x90\x7f\x42\xbc\xc1\x5c\x69\x84\x94\x97\xa5\x90\x1b\x07\x53\x70\x99\x95\xa8\xe7\xf0\x26\x68\xb6\x64\x09\xe9\xcc\x0d\x01\x37\x68\x0d\x70\x78\xf5\xfc\x39\x68\x34\xb5\x92\x06\x9d\x9f\xad\x77\xa5\x30\x24\x2e\x4b\x07\x7b\x2b\x54\xc9\xad\x50\xd2\xcc\x9d\x8e\x83\x31\xc0\x35\x42\x26\xd2\xbe\x06\x4b\x95\x3a\xb5\x99\xdb\x25\x79\x85\x2e\x1a\x7a\x48\x95\x34\x56\x73\x21\x7b\xed\xde\x99\xf9\x88\x29\xe2\x32\x0b\x6c\xc5\xb9\xe7\x91\xfe\xfe\xeb\x1a\x24\xce\xa7\xdd\x0a\xe9\xd2\x62\x8d\x3a\x7e\xc6\xf5\xc6\xcc\xe0\xd9\xb3\xaf\x77\xf4\x6b\xb0\x8f\xfe\xfa\x18\x20\x81\xb4\xc0\xf4\x6b\xc8\xd3\x48\x4d\xe4\x03\xcd\x31\x02\x7d\x34\xda\x46\xcb\xd0\x8f\x31\x6a\xad\x74\xc2\x02\xbd\x81\xf3\x3e\x32\x36\x03\xa7\x63\x92\x1e\x75\x3a\x23\xd6\xa3\x13\xb0\xf9\x51\x0c\xd1\x81\x86\x8f\x35\x1a\x2c\x75\x54\xf9\x32\x1c\xc5\xd6\xd5\xa0\xd7\x36\x07\xf9\x0c\xb9\x78\x34\x3d\x61\x25\x84\x17\x12\x35\x80\x49\x60\xb9\x72\xa6\x72\xe5\xcb\x16\x84\x6c\x7f\x0c\x58\xbc\xe5\x65\x83\x06\x12\xa0\xd9\xd9\xa0\x69\x33\xd0\x27\x40\xe4\x20\x95\xf5\x6a\x63\xf2\x45\xde\xa2\xcd\x37\x68\x63\x46\x9e\x08\x8d\x19\x3b\x48\xf2\xd8\xab\x39\x71\x25\xb3\x78\xdd\x2d\xb5\x06\x67\xc0\x84\x81\x1e\x63\x5c\x01\xa9\x92\x56\xc8\x06\xbb\x45\x0a\xc9\xb9\x44\x21\x9d\xf2\x8d\x14\x2a\x34\x86\x6f\x9c\xca\xda\x65\xa0\x0d\x31\x18\x74\x0f\x7f\xd1\x59\x0f\x3d\x9d\x0e\xb3\xde\x6f\xf4\x69\x1f\x53\xda\x5a\xea\xfb\xd1\xf5\x62\xd2\x52\xb8\x64\x42\xb2\xd5\x2c\x3c\x90\x88\xb5\xc9\x13\x79\xb7\x05\x92\x04\x58\xa3\x05\x3b\x91\xbd\xa5\x2f\x84\xf9\xad\xc0\xbb\x35\xd5\xe9\x92\x40\x56\x2b\x97\x40\xb2\x24\x24\xc4\x47\x4a\xa0\x34\xec\xf6\x53\xc0\xd2\x60\x28\x17\x2c\x0f\x6d\xba\x21\x78\xca\x6a\xc0\x23\x7b\x54\x05\x34\xa2\x62\xb2\x3b\xf5\x48\x06\x1f\xd9\xd5\x4e\xd4\x53\x1b\x7d\xd1\x0d\xaa\x8b\x6b\xcd\xb7\x6c\x7a\x02\xad\xfd\xb1\x5c\xbc\x58\x8d\x72\xe1\x56\xbb\x3c\x3c\x94\xbf\xc5\x30\x7f\x3b\x4a\xc2\x62\x9c\x10\x46\x4e\xf5\x8b\xee\x69\x35\x03\xe6\x01\xd8\x22\x40\xed\x83\xad\xef\x54\x9a\x57\xf7\xed\xe9\x96\xec\xb6\x0e\x75\x40\x5c\xc4\xcc\x6e\x6b\x64\x1d\x13\x24\xa6\x91\x4d\x6d\xb8\x16\x66\x4d\xd2\xd8\x6e\xeb\xe3\x0a\xf6\x71\x2c\x59\xd5\x18\x0b\x37\xf4\x26\x06\xd2\x06\x06\x67\xf4\xa3\xab\x28\x86\xb2\xa9\x58\x37\x0e\xdc\x1b\xc1\x81\x39\xda\xc3\xf2\xb2\x55\x5b\xf5\x06\x82\xf3\xa1\x31\x7a\x43\xd2\x19\x23\x3b\x74\x78\x98\x7f\x51\x42\xc6\x23\x10\xdf\x2a\x64\xbc\xe6\xd6\xa2\x96\x07\xf6\xc9\xb2\xc6\xb9\x41\xae\xd3\x22\xec\x0d\xaa\xab\xe3\x60\x4f\xfb\x52\x71\x9b\x16\x7e\x56\xba\xad\x2e\xf6\x43\xb4\xde\x97\x4a\x48\x51\x8d\xb8\x50\x1a\x58\xc5\xef\xc7\xab\xbd\x59\xab\xb7\xfd\x03\x7d\x64\x53\xdd\xa0\x86\x04\xf2\x52\x71\x1b\xb7\x6e\x8e\x34\x4e\xdb\x21\xce\xfd\xde\x7f\x07\xbe\x83\xd6\x80\xf2\xc7\xc3\xbd\x41\xd8\x68\xe4\x16\x35\xd8\x82\x4b\x6a\x67\xfc\xd6\xf0\x12\xac\x82\x89\x61\x30\x81\x75\x7b\x26\x8d\x0f\x2d\xf8\x7c\x84\x8f\xc8\x4f\x44\x3d\xf4\xf1\x3f\x9d\x8f\xfc\xfe\xa7\x7d\x2c\xd1\x98\x1f\x75\x90\xdf\x1f\x39\x88\xf7\x29\xd6\x16\x7e\x23\x6a\x2f\xe8\xd5\x3d\x36\x5d\x73\x63\x86\x09\x5d\x97\x28\x37\xb6\x38\x88\xa3\x44\xe9\x93\x33\xe2\x3b\x28\x0f\xc2\x39\x1d\x46\xc1\x6f\x11\xb8\x85\x12\xb9\xb1\x30\xc9\x20\x2d\xb8\xe6\xa9\x3b\x51\xc0\xe4\x14\xe2\xa0\xca\xf8\xfd\x77\x9d\x1a\x12\xfc\xd3\x4e\x55\xea\x31\x9f\x06\x80\xa3\x17\x56\xc0\x0c\xa3\xeb\xc1\xe1\xe2\xa7\x10\xbd\x0b\x84\xb4\xb8\x41\xcd\x8e\xa6\x8e\xc6\xb9\x6b\x3e\x3a\xbc\xd3\x79\xfc\xf5\x75\x76\xf6\x94\x05\x1c\x10\xc6\xcd\x96\x5f\x94\xc4\x43\xc8\xb6\x0b\xd8\x23\x3d\xf6\x60\x6b\x79\xdb\x57\xba\xc1\x1f\xad\x16\xbf\xe5\x92\x97\xe6\xc8\x93\x1b\xa5\x4a\xe4\xf2\x38\xb8\xee\xac\x11\x33\xab\x1b\xa4\x19\x97\x13\xc0\x78\x4a\x27\xc9\xf0\x6a\xd5\x61\x38\x5a\x20\x81\x83\x2b\x8f\xa7\xeb\x20\x2a\x91\x83\x5b\xef\xb7\x77\x6f\x3b\x48\x5a\xd1\x7c\xa3\x55\x53\xc7\x2f\x4e\x52\xb1\x76\xd7\x2b\xd3\x96\xfa\xec\xf4\x2d\x70\xe4\xb4\x90\x30\xbc\x8e\x2e\xa2\xc7\x01\x87\xba\x4b\x7a\xa7\x8c\x2a\xca\x25\xc2\x57\xd3\x78\x9f\xbf\x1a\xfb\x82\xa2\xe3\x59\x5e\x59\x6a\x06\x2f\x78\x24\xfb\x81\xd2\x79\xb8\x4a\x76\x98\x95\xfd\xdb\xe5\xd0\x0d\x0f\xbf\xb7\xad\x0b\x1f\x82\x1f\x4d\x6d\x81\x7a\xd7\xe9\xe0\xee\x7d\x06\x1e\xa6\x23\xa7\xf1\x86\xa0\x6c\x81\x1a\x0c\xea\x5b\xd4\x66\xe6\xee\x79\xaa\xb1\x4e\x94\x61\x2a\x2a\x5e\xba\xa3\x3c\x97\xe0\xdb\xa8\x3b\xb7\x8b\xbc\x7b\x91\x24\x24\x1c\xdb\x1c\xf8\xc7\x26\x19\xb5\x76\x2b\x1e\x3a\xce\x26\x66\x20\xd8\xed\x50\x66\xfb\x7d\xf4\xe7\x00\x41\xd1\x0b\x94\x22\x11\x00\x00")
+
+func templatesParams_validator_pythonTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesParams_validator_pythonTmpl,
+		"templates/params_validator_python.tmpl",
+	)
+}
+
+func templatesParams_validator_pythonTmpl() (*asset, error) {
+	bytes, err := templatesParams_validator_pythonTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/params_validator_python.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesPython_server_resourceTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x51\x8b\xe3\x36\x10\x7e\xcf\xaf\x18\xae\x01\xdb\xe0\x98\x7b\xe8\xd3\x42\xa0\xb7\xd7\x1e\x5d\xe8\x96\x6b\x7b\xb4\x0f\xe9\x61\xb4\xeb\x71\x56\x8d\x25\x79\x47\xb2\x97\xd4\x9d\xff\x5e\x24\xd9\x89\xe3\x0b\xdb\x1e\xf8\xc1\x1a\xcd\x7c\xdf\xcc\x37\xa3\x19\x86\x0d\x54\x58\x4b\x8d\xf0\x86\xd0\x9a\x8e\x1e\xb1\x6c\x8f\xee\xc9\xe8\xd2\xa1\x6a\x1b\xe1\xf0\x0d\x6c\x98\x57\xde\x73\x2d\x5a\xf9\xb3\x50\x08\x37\x5b\x28\xc2\x8f\xbf\xa9\xc9\x28\xa8\x1b\x61\x0f\x20\x55\x6b\xc8\xc1\x6d\xd3\x61\x4b\x52\xbb\x1c\xfe\xb2\x46\xcb\xfa\x98\x03\xe1\x73\x87\xd6\x05\x1c\x59\x43\xf1\xa3\xb0\xbf\x74\x48\xc7\xdf\x1c\x49\xbd\x87\x09\xe7\x05\xe9\xf0\x37\x76\xfb\xa2\x12\x4e\x58\x47\xdd\xa3\xeb\x08\xed\x84\x7c\xdf\x35\x4e\x7e\x2f\x1f\xdd\x6a\x34\x10\x06\x44\xd4\x15\x30\xcf\xc1\x83\x67\x2b\xc8\xdd\x9a\xea\xf8\x7f\xe1\xdf\x1b\xf5\x20\x35\x56\x67\x9a\xeb\xe0\x1f\x05\x09\x65\x3d\xea\x18\xd8\x06\x43\xd9\x8b\x46\x56\xc2\x19\xba\x8c\x03\x12\x7a\x8f\xb0\x3e\xe4\xb0\xee\x83\x78\xf7\xb2\xaa\x1a\x7c\x11\x84\xf6\x1d\xd1\x19\x67\x18\xd6\x7d\x71\x17\xfe\x3f\x0a\xf7\xc4\x0c\xc2\x46\xa3\x97\x9b\x79\x18\x5e\x43\xfd\x15\x9f\x6f\x4d\x25\xd1\x9e\xea\xf5\xa1\xcc\x53\x79\xf1\x74\xc6\xf8\x06\xf6\x66\x43\x42\x35\x37\x0f\xb8\x97\x7a\x74\xb3\x33\x3b\xea\x6a\xb5\x1a\x86\xd8\xec\x7f\xe0\x93\xf9\xc9\xbc\x20\x01\x73\x29\x5a\x09\xdb\x73\xa7\xd3\xe4\x0b\xaf\xe8\x94\xe4\x50\x96\x5a\x28\x2c\xcb\xec\xba\x14\xe8\x9e\x4c\x15\x52\x5e\x7d\x37\x0c\xa7\x19\x5b\xe0\x14\x64\x3a\x87\x9e\x66\xdd\x17\x3f\xe8\xaa\x35\x52\x3b\xe6\x24\x07\x15\x01\xb6\xbb\x78\xf7\x3b\xd2\x03\x73\xf2\xd9\xb3\x4d\x64\xca\xb3\x29\x4f\xb7\xee\x17\xda\x87\xe1\xf6\xbc\xbd\x0a\xf9\x33\x17\xf3\x43\xba\x8b\xa7\x77\xb4\xb7\xcc\x01\xd4\x37\x60\x7a\x11\xb2\x86\x75\x5f\x84\x71\xf0\x53\x69\x23\xda\x72\x1a\x8a\xf1\x0f\xd3\xdd\x0a\x00\xc0\x47\x8e\xa9\x5d\x04\x33\x8f\xd7\x05\x73\x7e\xf2\x1c\x9b\x75\xc1\x5d\x61\x1d\xc7\xe2\x3e\x14\x3f\xe6\x1a\x2c\x01\xcf\x32\x67\x37\x01\x21\x49\x92\x11\x69\x12\xa3\xf6\x62\xd4\xa3\x18\x1f\x3a\xfd\xf8\xde\x28\x85\x7a\x4c\x3e\xfa\xae\xfb\xfa\xf4\x3f\x71\xfa\x9b\x3b\x07\xd2\xc2\x93\xd0\x55\x83\x04\xb5\x21\x98\x69\x0e\x8b\xde\x2c\xf8\x83\x5a\xc5\xe2\xd1\x7b\x97\x67\x6f\x2a\x6d\xb4\x6d\x7d\xf9\x33\x27\xe6\xf4\xf4\x1a\xd3\x5d\x4a\x58\xd8\xee\x21\xa5\xe4\xcf\x3f\x92\x1c\x92\x32\xc9\xe1\x90\xe5\xd0\x67\x21\x99\x43\x0e\x3d\x48\x3d\x2d\x9b\x42\xd0\xde\x16\xd2\xa1\xb2\xa9\xf2\x20\xdb\x4f\xd4\x61\xf6\x39\xcb\x42\x4a\xb2\x06\x6d\xdc\x05\xfd\xb9\x53\xa3\x7c\xfe\x23\x74\x1d\xe9\x69\x95\xa5\x48\x64\xc8\x6e\x2f\xc2\xa2\x2d\xcb\xe1\xdb\xb7\x6f\x97\x8d\x8b\xc7\x50\x7c\x7c\xa0\xc7\xb3\x75\x33\x37\xdf\x9d\x97\xd6\xe4\x21\x75\xdb\x39\x1b\x45\x19\xbd\x98\xd3\x2f\xd6\x54\xba\x9b\x2a\xae\x65\x83\xf6\xb4\x6d\x8b\xda\x90\x9a\xca\xf5\x53\x87\x8d\xc5\x4b\xca\x0f\x86\xd4\xeb\x6c\x73\xac\x05\xd2\x6b\x71\x85\x5f\xb8\xa5\x17\xed\x84\xb0\x47\x17\x0d\x59\x76\x4d\xa4\xb1\x1f\x31\x8b\xaf\xe8\xc4\x18\x70\xa5\x07\x73\xf4\xe5\xb2\xfb\xaf\xc1\x5d\x70\x65\x0b\x10\xbf\x19\x47\x02\xff\x38\x56\xc3\x80\xba\x82\x0d\xf3\xea\xdf\x01\x00\xc1\x0b\xf1\x04\x54\x07\x00\x00")
 
 func templatesPython_server_resourceTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -707,6 +859,26 @@ func templatesPython_server_resourceTmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesQuery_string_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xdf\x6f\xdb\xb6\x16\x7e\x96\xfe\x8a\x53\x01\xe9\x95\x50\x5d\x25\xbd\x77\x28\x86\x64\x79\x68\xb7\x76\xcb\x8a\xa5\x69\x13\xef\x25\x08\x0a\x46\x3a\xb2\xb9\xc8\xa4\x4b\x52\x6a\x0d\xd7\xff\xfb\x70\x48\x4a\xa6\x1c\xc7\xeb\xd6\x3e\xec\xc5\x16\x7f\x9d\x5f\xdf\xf7\x1d\x4a\xab\x55\x85\x35\x17\x08\xc9\x87\x16\xd5\xf2\xbd\x36\x8a\x8b\xe9\xfb\xa9\x4c\xd6\xeb\x78\xc1\xca\x3b\x36\x45\x58\xad\x8a\x0b\xf7\x78\xce\xe6\xb8\x5e\xc7\x31\x9f\x2f\xa4\x32\x90\xc6\x51\x82\xa2\x94\x15\x17\xd3\x24\x78\x3e\xfc\x43\x4b\x41\x13\xf5\xdc\xd0\x9f\x40\x73\x38\x33\x66\xd1\x3f\xb7\xaa\xa1\x47\x85\x75\x83\xa5\xdd\xa1\x8d\x2a\xa5\xe8\xfc\x23\x17\x53\x9d\xc4\x59\x1c\x1f\x1e\xc2\x4f\x58\xca\x0a\xdf\x52\x74\x97\x36\x38\xa8\xec\x8c\x06\x33\x43\xb0\x51\xc3\x82\x29\x36\x47\x83\x4a\x03\x17\x46\xda\x15\x6d\x54\x5b\x1a\x58\x48\x2e\x0c\x56\x60\x24\xdc\x2e\xa1\xcb\xc9\x24\x2d\x0b\x36\x47\x0d\xb2\xb6\x7b\x83\xf3\x4c\xa1\x9d\xfa\xf5\xf2\xcd\xf9\x78\x93\x37\x58\x73\x6c\x2a\x5d\x90\x9d\xab\xf1\xd1\x8f\x33\x5e\xce\xac\x01\x21\x0d\x45\xd9\x30\x85\x15\xb9\x0d\x8e\xd3\x32\x9f\x0a\xa9\xb0\x2a\xe2\xba\x15\xe5\xfd\x04\xd3\x8e\x35\x2d\x6a\x68\x55\x53\xfc\x6e\x1f\x73\xe8\x28\x31\x54\x35\x2b\x71\xb5\xce\x00\x95\x92\x0a\x56\x71\xa4\x3a\x38\x3e\x05\x5f\x48\xb7\xfb\x4d\x9d\x76\x59\x1c\xf1\x1a\x54\x57\xbc\xe6\xa2\x4a\x33\x78\xb4\xd9\x73\x61\x14\x7c\xfe\x4c\x6b\x2f\x1b\x9c\xa7\xd9\x8e\x2d\x97\x2e\xd3\x55\x1c\x45\x0a\x4d\xab\x04\xd4\x73\x53\xbc\x24\x9f\x75\x9a\x94\x4c\xfc\xc7\x78\x10\x7c\xfd\x1d\x64\x14\xa2\x84\x83\xab\x24\x07\x0a\x60\x1d\xf7\xa7\xab\xed\x0c\x9d\x03\x9f\x67\xbe\x89\x25\x8b\xd7\x01\xe6\xbf\x20\xab\xa8\xae\x21\xde\x0a\x3f\xb4\xa8\x0d\xcc\xfc\xda\x3f\x82\xbb\x3f\xfc\x77\xb1\xee\xcf\x7d\x15\xd0\x3e\xab\x74\x06\xa4\x88\xc2\x0d\xff\xbd\x00\xf7\x39\xef\xc7\xf6\x82\x54\xa0\xd3\xc4\xed\x4e\x72\xa0\x84\x53\x2a\x3a\x01\xc3\xc5\x34\x83\xeb\x1b\xcf\x92\xc0\xeb\xec\xda\xd6\xe0\x47\x26\xa4\xe0\x25\x6b\x5c\x31\x5e\xe3\xd2\x1e\xcd\x6e\xe2\x68\xfd\x20\x3b\x26\xef\xce\xac\x57\x1f\x81\xa3\x87\x65\x54\x0f\xe2\xe4\xdd\xd9\x46\x9e\xfd\xe4\x94\x77\x28\x2c\xd8\x84\xea\x40\x1f\x77\x70\x8b\x3d\x21\x6c\xbd\x3b\x1b\x59\xee\x1d\xb9\x8c\xbe\x35\x78\x5f\x02\xcb\x38\xb7\x7b\xe0\xf0\x9a\x82\x20\xe7\x1a\x8d\x15\x9e\xad\x95\x8d\x20\x1d\x0a\xea\xd3\xc8\x4e\xec\xe6\x47\xa7\x20\x78\xf3\x90\x77\x2e\x3a\xd6\xf0\x6a\xcb\xf1\x41\x77\x0c\x07\x5d\x92\xdb\x82\xe6\x64\x66\x44\x0e\xc1\x1b\xc2\xcc\x96\x71\x7f\x13\x18\x35\x3b\xdd\x8d\x6b\x16\x56\x74\x17\xeb\xb6\x2e\x81\x2f\xa6\x9f\x73\x7d\x4d\xfb\x1c\xd5\x74\xd7\x73\x2c\x74\x00\x1a\x8d\xa3\x97\xeb\xfd\x44\x25\x36\xb4\x08\x25\xe7\xdb\x77\xc8\x88\x6a\x77\x5c\x54\xf6\xd6\x69\xa4\xbc\x6b\x17\xe0\x7c\x07\x74\xf5\xf6\x86\xf3\x61\xb9\x7c\x86\x64\xc3\x67\x92\xf7\x76\x1e\x4e\x71\x6f\x01\xb5\xb1\xac\xe8\x8a\xab\xe5\x02\xd3\x2c\x8e\x6a\xa9\x80\xd3\xdc\xd1\x09\x70\xf8\x01\xb4\x29\xce\xdb\xf9\x2b\xca\x34\xcd\x4e\x80\x3f\x79\x62\x29\x61\x53\xa7\x6d\xda\x14\x6e\x91\x67\x71\x44\xb7\x8c\x5d\x29\x9e\x0b\x29\x96\x73\xd9\x6a\x58\x81\x15\xd6\x0c\x15\xb7\x5a\x5a\x2e\x30\x8e\xec\x4e\xdd\x0d\x47\x7b\xea\x9f\xee\x6c\x4a\x51\x40\xe0\x7b\x85\xe8\x2b\x90\x87\xf6\xee\x73\x38\x1a\x60\x46\xa5\xac\xcd\x75\xdc\xff\x94\x52\x18\x2e\x5a\x8a\x8b\xc6\x44\x00\x72\xe5\xdf\x3c\x8a\xcb\x45\xc3\x4d\xea\xf2\xba\x62\xd3\xe2\x67\x34\x69\x62\x5f\x69\xb2\x1c\x92\x3c\xc9\xae\x8f\x6e\x5c\xee\xf6\xe4\xe9\x29\x24\x09\xdd\xa9\xc3\xe8\xbf\x09\xac\x76\xf8\xb1\x10\x6b\xf2\xe4\x32\xb0\xf0\xf9\x2a\x36\x28\x52\xb7\x6e\x8b\x72\xb4\xd3\xc0\x03\xb2\x4e\x83\x42\xe4\xe0\xad\xdc\x2f\xc8\x1e\x55\x1f\x74\x1b\x29\x13\xd9\xc6\x82\x8e\xd6\x3b\x44\x7d\x78\x38\x0e\x82\x46\x3a\xd0\x05\xc5\xb3\x51\x47\xc8\xf4\x2d\xb9\x5a\x71\xb0\xa6\x09\xf7\xd1\xdd\xda\x6a\xac\x80\xd7\x1b\xe1\x01\xb7\xf6\x1b\x5e\xa2\xd3\xc8\xb8\x08\xf5\x16\xe9\xfb\x4a\x0c\xba\x08\x54\x40\xb4\xdd\xd5\x7e\x2f\xc9\x78\xd8\x22\x46\x2e\xac\xd9\xb4\xee\x7a\xcb\xd7\x47\x37\xae\xdd\xe9\xb0\xcd\xff\xc6\xee\xd0\xda\x49\xeb\x5e\x65\x79\x08\xef\x68\xd0\x0b\x30\x87\x85\xb5\xc1\xc4\xd4\xb7\x12\x0d\xab\x87\x11\x77\x91\xe8\xe2\x4c\x54\xf8\xc9\xa1\xfe\x30\xe0\x8e\xff\x0e\xc3\xba\x2b\x2e\xd1\xa4\x3a\xdb\x86\xf3\x7e\x41\xfb\x6c\x77\x56\x15\xf6\x97\xf4\xf4\xfe\x8d\xe6\x3d\xf7\xd3\xe7\xf8\x71\x53\x9f\xfe\x82\x27\x2d\xd4\x1d\x9c\x92\x25\x37\xd5\xdf\x66\x6d\x0e\xf2\x8e\x2a\x54\x77\xc5\xf3\xaa\x52\x69\x56\x9c\xf5\x57\x6e\x9a\x15\x69\xff\xe5\x51\x5c\xe1\x27\x33\x11\x73\xa6\xf4\x8c\x35\xa8\xb2\x13\x3a\x17\x00\xda\x16\xc3\x2a\x6d\x4d\xaf\x6f\x6e\x97\x06\x9d\xf4\x32\x8b\x66\x1c\xe9\x8f\xdc\x94\xb3\x20\x9d\x55\x1c\x95\x4c\xe3\x90\x93\x7b\x4f\x3f\x1e\xb2\xf2\xef\xed\xce\xc8\xd6\xde\x17\x52\x36\xc7\xd4\x12\xa5\x68\x96\x60\x54\x8b\xc0\x44\x05\x35\x6b\x34\xe6\xf6\x05\x92\x08\x2e\xcd\x0c\xd5\xc0\xfe\xb2\xc4\x05\xf5\xce\xdb\x25\xf8\x0f\xa3\xe2\x82\x29\x8d\x64\xcb\x91\xc2\xba\x22\xb0\x13\xb2\x98\xc0\xe3\xc7\xc1\x94\xb5\xed\x5b\xd0\x1e\xd1\xdf\x4a\xd9\x20\x13\x70\xf0\x21\xf1\xa8\x7a\xad\xfb\xac\xc8\x9b\x2b\x0c\x75\x24\xe7\x68\x3b\xbb\x33\x61\xf2\x70\xf0\xfd\x68\xf4\xf4\xd9\x68\xf8\xff\xff\x8d\x86\xcf\xbe\xa3\x0a\x8a\x7c\x60\x78\x98\xea\x99\x30\xce\x77\x0e\x4f\x8f\x72\xd8\x50\xe5\x05\x37\x9a\xbe\x14\x06\x6d\xec\x63\xbc\xcf\x84\x8c\x89\xed\xd0\x27\x3c\x8c\x7d\xc2\x47\xc1\x4f\xf8\x38\xfa\x09\x1f\x87\x3f\xe1\x7f\x11\xff\x84\x7f\xcb\x04\x26\x7c\x57\x06\xaf\x1a\xc9\x46\x51\xd9\x09\x17\x56\xbd\x3b\x2c\xbb\xa3\x8f\xeb\xeb\x62\x72\xa6\xea\x2c\x8e\x2a\xac\x59\xdb\x18\xcb\x72\x2c\xa6\x05\x54\xcc\xa0\xce\x83\xef\x24\x77\x89\xfb\xfb\x80\xb9\x0f\x2e\xd7\x41\x36\xca\xa4\x0b\x76\x23\xce\x5e\x98\x7d\xf4\x6f\x5b\xb9\x91\x69\xbe\xbb\x0d\xec\x78\xf7\x5c\xad\x50\x54\xeb\x75\xfc\xe7\x00\xb7\xa1\xaa\xcf\xe8\x10\x00\x00")
+
+func templatesQuery_string_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesQuery_string_goTmpl,
+		"templates/query_string_go.tmpl",
+	)
+}
+
+func templatesQuery_string_goTmpl() (*asset, error) {
+	bytes, err := templatesQuery_string_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/query_string_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _templatesRequirements_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x34\x8f\x41\x6b\x03\x21\x10\x85\xef\xf3\x2b\x96\xdc\x15\x35\x29\xf4\xe2\x75\xa1\x81\xb6\x87\x04\xf6\x58\xa4\x3b\x49\xdc\xed\x8e\x5b\x67\x85\xb6\x92\xff\x5e\xd4\xf6\xf8\x3e\x1f\x9f\x6f\x72\x16\xdd\x88\x17\x4f\xd8\xed\x22\x7e\x26\x1f\x71\x41\xda\xf8\x6d\xfd\xde\x6e\x81\x76\x9d\xb8\xdf\xa1\xff\x70\x3c\x5b\xab\xa4\x56\x52\xb7\x24\x9e\x68\x4d\x1b\x17\x68\xa4\xfa\x63\xc3\xb9\xaf\x2d\x03\x47\x4f\x93\x33\xd6\x1a\xf9\x08\xcf\x2e\xce\x69\x3d\xb9\x0b\xd6\xf6\x1e\x86\x73\x1f\xe2\xc2\xe5\x55\xff\x07\x71\x3c\xbd\xbe\x34\x9b\x56\x30\x60\x9c\x7f\x30\x5d\xab\x4d\xcb\x03\xf8\x8d\x47\x47\x57\x8c\x21\xb5\x3f\x0f\x30\x71\x20\x7e\xbf\xe1\xe2\x8a\xe8\x41\x6a\x60\xff\x65\xad\x2e\x23\x15\xb4\xf9\x62\x0a\x8c\x85\xed\xa5\x81\x9c\x91\xc6\x7a\xcf\x6f\x00\x00\x00\xff\xff\xda\x00\x12\xec\xf5\x00\x00\x00")
 
 func templatesRequirements_pythonTmplBytes() ([]byte, error) {
@@ -727,7 +899,47 @@ func templatesRequirements_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServer_main_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x93\x41\x8b\xdb\x30\x10\x85\xcf\xd6\xaf\x18\xc4\x1e\xa4\xe2\x95\x97\x42\x2f\x0b\x3d\x04\xc2\xd2\x40\xbb\x35\x59\x68\x8f\x8b\x6a\x8f\x1d\x11\x59\x72\xc7\x72\x12\x10\xfa\xef\x45\x76\x92\xf6\xd2\x9b\xf5\xfc\x66\xe6\xd3\x63\x14\xe3\x23\xb4\xd8\x19\x87\xc0\x27\xa4\x13\xd2\xfb\xa0\x8d\x7b\xef\x3d\x87\xc7\x94\xd8\xa8\x9b\xa3\xee\x11\x62\x54\xf5\xfa\xf9\xaa\x07\x4c\x89\x31\x33\x8c\x9e\x02\x08\x56\x70\xeb\x7b\xce\x0a\xee\x30\x54\x87\x10\x46\xce\x18\x00\x00\x8f\x51\xed\xbd\x0f\xbb\xc5\x58\xeb\x70\x48\xa9\xea\x3d\xe9\xc1\x72\xc6\x0a\xde\x9b\x70\x98\x7f\xa9\xc6\x0f\x59\x35\xd6\xea\x6a\x98\x2f\x7c\xad\xed\xfd\x78\xec\x95\x71\xd5\x49\x5b\xd3\xea\xe0\x49\x9d\x3e\x72\x26\x19\xeb\x66\xd7\x40\x66\x14\x12\xe2\x62\xae\x2a\x30\x6e\x9c\x03\xdc\xbd\x8b\xfc\xb7\xf2\x0d\xc3\x8f\xf5\x60\xbc\x7b\x99\x5d\x23\xf8\x30\xdb\x60\x46\x8b\xdf\x3b\x5e\xc2\x0a\xa5\xbe\xdd\x35\xc9\x58\x41\xf0\xfc\x19\x86\xf9\xa2\x5e\xf1\xbc\xf7\x73\x40\x12\x92\xdd\xe6\x1d\xfc\x80\x30\xea\x1e\x59\x41\xea\x8b\x76\xad\xc5\xb5\x6f\xc5\x4b\xc8\x84\xe2\x0c\x39\x0a\xb5\xc7\x69\xf4\x6e\xc2\x9f\x64\x02\x52\x09\x04\x1f\xae\xfa\xef\x19\xa7\x90\xaf\x50\x14\x8b\xf2\x96\xd3\x7f\x31\x16\xc5\xb9\x04\x2a\x81\x1b\xd7\xe2\x45\x1d\xc2\x60\xb9\x64\x45\xba\x0e\x8f\x11\x4c\x07\x6a\x53\xef\xb6\xbe\x99\xb6\x86\x20\xa5\x1b\x95\x1e\x4d\xeb\x9b\x69\x39\x92\xca\x89\xd7\x84\x9d\xb9\x08\x5e\xc5\xf8\x4f\x49\x4a\x15\x97\x57\x6c\x12\xeb\xf4\x40\x66\xfc\xbf\xbb\x5c\x6f\x93\xf1\x16\xce\x6b\xd5\xd6\x90\xe0\xaa\xba\xce\xad\xb8\x94\x52\xde\x28\xd1\xb5\x19\x8d\x15\x31\x02\x69\xd7\x23\x3c\x1c\x4b\x78\x38\xe5\x58\x73\x2c\x7e\xa6\x06\xa7\x2d\x76\xd9\x55\xc4\xa8\xd6\xc5\xda\xb9\x80\xd4\xe9\x06\x97\xcc\x27\x41\x25\xdc\xff\x6d\xea\x5d\x4c\x72\xe9\x78\xef\x6e\x7d\xaf\x6a\x32\x2e\x58\x27\xf8\x14\x34\x05\xe3\x7a\x58\x57\x39\xe7\xb6\x70\x7e\x35\x53\x40\xb7\x71\xed\x02\x2f\xf8\xf3\xa7\xa7\xa7\x27\x5e\x02\x49\x96\x18\xcb\x6f\x20\xf7\xcb\xfb\xfe\x27\x00\x00\xff\xff\xcc\x4a\x63\xcd\x10\x03\x00\x00")
+var _templatesResponse_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4d\x6f\xdc\x36\x10\x3d\x8b\xbf\x62\x2a\x18\xae\x64\xac\xe9\xbb\x0b\x5f\x1a\xa7\x49\x80\x36\x30\xea\x02\xed\xad\x50\xc5\x59\x2f\x1b\x99\x52\x49\x2a\xaa\x41\xcc\x7f\x2f\x86\xe2\x4a\xda\xec\x47\xe2\xc6\xb9\xec\x87\x38\xf3\xe6\xbd\xc7\x47\x2a\x04\x85\x6b\x6d\x10\x72\x8b\xae\x6b\x8d\xc3\x3f\x1f\xda\x9c\x48\x74\x55\xfd\xa1\x7a\x40\x08\x41\xde\x8d\x3f\xdf\x57\x8f\x48\x24\x84\x7e\xec\x5a\xeb\xa1\x10\x59\x08\xb6\x32\x0f\x08\x67\x1f\xe1\xfa\x06\xe4\xbb\xb8\x70\x57\xf9\x8d\x83\x4b\x22\x91\xe5\x21\x9c\x7d\x24\xca\xb9\x12\x8d\x8a\x0f\x4b\x21\xae\xae\x18\x75\x84\x03\xed\xc0\x6f\x10\xb6\xd3\xa1\x5d\xf3\xe2\x6b\xa3\xba\x56\x1b\x4f\xb4\xe2\xf2\xd6\x34\x4f\xb1\x6c\xad\xb1\x51\x5c\xc3\x7f\x9c\xaf\x7c\xef\xa0\x6e\x15\x6e\x1f\x4d\x30\xda\x81\x43\x2f\x85\x7f\xea\x70\x31\xcd\x79\xdb\xd7\x1e\x02\x33\xba\x84\x91\xbd\xfc\x35\x35\x39\xe6\x7c\x1f\x41\x43\x90\xaf\x5a\xc5\x1d\x17\x53\xf3\xd8\x83\x46\x11\x09\x8a\x2a\x14\xc6\xd9\xe3\xd7\xbe\x8e\x03\x1c\x79\x39\x2a\xe2\xb5\xde\x28\xac\x9b\xca\xa2\x5a\x96\x39\xa8\x2c\x9a\xef\x7d\x82\x55\x52\xac\x7b\x53\x43\xd1\x2f\xa8\x94\x69\xb1\x60\x3c\xb8\xd8\x78\xdf\x4d\x2a\x4a\x40\x6b\x5b\xcb\x1a\xdd\xa0\x7d\xbd\x89\xde\xca\x51\x17\x8b\x3a\xa5\xbe\xae\x5c\xb4\x8b\xeb\x88\xae\x45\x96\xf5\xf2\x53\x47\x6e\xe0\x7c\x22\x12\x48\x64\x99\x45\xdf\x5b\x03\x7b\x95\x72\x41\xb2\x5c\xba\x97\x91\xd8\x36\x19\xdd\x24\x33\x7f\xb7\xda\x23\x0c\xfc\xf9\x79\x2b\x87\x8d\xae\x37\x9c\x1d\x87\x3e\xfa\xa9\x3d\x8c\x88\x0e\x2a\x93\x1c\xd0\x6b\x30\xad\x99\xb3\xb0\xf5\x71\x62\x5f\x8e\x43\x8b\x01\x76\x1c\x8c\x0f\xed\xbe\x8f\x9f\xf3\x6d\xdf\xaa\xef\x6e\xc0\xe8\xe6\xfa\x94\x47\x89\xc1\x31\x7f\x22\x07\x27\xdf\xe3\x50\xe4\xa6\x3d\x7e\x4c\x92\xc8\xbc\x14\x24\x42\x38\x40\xf1\xd0\xa1\x9b\x89\xee\xe2\x9e\x2d\x80\xc5\x42\xf2\x2d\xba\xda\xea\xce\xeb\xd6\x4c\x88\x44\xf1\x6c\x13\x9d\x3e\x6a\x7a\x0d\xf2\xc7\x56\x3d\xb1\x3a\xfe\x66\x32\xdb\xff\x0b\xe1\x8b\x61\x6f\xb1\x52\x68\xa3\xbb\x21\xc8\x9f\xf8\xe0\x13\x41\x08\x71\x57\x3d\xfb\xff\x4f\xaf\x2d\x2a\xa2\x8b\xc4\x20\x04\xf9\xdb\x53\xc7\xb3\x77\xc4\x6e\x22\xd0\x72\xcc\x91\xc0\x8d\x85\x6e\xf5\x89\x37\xcb\xdc\x55\x46\xc5\xd5\xbf\x58\x42\xca\xe5\xd6\xbb\x94\x2f\xfb\xfc\x7c\x1d\x53\x3d\xda\x36\x0b\x15\x99\x5e\x73\x17\xdf\xb6\xbc\x4b\x6f\xb1\xe9\xd0\xde\x7d\x78\x20\xba\x47\x3f\x1a\x56\x0c\x09\xa3\x28\x57\x90\x4f\x54\xf2\x15\x9c\x5b\x39\x1b\x59\xfe\x10\x81\xc6\x80\x42\x98\x13\x8a\xd6\xc6\xfc\xf1\x74\x6c\x1c\xa6\xa9\xcb\xde\x14\xeb\xd8\xf5\x35\x84\x4e\xf3\xd9\x21\x94\xd1\x4c\xca\xa8\x03\xa1\x59\xe6\x6b\x1e\x28\xef\xd1\x17\xf9\xab\xd6\x78\x34\xfe\x92\xc3\x91\xaf\x20\xaf\xba\xae\xd1\x75\xc5\x31\xbe\x8a\x79\x8a\xc9\x7c\xe7\xfe\xf8\xe5\x67\xa2\x7f\x1f\x9b\x10\x46\xe1\x7f\xbb\xd6\xa4\x68\xe5\xbb\xe7\x73\x90\x71\x63\xd3\x98\x29\x2a\xe5\x3e\x97\x24\xe2\x0b\xc7\xf0\x39\x7f\x6d\xf8\xd2\xb4\xc5\x50\xca\xf1\x67\x71\x6e\x23\x5c\xb9\xbb\x29\x09\x99\x2f\xd0\x2f\x79\x31\xa5\x70\xef\x25\x98\xd3\x3b\x25\xf7\xd9\x6f\x98\x17\x48\xee\x9b\x29\x28\x4c\x25\xc1\xbc\x68\x74\x67\x58\xf9\x86\xf3\x30\x23\x97\x8c\x92\xe7\x31\xc9\xcb\x11\x70\x03\x06\x87\x62\xba\x50\x4a\x91\x7d\x9d\x80\x6f\x15\xf5\xe7\xc7\xeb\x16\xc7\x78\x45\xae\x0c\x53\xca\x5b\xfc\x1f\x31\x4b\x90\x22\x84\x4b\x40\xa3\x88\xc4\x7f\x03\x00\x9d\x12\x31\xf1\x4b\x0a\x00\x00")
+
+func templatesResponse_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesResponse_goTmpl,
+		"templates/response_go.tmpl",
+	)
+}
+
+func templatesResponse_goTmpl() (*asset, error) {
+	bytes, err := templatesResponse_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/response_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesRouter_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xdd\x56\x4d\x4f\xdc\x30\x10\x3d\x93\x5f\x61\xe5\xd2\xa4\x5d\xbc\x97\xf6\x52\x89\x43\x85\x5a\x81\x0a\x08\x6d\xa1\x3d\x00\x5d\xbc\xce\x6c\x62\x91\xb5\x83\xed\xec\x82\xa2\xfd\xef\x1d\xdb\xc9\x92\x2c\x1f\x6d\x0f\xa8\x52\x0f\x68\x93\xf9\x78\x9e\x79\x6f\xc6\xa1\x69\x32\x98\x0b\x09\x24\xd6\xaa\xb6\xa0\xa7\xb9\x8a\xd7\xeb\xa8\x62\xfc\x86\xe5\x40\x9a\x86\x9e\x86\xc7\x13\xb6\x00\x74\x44\x62\x51\x29\x6d\x49\x12\xed\x34\xcd\x2e\x11\x73\x02\xb7\x84\x4e\x7c\x2e\x89\x81\x17\x2a\x26\x18\xb6\x13\x73\x25\x2d\xdc\xd9\x38\xc4\x81\xcc\x82\x59\x82\x1d\x17\xd6\x56\xf1\x93\xf9\xc6\x66\xa5\x98\xb5\x08\x1a\x72\xb8\xab\xb6\x00\x9a\x66\x3b\x87\x17\xa2\x4d\xc8\x85\x2d\xea\x19\xe5\x6a\x31\xce\xd5\x2e\xda\xc7\xee\x6f\xf9\xa1\x83\x28\x0d\xbc\x50\x70\x2f\xbb\x64\x33\x63\xb1\xed\xb1\x73\x8f\x97\xef\x87\x35\xa4\xd1\xe3\x22\x7a\x85\x8f\xc7\xc4\x16\x40\x78\xc1\x34\xe3\xe8\x34\x44\xcd\xbd\xa5\x42\xc3\x02\xbc\x45\xe2\x83\x21\xab\x42\xf0\x82\x30\x0d\xf2\x8d\x25\xac\x2c\xd5\x0a\x32\x22\xa4\x0f\xfe\x06\x7a\x09\xc7\xf5\x1d\x59\x89\x32\xe3\x4c\x67\x26\x5a\x32\x4d\x34\x9c\x28\x79\x98\x81\xb4\x62\x2e\xf0\xe0\x3d\x12\x58\xa2\xc7\xb5\xb1\xfb\x6a\x51\x89\x12\x92\xeb\x8b\x9f\x97\xd5\xd1\x65\x75\x32\xbd\x7a\x77\x9d\x46\xae\xa2\xf3\xc9\xe1\xa9\x3b\xde\x60\xbc\xad\xb5\x34\xfe\x90\x79\x2d\xb9\x15\x4a\xb6\x95\xf4\x5d\x98\xd0\x2f\xb8\x6d\x21\x17\x4b\x90\x6d\xf5\x68\x62\x98\x72\x5b\x83\xb1\xd4\x9d\x71\x86\x01\x9b\x6a\xbb\x8c\x4d\x1f\x15\xb3\x88\x84\xe8\xd8\xaf\xf7\x6c\x50\x86\xdc\x38\xa4\x55\xa1\xcc\x80\xc1\x01\x53\x25\x38\x24\x33\x22\x99\x40\xcd\x10\x42\x93\x5a\x66\x68\xe1\x4a\x43\xc0\xd7\x50\x95\x8c\x23\x9b\xb3\xfb\xbe\x8f\x46\xae\xe3\x07\x32\x92\x50\x03\xa5\xd4\x58\x2d\x64\x9e\x7a\x46\x92\xb7\x6e\x40\xe9\x24\xb4\x96\x92\x05\xab\x2e\x82\xff\x2a\xfc\x90\x26\xda\x09\x5c\x85\x78\x4d\xfe\x24\x63\x07\xf5\x33\xe4\xe3\x1e\x7a\x6f\x20\x79\x14\x32\xc2\xbe\x64\x28\x28\x4d\x31\x7a\x8e\x6d\x4d\x47\x9e\x25\x97\xa4\x99\xcc\x3b\xce\x1c\x98\x47\xbb\x70\xef\x57\x6e\x08\x70\x4b\x6d\xf1\x9d\x95\x35\x24\x5b\x23\x82\x55\x79\x2e\x3e\x95\xe5\x37\x7f\x90\x3f\x63\x44\xe2\x69\xec\xcf\xc1\xd1\xef\x9a\x71\x90\x11\x1a\xd6\xd1\x73\xeb\xd2\xed\xda\xeb\x4f\xd4\xff\xaa\x14\x32\x48\xcf\x27\x47\xbe\xab\x44\x87\xac\xbf\x96\x61\x73\x6b\xd9\xfb\x0a\x48\xad\x45\x20\xe9\x2b\xdc\x13\x2c\xb1\xe6\xb6\x59\xfb\xad\xff\x8c\x71\x07\x4c\x66\x25\xe8\x81\x14\x2e\x9f\x14\xad\x23\x28\x65\xdc\x9e\x06\x6f\xab\x80\x71\xcb\x53\x8c\xba\xfb\xec\xb1\x74\x1e\x05\xd7\xda\x2f\x26\x3e\x18\x83\x0b\x67\x11\xb8\xbb\xc3\xda\x4f\x40\x17\x3f\x50\xb6\x57\x5a\x52\x10\x2f\x4a\xfb\x9a\x7a\xe0\xee\xed\x8b\x0b\xde\x52\x91\x87\x88\xfd\x00\x8f\xf1\x5a\xa3\x04\xbf\x17\xee\x59\xb1\x38\xf5\x04\xba\x4f\x9c\x49\xd2\xa7\x54\x0b\x01\xc9\x40\x2d\x97\xcf\xbb\x51\x4a\x9c\xb9\xa0\xfe\xba\x3b\x38\x3b\x3b\x4d\x9c\xc7\x54\x4a\x1a\x48\xd2\x11\x2e\xe8\x0f\xfc\xbc\xb4\x15\x27\x2d\x31\xde\xd6\x2e\x6d\xd7\x8d\x0b\xee\x0b\xda\xac\x47\x7e\x20\x52\x3f\x6a\x2d\x0b\x52\x94\x61\x40\x5e\x67\x11\x1d\x6a\x7f\x17\xc3\x70\xf8\xcb\x94\xc9\xbe\x72\xff\x74\x49\x7d\x0b\xf8\x15\x98\x7a\x1d\x1f\xf8\xa3\x81\xd1\x21\x89\x29\x7d\x7a\x1c\x5e\x71\xd3\x43\x7d\xe1\xf5\xc5\x05\x0f\xff\x55\x34\x0d\x3e\xe0\xef\x2f\xe2\xf0\x67\x01\x91\x09\x00\x00")
+
+func templatesRouter_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesRouter_goTmpl,
+		"templates/router_go.tmpl",
+	)
+}
+
+func templatesRouter_goTmpl() (*asset, error) {
+	bytes, err := templatesRouter_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/router_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServer_main_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\x8e\xdb\x36\x10\x3d\x8b\x5f\x31\x20\xf6\x20\x05\x5e\x72\x13\x34\x97\x05\x72\x58\xc0\x4d\x6a\xa0\x49\x8d\xdd\xa2\x3d\x06\xb4\x34\xa2\x08\x53\xa4\x42\x51\xb6\x01\x42\xff\x5e\x90\xb2\xe5\xd4\xbb\x71\xda\x00\x39\x18\x30\xc9\x37\xa3\xf7\xde\x3c\x4a\x21\xdc\x42\x85\xb5\x32\x08\xb4\x47\xb7\x43\xf7\xb9\x15\xca\x7c\x96\x96\xc2\xed\x38\x92\x78\xae\x6a\xc0\x2f\xc0\x1e\xed\xe0\xd1\x01\xed\x7d\xa5\xd5\x86\xc2\x38\x12\xce\xc1\x37\x08\x2d\xfa\xc6\x56\x20\x4c\x05\x7b\xa5\xab\x52\xb8\x0a\x3a\xe1\x3d\x3a\xd3\x83\xad\x13\xe6\x29\x36\xff\x38\x1c\x08\xe7\xd2\xde\x57\xb8\x19\x24\x34\xde\x77\xed\x70\x90\xf6\xf5\x9b\xd7\xef\xee\x08\x09\x01\xd0\x54\xe9\xb9\x9d\x28\xb7\x42\x22\x84\xc0\xd6\xd3\xdf\x4f\xa2\xc5\x71\x24\x44\xb5\x9d\x75\x1e\x72\x92\x51\x6d\x25\x25\x19\x35\xe8\x79\x6c\x45\x09\x01\x00\xa0\x21\xb0\x47\x6b\xfd\x2a\x01\xd7\xc2\x37\xe3\xc8\xa5\x75\xa2\xd5\x34\x3e\xe2\x42\x4e\xd9\xa8\xa4\x25\xa3\x52\xf9\x66\xd8\xb0\xd2\xb6\x5c\xda\xdb\xb2\x51\x3c\xfe\x76\x6f\x29\xc9\xa2\x0d\xa8\x7b\xbc\x2c\xc6\xb2\xb1\xcf\xab\xb5\xd8\xf4\x5e\x94\x5b\x1e\x8f\xf9\xee\x97\x2b\x0d\xa4\x75\x4a\x6b\xf1\x12\x83\x74\xc0\xdb\xe1\x70\x2a\x37\x55\x44\x25\x89\xd2\x76\x5b\xc9\x94\xe1\x3b\xa1\x55\x25\xbc\x75\x6c\xf7\x86\x92\x82\x90\x7a\x30\x25\xc4\x11\xe6\x05\x84\xe4\x07\xe7\xa0\x4c\x37\x78\x98\xb1\x69\xfb\x5c\xf9\x84\xfe\xaf\x69\xa1\xac\x79\x3f\x98\x32\xa7\xed\xa0\xbd\xea\x34\xfe\x51\xd3\x05\x4c\xde\xb1\x8f\xf3\x5e\xf1\x82\x8d\x67\x27\x1c\xdc\xbf\x83\xb8\x64\x9f\x70\x9f\x17\x84\x64\x9c\x43\x63\x5b\x84\x4e\x48\x24\x99\x63\xef\x95\xc6\x9c\x72\xba\x00\xaa\x4c\x85\x07\xd6\xf8\x56\xd3\x62\x1a\xdf\xd4\x9a\x3d\xac\x57\x4b\x5b\xf6\x4b\xe5\x4e\xa2\x39\x07\xd1\xa9\xca\x96\x7d\xc2\x39\xf6\xe4\x85\x57\x65\x4e\x79\x08\x5f\xc1\xc7\x31\xf6\x65\xfc\x88\xa5\xc5\xa9\xeb\xd1\xbf\x79\x12\x91\xeb\xf5\x78\x4f\x52\x62\xb4\xa2\x94\x53\x82\xf3\xe2\xdb\xe3\x9c\xc3\x94\x2a\xcb\x46\xc5\xc2\xe9\xf0\x5f\x65\x33\xa4\x1d\x0e\x2f\x40\x26\xa6\x27\xd5\x5f\x79\xf7\x3d\xbe\xec\x37\x61\x2a\x8d\xd3\x10\x3f\xfc\xfa\x27\xf0\x70\x13\xfd\x88\xa9\xc8\xf7\xe9\xc2\xb1\x47\xec\x3b\x6b\x7a\xfc\xdb\x29\x8f\x6e\x01\x0e\x5e\x1d\xf7\xbf\x0c\xd8\xfb\x18\x9b\xff\xa0\x8f\x7d\x40\x3f\xcd\xf0\x87\x7b\x3f\x67\xfc\xc3\xed\x26\xc3\xb2\x2c\x09\x49\x83\x4a\x19\xdb\x2f\xc0\x5d\x86\x2c\x1b\xff\x67\xd0\xae\x7b\x1e\x0b\x4e\x22\x9e\x47\x31\x0a\x9a\x38\x79\xa7\xba\xb5\xc3\x5a\x1d\xae\xa0\x22\xe9\xc4\xde\xe5\x69\xbd\x54\x2e\x3f\x47\x99\xd3\xa2\x28\x4e\x71\xbe\x3e\x9f\xef\xb0\x7a\xf5\xb3\x69\xcd\x14\xd6\xc2\x37\xdf\xee\x5f\x1c\x29\xba\xfc\xa7\xb1\x39\xbf\x34\x2f\x5e\x01\xc7\x3b\x96\x85\x00\x4e\x18\x89\x70\xb3\x5d\xc0\xcd\x2e\xde\xdb\x78\x45\xec\xe0\x4a\xec\x97\x58\x47\x54\x16\x02\x9b\xbe\x3f\x2b\xe3\xd1\xd5\xa2\xc4\xe4\x78\x9f\xbb\x05\xcc\x67\x0f\xeb\x55\x18\xd3\x2d\x3f\x77\xd7\x56\xb2\xb5\x53\xc6\x6b\x93\xd3\xde\x0b\xe7\x95\x91\x30\x7d\x69\x69\x41\xa6\xc0\xfe\xae\x7a\x8f\xe6\xc1\x54\xc9\xe4\x9c\xde\xbf\xbd\xbb\xbb\xa3\x0b\x70\x05\x19\xc9\xcc\xf6\x76\x1c\xc9\x3f\x03\x00\x4b\x7f\xff\xe9\xaf\x07\x00\x00")
 
 func templatesServer_main_goTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -747,7 +959,7 @@ func templatesServer_main_goTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServer_main_nimTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x90\x41\x6b\xe3\x30\x10\x85\xef\xfa\x15\x83\xf0\xc1\x06\xc7\x7b\x0f\xe4\xb4\x24\xcb\x2e\x6c\x29\x6d\xe9\x35\xa8\xd6\xb4\x56\x6a\x49\x66\x46\x31\x0d\x62\xfe\x7b\xb1\x9c\xd0\x42\x6f\xd2\xe8\x7d\x4f\xef\x4d\xce\x1b\xb0\xf8\xea\x02\x82\x66\xa4\x19\xe9\xe8\x8d\x0b\xc7\xe0\xbc\x86\x8d\x88\x72\x7e\x8a\x94\xe0\x84\x9c\x90\x5a\x30\x7c\x09\xbd\x75\x3c\x99\xd4\x0f\x2d\x9c\x38\x86\x16\xbc\x21\x1e\xcc\xd8\x02\x5f\x38\xa1\x57\x39\x03\x99\xf0\x86\x50\xbd\xb7\x50\xcd\xb0\xdd\x41\xf7\xb7\xf8\x30\x7c\x59\xe6\x5c\xcd\x22\x39\x63\xb0\x22\x4a\x51\x3c\x27\xe4\xad\x5a\x12\xfd\xa0\x1f\x90\xe3\x99\x7a\x2c\xfc\x77\x85\x5f\x24\x7e\xd1\x54\x73\xf7\x1f\xd3\x10\x2d\x8b\x28\x28\xee\xbe\x7b\x46\x7a\x11\x01\xbd\xde\xfe\x95\x12\xfb\x60\xa7\xe8\x42\x12\xd1\x5b\x05\x00\x30\x62\x02\xc2\x04\xbb\x2b\xb4\xda\xdc\x19\x8f\x22\xf5\x3a\x7a\x2c\xab\xf9\x6d\xc6\xf1\xde\x90\xf1\x2c\xd2\x14\x94\x90\xa7\x9a\x30\x75\x7d\xb4\xd8\x42\x55\xad\xe7\x90\x30\xa4\x46\x95\x6e\x4b\x62\x05\xf0\x67\xff\x04\xfa\xd7\xf5\xc7\x2b\x66\xec\xc1\x8d\x58\x6b\x17\x2c\x7e\x74\x43\xf2\xa3\x6e\x9a\x52\xef\xc6\xd1\x39\x1c\x22\xe1\x8c\x54\x2f\x0f\xb7\xf9\x67\x00\x00\x00\xff\xff\x04\x99\xf2\xd7\xb6\x01\x00\x00")
+var _templatesServer_main_nimTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x52\x4d\x6b\xdc\x30\x10\xbd\xfb\x57\x0c\xc2\x07\x1b\x14\x67\x0f\x3d\x2d\x6c\x29\x94\xa4\x69\xa1\x1f\xa4\xa5\x97\x52\x82\x62\xcd\xd6\xda\xe8\xc3\xd5\x68\x4d\x83\x99\xff\x5e\x24\xef\x36\x86\x52\x28\xe4\xe6\x99\x79\xf3\x9e\xde\xf3\xcc\xf3\x05\x68\xdc\x1b\x8f\x20\x08\xe3\x84\xf1\xce\x29\xe3\xef\xbc\x71\x02\x2e\x98\x2b\xe3\xc6\x10\x13\x1c\x90\x12\x46\x09\x8a\x1e\x7d\xaf\x0d\x8d\x2a\xf5\x83\x84\x03\x05\x2f\xc1\xa9\x48\x83\xb2\x12\xe8\x91\x12\xba\x2a\x93\x9a\x3d\x74\x37\x8a\x3e\xa9\xa8\x1c\xc1\x13\xd1\x58\x1a\x05\x82\x5e\xe7\xc1\x3c\x43\x54\xfe\x07\x42\xfd\x20\xa1\x9e\x60\xbb\x83\xee\x6d\x01\xaf\xf7\xe6\xb9\x9e\x98\xe7\x19\xbd\x66\xae\xaa\x18\x8e\x09\x69\x5b\x78\xfe\xda\xbe\x45\x0a\xc7\xd8\x63\xd9\x5f\x23\x5c\x86\xb8\x8c\xa9\xa7\xee\x3d\xa6\x21\x68\x62\xae\xa0\xb0\xbb\xee\x2b\xc6\x7b\x66\x10\x4b\xf5\xae\x58\xbe\xf2\x7a\x0c\xc6\x27\x66\xb1\xad\x00\x32\xb4\x98\xcb\x88\x62\xee\xe3\xfd\x01\xfb\x54\xa4\xf2\xd8\x62\x82\xc9\x04\xab\x92\x09\x9e\x60\x07\xfd\x80\xfd\x43\x41\x52\x13\xf1\xe7\x11\x29\x49\x78\xf5\xad\x70\x01\xac\x5e\xf7\x0f\xc2\x8c\xe9\x98\xe5\x6a\xe1\x14\x5c\xae\xbf\xb7\xa5\x6f\xf6\x2b\xd1\xce\xa2\x87\x97\xb0\xd9\x9e\x56\x22\xd2\xd8\xdc\xa4\x34\xbe\xd8\x6c\xe4\x0a\x77\x8b\x34\x06\x4f\xd8\x3c\xb5\x5a\x09\x42\x8d\xa3\x35\x7d\xa9\x2f\xf3\xff\x15\x8b\x04\x5a\xc2\x33\x63\x36\x19\x31\xc1\xee\x14\xdc\x12\xe5\x07\xe5\x90\xb9\x59\x5a\x9f\xcb\x31\xbd\x56\xd6\x2e\xde\x99\xdb\xf5\x73\x22\xa6\xae\x0f\x1a\x25\xd4\xf5\xf2\xed\x13\xfa\xd4\xfe\x89\x38\xcb\x9d\x4d\x3e\x43\xee\x3f\xc5\xce\x97\x78\x4a\xb6\x02\x78\x73\xf5\x05\xc4\xa5\xd8\xae\x59\x94\xbe\x36\x16\x1b\x61\xbc\xc6\x5f\xdd\x90\x9c\x15\x6d\xbb\x3e\xe5\x2a\x1e\xfd\x75\x88\x38\x61\x6c\xf2\x00\xd0\x6b\x60\xae\x7e\x0f\x00\x71\x8f\xc0\x2a\x66\x03\x00\x00")
 
 func templatesServer_main_nimTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -787,7 +999,7 @@ func templatesServer_main_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServer_resources_apiTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x54\x4d\x6f\xdb\x38\x10\x3d\x9b\xbf\x62\x56\x30\x16\xd2\x26\xa1\x73\xd8\x53\x82\x1c\xb2\xdb\x04\x0d\xd0\x06\x6e\x0b\xa4\x87\xa2\x88\x69\x6b\x14\xb3\x96\x48\x79\x44\xd9\x30\x08\xfe\xf7\x62\x48\x19\x8a\xd3\xf8\xe2\xf9\x7e\x6f\x1e\x49\x79\x7f\x01\x25\x56\xda\x20\x64\x84\x9d\xed\x69\x85\xcf\xaa\xd5\xcf\x0e\x9b\xb6\x56\x0e\x33\xb8\x08\x41\xb4\x6a\xb5\x51\x2f\x08\xde\xcb\x79\x32\x1f\x55\x83\x21\x08\xa1\x9b\xd6\x92\x83\x5c\x00\x00\x78\x0f\xa4\xcc\x0b\xc2\x74\x73\x0e\xd3\x1d\x5c\xdd\x80\xbc\x9d\x3f\x7c\xd2\xcb\x87\x58\x36\x57\x6e\xdd\xc5\x81\x30\xfc\x32\xef\xa7\xbb\x10\xb2\x63\x3b\x9a\x32\xe6\x0b\xe1\xfd\x54\xb5\x9a\x61\xe2\x98\x01\x6f\x36\x63\x0e\xc9\xb9\x9d\x3f\x80\xee\x20\xfe\x35\x6d\x8d\x0d\x1a\xa7\x9c\xb6\x06\x6c\xc5\x55\x77\xa6\x6c\xad\x36\x2e\x04\x20\x6b\x1d\xcf\x8e\xbe\x70\x87\x16\x4f\xc7\x74\x8e\xfa\x95\x03\x2f\x82\x10\xe2\xbd\x2d\x3e\xa3\x5b\xdb\xb2\x83\x23\x85\xe9\x6e\x08\xa5\x19\xcc\xc3\xad\x11\xd6\xca\x94\x35\x12\x54\x96\x52\xd1\x13\xd2\x32\x84\x64\x8f\x7c\x04\xcb\x7e\xc4\xa8\x18\xa4\x62\x94\xe9\x4e\xde\xf7\x66\xf5\xbf\x6d\x78\x95\x6e\xc4\xaa\x42\xf0\x1e\x4d\x19\x82\xa8\x7a\xb3\xca\x55\xab\x61\xd4\x27\xae\x50\xfc\x49\x2a\xdf\xc3\xda\xb9\x56\x7e\xc5\xae\xb5\xa6\xc3\xef\xa4\x1d\xd2\x39\x10\xfc\x33\xc4\xb7\x3d\x76\xae\x00\x2f\x26\xaf\x09\x6d\x99\xd0\x76\x20\xf4\xa5\x47\x3a\xcc\x15\xa9\x06\x1d\xd2\x78\x78\x89\xd9\x66\x1b\x02\x17\x12\x6e\xe5\xbd\xa5\xe6\x49\xd5\x3d\xe6\xd9\x90\xc9\x8a\x34\x98\x0f\x35\x84\x64\xeb\x0a\x18\xf8\x3f\x5b\x1e\xe2\xac\xc9\x4e\x11\xb7\xc7\x80\xf7\xc7\x1c\x5f\xad\x01\xa6\xc4\x95\x2d\x91\x6b\x98\xad\x98\xe8\x0a\x90\x88\x51\x7f\x75\xd6\xc8\x47\xdc\x7f\x88\x15\x94\x93\xe4\xd6\x42\x26\x3f\xff\x7b\x18\x5b\x5c\xc7\x86\xbf\x6e\xc0\xe8\x9a\x97\x9d\xec\x65\xd4\xe2\x23\x2a\x6e\xfb\xf7\xf2\xb2\x10\x93\x09\xa1\xeb\xc9\x88\xc9\x88\xbc\x53\xb5\x2e\x95\x1b\xb1\x39\x3e\xc2\x0f\xe3\xe5\xd3\x50\x96\xbf\x05\x3a\x5e\xf2\x77\xe0\xde\xa4\xf2\x1f\x3f\x97\x07\x87\xf9\xc2\x67\x48\x64\x29\xbb\xca\x16\x67\x48\x24\xef\xd8\xcb\x8b\xb3\x45\x16\x16\xc5\xd8\x36\x70\x65\x33\x9c\x68\xfc\x5a\xe4\xae\x8d\xa2\x8e\x22\x0f\x81\xa8\x72\xb2\x39\x77\x54\xf1\xce\x24\x15\xf7\x85\x4c\x26\x0b\x98\xca\x8a\xeb\xd3\x83\x9c\xcd\xa0\x37\xab\x74\x4d\x61\x89\xb5\xdd\x43\xcd\x9f\x10\x67\x41\x95\x25\xac\xe3\xa2\xb1\x6c\x2f\x87\xad\x0b\xf9\x0d\x5d\x9e\x6d\xf0\x90\x9d\x67\x3b\xbe\x26\x59\x21\xd2\x43\x38\xbe\xf9\x13\xe7\x77\x00\x00\x00\xff\xff\xa7\x39\xf1\xd8\x99\x04\x00\x00")
+var _templatesServer_resources_apiTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x5d\x4f\xec\x36\x10\x7d\x8e\x7f\xc5\x34\x5a\x5d\x25\x55\x36\xfb\xde\xab\xfb\x00\x14\xd4\x95\x16\xb4\x85\x96\xf2\x86\x4c\x32\xd9\x75\x49\xec\x60\x3b\xbb\xac\x2c\xff\xf7\xca\x1f\x61\x03\x05\x89\x4a\x94\x17\xc6\xce\xcc\x9c\x73\x66\xc6\xb3\xc6\xcc\xa1\xc6\x86\x71\x84\x54\xa2\x12\x83\xac\xf0\x9e\xf6\xec\x5e\x63\xd7\xb7\x54\x63\x0a\x73\x6b\x49\x4f\xab\x47\xba\x41\x30\xa6\x5c\x07\xf3\x8a\x76\x68\x2d\x21\xac\xeb\x85\xd4\x90\x11\x00\x00\x63\x40\x52\xbe\x41\x98\x3d\x16\x30\xdb\xc1\x2f\x3f\xa0\x3c\x59\x2f\x57\xec\x61\xe9\xdd\xd6\x54\x6f\x95\x4f\x08\xf1\x2f\x35\x66\xb6\xb3\x36\x1d\xc3\x91\xd7\xfe\x7b\x4e\x8c\x99\xd1\x9e\x39\x18\x9f\x26\xe2\x2d\x16\x8e\x43\x38\x9c\xac\x97\xc0\x14\xf8\x7f\x5d\xdf\x62\x87\x5c\x53\xcd\x04\x07\xd1\x38\xaf\x73\x5e\xf7\x82\x71\x6d\x2d\x48\x21\x34\x60\x3c\x13\x7d\xe8\xf1\x75\x1a\xa5\xe5\x50\x69\x30\xc4\x12\x42\xde\x53\x71\x89\x7a\x2b\x6a\x05\x23\x85\xd9\x2e\x5e\x05\x2a\x8e\x87\xde\x22\x6c\x29\xaf\x5b\x94\xd0\x08\x19\x9c\x6e\x51\x3e\x58\x1b\xec\x23\x1f\xe2\xca\x3e\x62\x34\xae\x54\x8d\x13\x39\xdb\x95\x17\x03\xaf\xce\x44\xe7\xa4\xa8\x23\x56\x63\xad\x31\xc8\x6b\x6b\x49\x33\xf0\x2a\xa3\x3d\x83\x63\x7d\x7c\x25\xf2\x7f\x93\xca\xf6\xb0\xd5\xba\x2f\xaf\x51\xf5\x82\x2b\xfc\x4b\x32\x8d\xb2\x00\x09\x3f\xc7\xfb\xa7\x01\x95\xce\xc1\x90\xc4\x11\x62\x0d\x94\xbf\x0f\x28\x0f\x37\x5a\x32\xbe\x71\x5a\x93\x1d\x95\xf0\x34\xb9\x33\x66\xea\xe2\x06\x20\x59\x2c\xa0\xc6\x4a\xd4\x18\x1c\x41\xf9\x68\x92\xb0\x06\x50\x4a\xa7\x6b\x23\x24\xed\xda\xf2\x57\xef\x35\x09\xcf\x64\xf9\xe7\xf5\x2a\x24\xcc\xf2\x02\xbe\x4d\x90\xf2\xef\x3e\xfa\xa7\x1f\xc0\x59\xeb\x18\x26\x31\x8b\x57\x71\x2e\xa5\x90\xd9\xbe\x08\x02\x6f\x34\xd5\x83\x3a\xa5\x75\x54\x54\xb8\xd0\x9c\x24\x89\x44\x3d\x48\x4e\x92\x48\x73\x47\x5b\x56\x53\xfd\x31\xd1\x09\x7e\x79\x1b\x9d\xb3\x2f\x66\x92\xc4\x29\xb7\xf6\x58\xf5\x6b\x7c\x3a\x15\xf5\xc1\x4f\xbe\x2f\xb9\x8c\x17\xc6\x8c\xdf\x7c\xa9\xdf\xf8\x2f\xd5\x85\x90\x9d\xeb\x93\x7f\x3e\xc7\x46\xc8\x80\xfe\x61\x0f\x5c\x58\x26\x0b\xf8\x16\x71\xbe\x5e\xe2\x1c\xb0\x55\xf8\x39\x6a\xc6\xbc\xd2\x74\x77\xb9\xb2\xf6\xb9\x6b\x8d\x71\x29\xac\xfd\x5b\x09\x1e\xa7\xbf\xbc\xc2\x7d\x98\x22\x99\xc9\xd2\x95\x25\x8f\x8a\xb2\xff\x55\x0a\xaf\xa7\x4a\x5e\xc6\x68\xd4\xe2\x8a\x7f\x94\x13\x89\x7c\x3c\x40\xe3\xee\xfb\xcf\xc4\xc6\xc0\x48\xcf\x99\x6f\x18\x4e\x26\x4a\xf5\xae\x1c\x2f\x8f\x58\x8e\x17\x7e\xa4\x82\x3d\x1d\xc1\x3f\x0e\x3d\xd6\xe3\xa6\xf0\x7b\x2e\x19\x43\x02\xc3\x6c\x9f\x47\x2c\xd7\xd8\x29\xc6\x52\xdd\x5d\xae\x7c\xc4\xbe\xfc\x0d\xa9\x6b\x4e\x5e\xde\xa0\xce\xd2\x33\xc1\x35\x72\x3d\x77\xc9\xd3\x02\x52\xda\xf7\x2d\xab\xfc\x8e\x5e\x3c\x77\x6d\x9a\x93\xe4\xb9\x6b\x5d\x57\xcf\x79\xe8\xea\x3e\x2f\x83\xe9\x1a\x1a\xd2\xe7\xdf\x27\xb8\x0e\xc5\x0d\xc4\x67\x63\x26\x0f\x6d\x6a\xb3\x06\xb8\xd0\xef\xaa\x5e\x2c\x60\xe0\x55\x58\xc1\xf0\x80\xad\xd8\x43\xeb\x7e\x1e\xb5\x00\x5a\xd7\xb0\xf5\x02\xfd\x3a\x79\xab\xf6\x11\x0f\x69\x91\xee\x68\x3b\x60\x3a\xd6\x2a\x60\x5a\x32\x1e\xdc\x0b\x7f\x75\xf8\x67\x00\x4f\x39\x50\x07\x81\x07\x00\x00")
 
 func templatesServer_resources_apiTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -807,7 +1019,7 @@ func templatesServer_resources_apiTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServer_resources_api_nimTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x92\x41\x8f\xda\x30\x10\x85\xef\xfc\x8a\x51\xca\x01\x2a\x88\xd0\xb6\xa7\x48\x48\x55\x91\x56\x2d\x55\xb7\x2b\x16\x89\x03\x42\xc8\x38\x43\x09\x24\x76\x18\xdb\x59\x21\xcb\xff\xbd\xb2\xe3\x2c\xd1\x76\x6f\xc9\xf8\xcd\x9b\x6f\x9e\x6d\xed\x14\x72\x3c\x16\x02\x21\x51\x48\x0d\xd2\x9e\x50\x49\x43\x1c\xd5\x9e\xd5\xc5\x5e\x14\x55\x02\x53\xe7\x06\x45\x55\x4b\xd2\x70\x46\xa5\x91\x26\x50\x31\x52\x27\x56\x4e\x40\xdd\x94\xc6\x6a\x60\x6d\x71\x84\xf4\x09\x31\x5f\x6e\xd6\xce\x45\xb5\x64\x46\x9f\x1e\xf6\xe7\x57\x6d\x2d\x8a\xdc\xb9\x81\xb5\x40\x4c\xfc\x45\x18\x5e\x26\x30\x6c\x20\x9b\x43\xfa\x33\x88\x15\xdc\xa7\x58\x3b\x6c\x9c\xeb\x7a\xde\x9b\x97\xa8\x41\x9e\x5f\x35\xcc\xe1\x4f\x18\xb0\xdc\xac\x47\xb5\x39\xfc\xc2\x5b\x46\xc8\xf2\xc7\xa2\xc4\x51\x12\x67\xc7\xad\x2e\x78\x4b\x6b\x73\x48\xc6\xe3\x9e\xeb\xff\x28\xbf\x51\x9f\x64\xae\x9c\x1b\xd4\x24\x79\xe0\x88\xb5\x27\x56\xa1\x73\x9f\x47\xa1\xf4\x12\x4c\x9f\x49\xf2\x67\x46\xac\x52\xce\x8d\x21\x03\x6d\xea\x12\xb7\x5c\xe6\x98\xc1\x0f\xad\xeb\x85\xcc\x71\x02\x5c\x0a\x8d\x42\x67\xad\xd9\xa2\xfd\x5b\xa1\x6e\x58\xe9\xdc\x0e\xe6\x03\x00\x7f\x0b\x1d\x0a\xf7\x2c\xdc\xc3\x0c\x9b\xf4\xd1\x08\xbe\x90\x55\x85\x42\x7b\x26\x80\x4f\xc1\x85\xf7\xb2\x69\xbb\x7d\x3e\x2b\x54\xf5\x77\x99\xdf\x20\x28\x1b\x46\x40\xb1\xe2\x67\xbf\x1d\x87\x53\xdf\x83\xa5\xc2\xf0\xe3\xf3\xec\xa4\x30\x87\x24\xe9\x04\x22\x87\xa8\x2e\x8e\x10\xd6\xe6\x86\xd0\x17\x8b\x23\x08\xd9\xde\x42\xca\x4f\xc8\x2f\xcb\xcd\x7a\x2d\x2f\x28\x46\x84\xd7\x09\x7c\xdb\xc6\x98\xb8\xa1\x42\xdf\x5e\xb8\xac\x51\x39\xb7\x1b\x67\x40\xa8\x0d\x09\x18\xdd\x63\xfa\x3a\xfb\xd2\x4b\xa9\x03\x79\xbb\xa7\x38\x3e\x5d\xe1\x35\x00\x4e\x7b\xcc\xd7\x88\xac\xe5\x36\x6c\x78\x6d\x17\xdc\x79\x8a\xf4\xe0\x5d\xde\xaf\x42\xa8\x4c\xe9\x5f\x4e\x0f\xe0\x61\x36\xfb\x08\xc0\x3f\x90\xd8\x77\xff\xfa\x17\x00\x00\xff\xff\x5d\x37\x0f\xd5\x31\x03\x00\x00")
+var _templatesServer_resources_api_nimTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\xcf\x4f\xdb\x30\x14\xbe\xf7\xaf\x78\x2a\x3d\xb4\x53\x1b\x21\xb6\x53\xa4\x4a\xd3\x10\x08\x98\x60\x0c\x2a\x38\x20\x54\xb9\xce\x6b\x6b\x9a\xd8\xc9\xb3\x5d\x16\x45\xfe\xdf\x27\x3b\x49\x1b\x4a\x77\xdc\xad\x7e\xfd\xfc\xf9\xfb\xe1\xb8\xaa\x26\x90\xe0\x52\x48\x84\xbe\x46\xda\x22\xcd\x09\xb5\xb2\xc4\x51\xcf\x59\x2e\xe6\x52\x64\x7d\x98\x38\xd7\x13\x59\xae\xc8\xc0\x1b\x6a\x83\x34\x86\x8c\x91\x5e\xb3\x74\x0c\xba\xd4\x06\xb3\x9e\x27\x12\x4b\x50\x04\xd1\x15\xd3\xbf\x2d\x52\xf9\x68\x48\xc8\x55\x58\x5f\x2a\xca\x7e\xa8\xa4\x84\x3d\x91\x36\x64\x8d\x48\xf5\x18\x34\x16\xcd\x2f\x23\x32\xd4\x81\x0a\x65\xe2\xb1\x0d\xab\xa7\xb8\xb5\xa9\x11\x39\x23\x73\xc0\x63\xd8\x22\x3d\xdc\xe4\xb7\xdc\x21\x26\x37\xcf\x33\xe7\x1a\x9c\x62\xd6\xac\xcf\xe6\x6f\xef\xa6\xaa\x50\x26\x81\x1c\x88\xc9\x15\xc2\x60\x33\x86\xc1\x16\xe2\x29\x44\xd7\x01\xac\x3b\xfc\x55\x35\xd8\x3a\xd7\xee\x39\x81\x95\x9a\x10\xcb\xd2\x78\x81\x2b\x21\xa1\x06\xe9\xce\x1c\x65\xd2\x3b\x94\x90\xa2\x01\xf5\xf6\x6e\x60\x0a\xbf\x82\x8c\x9b\xe7\xd9\x30\xb7\x8b\x9f\x58\xc6\x84\x2c\xb9\x14\x29\x0e\xfb\x8d\xc2\xa6\x86\x0d\x96\x51\x6e\x17\xfd\xd1\xa8\x3d\xfb\xa8\xe0\x5b\x34\x6b\x95\x68\xe7\x7a\x39\x29\x1e\xd4\x36\xb3\x3b\x96\xa1\x73\x5f\x86\x61\xf4\x18\x48\xef\x49\xf1\x7b\x46\x2c\xd3\xce\x8d\x20\x06\x63\xf3\x14\x5f\xb8\x4a\x30\x86\x2b\x63\xf2\x73\x95\xe0\x18\xb8\x92\x06\xa5\x89\x6b\xb2\xf3\x7a\xf5\x80\x66\xcb\x52\xe7\x5e\x61\xda\x03\xf0\xbd\xb4\x52\xb8\xd7\xc2\xbd\x98\xc1\x36\xba\xb4\x92\x9f\xab\x2c\x43\x69\xbc\x26\x80\x93\xc0\xc2\xf7\x09\x36\xbb\x7d\x45\x0f\xa8\xf3\xb6\x4d\x80\x2d\x23\xa0\x66\xe2\xcf\xde\xfd\x1d\x78\xfc\x89\x98\x6a\x0c\x0b\x9f\x67\x0b\x85\x29\xf4\xfb\x2d\xa0\xbe\x00\x7e\x21\x96\x10\x6c\x73\x4b\xe8\x87\x62\x09\x52\xd5\x2d\x44\x7c\x8d\x7c\x73\xf3\x3c\x9b\xa9\x0d\xca\x21\x61\x31\x86\xef\x2f\x4d\x4c\xdc\x92\x30\xe5\x23\x57\x39\x6a\xe7\x5e\x47\x31\x10\x1a\x4b\x12\x86\xfb\x98\xbe\x9d\x7e\xed\xa4\xd4\x0a\xd9\xf5\xb4\x37\xd8\xfd\x0c\x76\x1e\x8b\xfd\x30\xd8\xec\x80\x02\xc6\x50\x19\xf7\x00\x3e\x86\x5c\xf8\x90\x0b\x1f\x72\x53\x65\xd8\x55\x77\x59\x5b\xf6\xf8\xc1\xb6\xd8\x07\x0d\x80\x7f\x38\xe6\x06\x9e\x58\x6a\xf1\x82\x48\x51\xcd\x7b\xcc\xd0\xe9\x31\x43\x47\x53\x8d\x1e\xb0\xf0\x76\x61\xf2\x79\x76\x1d\x3e\xf4\xe6\x9f\xba\xcf\xa2\x53\x67\xb1\x6b\xf3\xa8\xc7\xa5\xf7\xb8\xec\x78\xf4\x64\x9f\x2d\x2e\xff\x87\xc5\x54\x63\x23\xbb\xbe\x5b\x45\x73\xb5\x8c\x7a\xe9\x4a\x7f\xf5\xb7\x25\x5a\x1c\x0f\xa7\xb3\x38\x7c\x26\xbc\xee\xe8\x09\x69\xe1\x5c\xa8\x29\xba\x90\x49\xae\x84\x34\x01\x4d\xa8\x6d\xea\x1f\x87\x8e\xde\xb3\x7f\xea\xfd\xf8\xd4\x54\x55\x7b\x6a\x55\x01\xca\x04\x9c\xeb\xfd\x1d\x00\xf4\xb9\x08\x3d\xd5\x05\x00\x00")
 
 func templatesServer_resources_api_nimTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -827,7 +1039,7 @@ func templatesServer_resources_api_nimTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServer_resources_interfaceTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x52\xcd\x6e\x9b\x40\x10\x3e\xb3\x4f\xf1\x09\x71\x80\xc8\xc6\xf7\x4a\x3d\xf5\x47\xcd\xa1\x51\x14\x45\xed\x31\x5a\xc3\x60\x56\x81\x5d\xba\x0c\x38\xd6\x6a\xdf\xbd\x5a\xc0\x8e\xdd\x38\x6d\x7d\x9a\x81\xf1\xf7\x8b\x73\x6b\x94\x54\x29\x4d\x88\x2d\xf5\x66\xb0\x05\x3d\xa9\xea\x89\xa9\xed\x1a\xc9\x14\x63\xed\xbd\xe8\x64\xf1\x2c\x77\x04\xe7\xf2\xfb\x79\xbc\x93\x2d\x79\x2f\xc4\x66\xf3\x58\xab\x1e\x95\x6a\x08\xaa\x87\x1c\xd8\xac\x77\xa4\xc9\x4a\xa6\x12\xdb\x03\x76\x66\x6d\x65\xdb\x88\xcd\xe6\xb3\x81\x36\x0c\x2a\x15\x83\x4f\x7f\xda\x1e\x50\x4b\x5d\xa2\x57\xba\x20\x28\xc6\x5e\x35\x0d\xb6\x04\x33\x92\xdd\x5b\xc5\x4c\x1a\xe5\x60\x95\xde\x81\x6b\x82\xa6\x17\xc6\xc2\xa0\x8c\x16\x42\xb5\x9d\xb1\x8c\x54\x00\x80\x73\xb0\x52\xef\x08\xc9\xf3\x0a\xc9\x88\x0f\x1f\x91\xdf\x6a\x26\x5b\xc9\x82\x6e\xa7\xcb\x7b\xc9\x75\x3f\xb9\xc2\xf2\x8b\x9d\x4b\x46\xef\xe3\x23\x02\xe9\x72\x7a\x9f\x05\x7b\xc1\xf3\x6c\xf6\x84\x13\x8c\xaa\xd3\x52\x19\x1b\x6e\xbe\xe8\xb2\x33\x4a\xb3\xf7\xb0\x26\xd8\x5c\x76\xc1\x87\x8e\xae\x82\x1c\x27\x77\x12\x12\xca\x78\x23\xff\x3b\x71\x6d\xca\x4b\xc5\x93\x2c\x24\xe3\xf2\x72\x86\x0e\xb2\x42\x42\x21\xce\x86\xec\x22\x2c\x19\xf3\x1f\x64\xb7\xde\xcf\xf3\xab\x4c\x11\x01\xd1\x39\x63\x15\x28\xab\xc0\x99\x8c\xf9\xd7\x41\x17\x9f\x4c\xdb\x92\xe6\x7e\xb9\x9d\x48\x93\xb1\xf2\xde\x39\xd2\x65\x78\x1a\x4d\x98\xe7\x22\xd2\x9a\xb9\xcb\x1f\xa8\xef\x8c\xee\xe9\xa7\x55\x4c\x76\x85\x9b\xe5\xe9\xaf\x81\x7a\xce\x44\xb4\x84\xec\xbd\xf0\xef\x64\xfc\x60\x06\xa6\x3e\x58\xb2\x66\xe0\x50\xfe\xbf\x72\xae\x06\x5d\xbc\x0b\x94\x5a\xdc\xb4\xc3\x4b\x3e\x6d\x76\x05\x75\xe5\x32\x03\x9c\x88\xfe\xda\xc1\x59\x05\xe1\x4e\x55\x53\x07\xaa\x2c\x1b\xda\x4b\x4b\x17\x07\x36\xff\x36\x15\x91\xc6\x7f\x04\x1f\xaf\x20\x1b\x55\x50\x7e\x47\xfb\x74\x0e\xf0\x15\xc1\xfb\x2c\x7f\xac\x49\xcf\x39\xce\x08\x36\x94\x91\xaa\xfc\x4d\xd8\x59\x96\x1d\xa5\x2d\x2c\x73\xd5\x71\x76\x21\x93\x9a\x9e\x30\xd5\x75\xd4\x34\x01\x5e\xd1\x75\x8d\xe3\xbf\x18\xe6\x32\xa3\xb3\xd9\x8b\xe3\x12\x3e\xdc\xdf\x01\x00\x00\xff\xff\xd6\x21\x2f\x89\x67\x04\x00\x00")
+var _templatesServer_resources_interfaceTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x4d\x6f\xdb\x3c\x0c\x3e\x47\xbf\x82\x08\x7c\x68\x5f\xc4\xce\xfd\x05\x7a\xda\x07\x50\x60\x1b\x8a\xa2\xe8\x0e\xc3\x50\x28\x36\x65\x0b\xb5\x25\x8f\xa2\xdd\x05\x02\xff\xfb\x20\xdb\x4d\xd6\x25\x6d\x7d\x22\x29\x8a\x7c\x3e\xac\x18\x73\xa8\xd0\x58\x87\xb0\x26\x0c\x7e\xa0\x12\x1f\xac\x79\x60\xec\xfa\x56\x33\xae\x21\x17\x51\xbd\x2e\x1f\x75\x8d\x10\x63\x71\x33\x87\xdf\x74\x87\x22\x4a\x6d\xb7\x77\x8d\x0d\x60\x6c\x8b\x60\x03\xe8\x81\x7d\x5e\xa3\x43\xd2\x8c\x15\xec\xf6\x50\xfb\x9c\x74\xd7\xaa\xed\xf6\xa3\x07\xe7\x19\xb0\xb2\x0c\x7c\xb8\xb4\xdb\x43\xa3\x5d\x05\xc1\xba\x12\xc1\x32\x3c\xd9\xb6\x85\x1d\x82\x1f\x91\x9e\xc8\x32\xa3\x83\x6a\x20\xeb\x6a\xe0\x06\xc1\xe1\x6f\x86\x65\x83\xf5\x4e\x29\xdb\xf5\x9e\x18\x2e\x14\x00\x40\x8c\x40\xda\xd5\x08\xd9\xe3\x06\xb2\x11\xfe\xbf\x82\xe2\xda\x31\x92\xd1\x25\x5e\x4f\x9d\x37\x9a\x9b\x30\xb1\x82\xe5\x5b\xc7\x98\x8d\x22\xeb\xe7\x09\xe8\xaa\xe9\xfc\x32\xd1\x4b\x9c\x67\xb2\x87\x39\x89\xa8\x3d\x24\xc6\x53\xea\xf9\xe4\xaa\xde\x5b\xc7\x22\x40\x3e\xd1\x5c\x72\xc5\xfb\x1e\xcf\x0e\x79\x8e\xe2\x01\x48\x32\xe3\x04\xfe\x57\xe4\xc6\x57\x2f\x11\x4f\xb0\x20\x1b\x97\xc3\x19\x5f\x82\x95\x14\x4a\x72\xb6\x48\x0b\xb0\x6c\x2c\xee\x91\x76\x22\x73\x7c\x84\xa9\x56\x00\xab\xbf\x37\x9a\xb4\xd2\xa4\x9d\xd9\x58\x7c\x1e\x5c\xf9\xc1\x77\x1d\x3a\x0e\x4b\xef\xb4\x34\x1b\x8d\x48\x8c\xe8\xaa\x54\x5d\xc5\xf8\x0f\x88\x8b\x86\xb9\x2f\x6e\x31\xf4\xde\x05\xfc\x4e\x96\x91\x36\xf0\xdf\x52\xfd\x35\x60\xe0\x4b\xb5\x5a\x44\x16\x51\xf2\x8a\xc6\xb7\x7e\x60\x0c\x89\x12\xf9\x81\x93\xf9\xef\xe9\x6c\x06\x57\xbe\x3a\xe8\x62\xba\x3b\x0d\xa5\xbb\x7d\x8f\x22\x1b\xb0\x67\xba\x2f\x01\xa2\x5a\xbd\xe9\x43\xe2\x3d\xd1\x9e\xa6\xcd\x59\x7e\xa4\xf3\xde\xdd\x74\x6e\x4d\xf2\xee\x46\x93\xee\xc2\xbd\xa6\x54\x56\x8b\xba\xc7\xaa\x08\x68\xc2\xe9\x9f\xef\x53\x0d\x19\x29\x80\x37\x6f\x79\x0a\xa3\x6e\x6d\x35\x3f\x3d\x34\x9e\x10\x4a\xdd\xb6\x49\xbd\x13\xa3\xd4\xa8\xe9\x74\xe3\x15\xfc\xf8\x59\xfb\xf4\x60\xe7\xf2\x4b\x31\x96\xde\x2f\xc9\x54\xdd\x3e\x4b\x51\x88\x6c\xce\x88\xb0\x24\x67\xc2\x5c\x44\xfd\x19\x00\x4b\x41\x95\x08\x78\x04\x00\x00")
 
 func templatesServer_resources_interfaceTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -847,7 +1059,47 @@ func templatesServer_resources_interfaceTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesStructTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x55\xc1\x6e\xdb\x38\x10\x3d\x5b\x5f\xf1\x56\xf0\xc1\x32\x62\xf9\x1e\xc4\x39\xec\x66\x17\x30\xb0\x4d\x72\x48\x7b\x09\x82\x84\x95\x47\x09\x6b\x8a\x52\x48\xca\xa8\x41\xf0\xdf\x0b\x92\x92\x2d\xcb\x69\x8b\xea\x60\x88\xe4\x70\xe6\xbd\x37\x4f\x63\x6b\x37\x54\x72\x49\x48\xb5\x51\x6d\x61\x9e\x0d\x55\x8d\x60\x86\x52\xe7\x92\x86\x15\x5b\xf6\x4a\xb0\x36\xbf\x8f\xaf\xb7\xac\x22\xe7\x92\x84\x57\x4d\xad\x0c\x66\x09\x00\x58\x0b\xc5\xe4\x2b\x61\xba\xbd\xc0\x74\x87\xcb\x15\xf2\x75\x08\xb8\x67\xe6\x4d\x63\xe1\x5c\x88\xf3\x4f\x6a\x2d\xa6\x5b\x38\x97\xf6\x57\x49\x6e\x42\x44\x96\x24\xc7\x44\x31\xc9\x0d\xe9\x42\xf1\xc6\xf0\x5a\xc2\xb9\x64\xb9\x84\xb5\xd3\x9d\x73\xb0\x96\xe4\xc6\x39\x7f\x81\x97\xc8\xef\x24\xfd\xcf\x25\xdd\x50\x19\x32\x59\x7b\xb2\x15\x76\x16\x20\xa1\x29\x1c\x9b\x7d\xe3\x29\x21\xf7\x64\xe0\x1c\x22\x73\xd8\x31\x19\xda\x7b\x3a\x4c\xb4\x14\xd0\xfc\xc7\x49\x6c\x34\x06\x64\x3c\x1a\x7f\x9c\x47\x59\xfc\x06\x2f\x41\xef\xdd\xad\x7c\xad\xff\xa9\xab\xa6\xd6\x3c\x30\x28\x99\xd0\x14\xc0\x77\xc7\x0f\xfb\xc6\xaf\x5f\xbe\xe9\x5a\x5e\xa6\xd6\xfa\x8a\xce\x9d\xe5\xb8\xab\xb8\x31\xb4\x81\x51\x2d\x39\x77\x51\x57\xdc\xf7\xc8\xec\x3b\x11\xd2\x70\xa1\x8b\xfe\xc2\x04\xdf\x30\x53\x2b\xed\x1c\x76\x71\x41\x21\xf7\xf9\x79\xda\x25\x78\x39\xc8\x19\x39\x2d\x10\x57\x9d\x6c\xe1\xbd\x57\xfa\xb6\x36\x7f\x33\x45\x6b\x69\x48\x95\xac\xf0\x5e\x28\x5b\x59\x60\xa6\xbd\x49\xa2\x0c\x19\xba\x2a\x34\xcb\x40\x4a\xd5\xea\x20\xed\x72\x8e\xd2\xab\x08\x41\x3b\x12\x3d\x40\x2f\xce\x7c\x79\xa8\x7f\x6e\xa6\x53\xe5\x23\x94\xe9\x2e\xff\x2c\xf9\x7b\x4b\x6b\x43\xd5\xe1\xac\xf2\x4c\xfb\x76\x5c\xae\x50\xb1\xe6\x91\xf7\x60\xad\x7b\x8a\xad\xb6\xce\xc6\xf0\xb2\x56\x78\xbe\x40\xa8\x12\xab\xea\x7c\x98\xc1\x1e\x5a\x3d\x4c\xfc\xb8\x7b\xc2\x0a\xa3\x54\xf1\x97\x97\x10\x24\x67\xc3\xe8\x0c\x7f\xad\xc2\xe6\x49\xea\x6c\x90\x5b\x91\x69\x95\x44\x59\x99\xfc\x5f\x2f\x57\x39\x4b\x87\x20\xaa\x56\x1b\x7c\x25\xb4\x81\x6e\x9a\x0d\xca\xc5\xcf\xc7\x0d\x17\x47\x95\x96\x73\xcc\x07\x0f\x82\xef\xcf\x85\xef\x4e\x07\x0d\xf0\x8d\x7e\xc8\x3f\x71\x19\xa5\xed\x3f\xdf\x8e\x9b\xce\x70\xe5\x9b\x7d\x8c\x38\x11\xea\x03\x32\x82\x24\xf4\x5b\xdd\x8a\x8d\xa7\x71\xbd\xc2\xf8\xfa\x88\xd3\xe2\x84\x54\x87\x86\x7d\xff\x19\x9a\xeb\x2e\x5d\x17\xf1\x67\x68\xae\x56\xe3\xdb\xbf\x04\x83\x88\x66\xe4\xbc\xc9\x72\x89\x8a\x6d\x09\xba\x55\x04\x6e\xc0\x75\xd7\xad\x68\xca\xdf\x38\x71\xf2\x81\x0d\x61\x93\xc9\xa4\x3a\x73\xda\xc4\x25\x93\xde\x63\x47\x63\x65\x21\xfa\x03\xae\x45\x2d\x04\x15\xa1\xcf\x5c\x43\xd6\xe6\x68\xa2\xc9\x09\xc1\x13\xd7\x14\x4c\x08\xbc\xd6\x8b\x5d\x3f\x2c\xfa\xb1\x41\x9e\xbe\xcf\x32\x98\xae\x23\xdf\x8c\x46\xf1\xa0\x07\x92\x8b\x83\x4d\xfb\x51\x3c\x38\x3e\x2f\x36\xd3\xd9\xf8\x3f\x22\xcc\xf6\x0e\x6e\x3f\xb4\x7e\x04\x00\x00\xff\xff\xc3\xc1\x18\x45\xc2\x06\x00\x00")
+var _templatesServer_resources_typed_apiTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\x6d\x52\xd1\x4a\xc3\x30\x14\x7d\xb6\x5f\x71\x29\x45\x36\xd8\xba\xf7\x81\x0f\x32\x15\xf6\xa0\x94\x29\xbe\x8e\xb8\xde\xd8\xb2\x36\x29\x49\x3a\x90\x90\x7f\xf7\xde\xb4\x5d\x15\xcd\x4b\x93\x73\x0e\xe7\x9c\x9b\xc6\xfb\x35\x94\x28\x6b\x85\x90\x1a\xb4\xba\x37\x27\x3c\xba\xaf\x0e\xcb\xa3\xe8\xea\xa3\xc3\xb6\x6b\x84\xc3\x14\xd6\x21\x24\x9d\x38\x9d\xc5\x27\x82\xf7\x79\x31\x6c\x5f\x44\x8b\x44\x24\x75\xdb\x69\xe3\x60\x91\x00\x2d\xef\xc1\x08\x45\xba\xec\xbc\x82\xec\x02\xdb\x3b\xc8\xdf\xd8\xf2\xbe\xd8\xef\xa3\xb0\x10\xae\xb2\xd1\x12\xc6\x95\x7a\x9f\x5d\x42\x48\x27\x03\x54\x65\xe4\x97\x09\x11\xd4\x84\x83\xa2\xd1\x98\xb8\xd9\x70\x8b\xe1\x40\xb6\x50\x5b\x88\x1f\xaa\x8b\x2d\x2a\x27\x5c\xad\x15\x68\xc9\xaa\x47\x55\x76\xba\x56\x2e\x04\x30\x5a\x3b\xf6\x8e\xe7\x84\xe7\xfc\x6d\x63\x9d\xe9\x4f\x0e\x7c\x42\x33\xfd\x37\xc6\x33\xba\x4a\x97\x16\xa6\x06\xd9\x65\x84\x06\x0b\xae\xe1\x2a\x84\x4a\xa8\xb2\x41\x03\x52\x9b\x41\xf4\x8e\xe6\x83\xe8\xb8\x9f\xeb\x50\xc4\xfa\x9a\x21\x39\x44\x72\x0a\x69\x9e\x7a\x75\xda\xe9\x96\x27\xb1\x73\x96\x0c\xc1\x7b\x6a\x4f\x88\x24\x01\x2c\xe8\x62\x60\xbe\x9f\x38\xc2\xf2\x6f\xab\x45\x44\xe2\x1f\x28\x84\x11\x2d\x39\x8e\xaa\x88\x1d\xd0\xf5\x46\x59\xae\x97\xdc\x70\xa3\x5a\x72\x85\x03\xda\x8e\xf9\x38\x6c\xc4\x33\x7a\x20\xdd\x58\xf0\xca\xe6\x0f\x28\x45\xdf\xb8\xa8\x32\xd1\x6a\xf0\x9e\x15\x43\x0b\xff\x4a\x3f\xa5\xb7\xc4\xb1\x4d\xbe\xd3\x25\x81\x5b\xb8\x9d\x80\x51\x15\xc2\x0a\x54\xdd\x0c\x89\xd8\x58\xfc\x69\x3c\x13\xf4\x3c\x08\xe7\x1b\x9c\xf6\x13\xcc\xaf\xe6\x1b\x14\x9d\x33\x47\xd6\x02\x00\x00")
+
+func templatesServer_resources_typed_apiTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServer_resources_typed_apiTmpl,
+		"templates/server_resources_typed_api.tmpl",
+	)
+}
+
+func templatesServer_resources_typed_apiTmpl() (*asset, error) {
+	bytes, err := templatesServer_resources_typed_apiTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server_resources_typed_api.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServer_resources_typed_interfaceTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x96\x51\x6f\xdb\x36\x10\xc7\x9f\xa5\x4f\x71\x33\x8c\x4c\x1e\x14\xe5\x7d\x45\x1e\xd6\xb5\x45\x03\xa4\x45\xe6\x34\xd9\x80\xa2\x08\x18\xeb\x64\x73\x95\x49\xe5\x48\xc9\x0d\x08\x7e\xf7\xe1\x28\x2a\x76\x6c\xd7\xc9\x80\x06\xf5\x93\x44\xdd\xfd\xef\xee\x77\x47\x9a\xce\x1d\x43\x89\x95\x54\x08\x23\x42\xa3\x5b\x9a\xe1\x8d\xbd\x6f\xb0\xbc\x91\xd5\x8d\xc5\x65\x53\x0b\x8b\x23\x38\xf6\x3e\x6d\xc4\xec\xab\x98\x23\x38\x57\x5c\xf4\x8f\x1f\xc5\x12\xbd\x4f\xd3\x93\x93\x4f\x0b\x69\xa0\x92\x35\x82\x34\x20\x5a\xab\x8f\xe7\xa8\x90\x84\xc5\x12\x6e\xef\x61\xae\x8f\x49\x2c\xeb\xf4\xe4\xe4\x8d\x06\xa5\x2d\x60\x29\x2d\xd8\x07\xa7\xdb\x7b\x58\x08\x55\x82\x91\x6a\x86\x20\x2d\xac\x64\x5d\xc3\x2d\x82\xee\x90\x56\x24\xad\x45\x05\x65\x4b\x52\xcd\xc1\x2e\x10\x14\x7e\xb3\x10\x23\x48\xad\xd2\x54\x2e\x1b\x4d\x16\xb2\x14\x00\xc0\x39\x20\xa1\xe6\x08\xe3\xaf\x39\x8c\x3b\xf8\xfd\x14\x8a\x4f\x5c\xd3\x99\xb2\x48\x95\x98\xe1\x59\x30\xbf\x10\x76\x61\x42\x69\x10\x7f\x23\xe7\xc6\x9d\xf7\xa3\x41\x06\x55\x19\xbe\x4f\xb8\x46\x2e\xbc\xaf\xf8\x41\x87\xab\x95\x0f\x2f\x95\x26\xb6\x79\xab\xca\x46\x4b\x65\xbd\x07\xd2\x5c\x6b\x7c\x4f\x99\xeb\x5e\x91\xe1\xc9\xa5\x09\x37\x64\x27\xf9\x0f\x68\x17\xba\x34\xe0\x7d\x9a\x84\x3c\x60\xdc\xc5\xc5\x5e\x8b\xf3\x60\x2e\x0c\xb1\x46\x8a\x99\x8c\xbb\xe2\x1a\xe9\xd6\xfb\xfe\x79\x9d\xd7\xe3\x30\x15\xc7\xa9\x38\xd0\xb8\x2b\xde\xb5\x6a\xf6\xa7\x5e\x2e\x51\x59\xf3\x10\x6e\xdc\x55\xde\x3b\x87\xaa\xe4\x25\xe7\xb6\xa2\x67\x61\x25\x10\xbe\x10\x24\x96\xc6\xfb\x09\xac\xd7\xa6\x68\x5b\x52\x66\x08\xcb\x4c\xbd\x4f\xfd\x77\x90\x4e\x75\x6b\xd1\x70\x41\xa4\x5b\xcb\x0d\x7f\x0a\x6b\xd5\xaa\xd9\x77\x85\xb2\xe0\x1b\x44\x89\xb3\xf1\x3e\x07\xb9\xc7\x7a\x02\xf0\x1c\xfa\xa1\xaa\xa0\xb6\x53\xce\x53\xbe\xfc\x5d\x56\xdc\xb9\x1e\xd2\xb5\x20\x5e\x4e\x23\xe1\xf5\xaa\xf7\x20\x08\xc3\x9c\x37\xbc\x86\x16\xc9\x80\xae\x0e\x75\x14\x3a\x51\xcb\xb2\xdf\x6e\x58\x69\x42\x98\x89\xba\x66\x7a\x3b\xdd\x4a\x3b\x41\xbb\x11\x4f\xe1\xf3\x97\xb9\xe6\x4d\xda\x2f\x3f\x86\x11\x6d\xcf\xa5\x45\x12\xf5\x80\xa2\xf0\x3e\xdf\x03\x21\xbe\x3c\x7a\x3c\x48\x66\x20\xf0\xbe\x9f\xde\x38\xd2\x25\xce\x74\x89\x06\xf8\x5c\x18\xaa\xeb\xa7\x9c\xf0\xae\x45\x63\x9f\x40\x92\x33\x59\xa6\x60\x76\x19\x04\x51\x3e\x57\x78\xd2\xec\xaf\x06\x08\x4d\xa3\x95\xc1\x61\x98\xb6\xb3\xc9\x78\x66\xc6\xbb\x43\xb3\xb0\xb6\x19\x2c\x79\xeb\xf0\x10\x51\x98\x77\x60\xa5\x6c\xd5\x5b\x4c\xa3\xfc\xdf\x1c\x92\x72\x20\xf8\x2d\xae\x87\x4a\x26\xec\x96\xac\x07\xe4\x6a\x7a\x16\x78\x07\x3a\x09\x6f\xf9\x1e\x06\x5c\x4d\xcf\x36\x66\x22\x4d\x92\x4e\x90\xe1\x41\x73\x6e\xd3\x8d\x33\xf1\x3e\xa3\x49\x9a\x3c\xee\xe2\x96\x70\x3f\x09\x45\x3f\x01\xce\x85\x43\x32\x7c\x90\x15\x20\x11\x0b\xc7\x99\x78\x13\xe2\x0f\xee\xd9\xe8\x61\x03\x8d\x72\xe0\x1c\x3e\x6f\xac\x7c\xc9\xe1\x68\x50\x9d\xbc\x0a\x42\xbf\x9c\x82\x92\x75\xa8\x32\x89\x8a\x01\xc5\x5b\x22\x4d\xd9\x2a\xef\x29\x5d\x5a\x61\x5b\xf3\x5a\x94\x11\x4b\xce\xbe\x5c\x43\x44\x9a\x26\x89\x8f\x15\xc5\xc1\xe2\x97\xe1\x39\x7e\xe9\xb7\xd8\x5f\x2d\xd2\xfd\xa5\x0d\x7f\x18\x5b\x0c\x37\xe7\x09\xee\xd8\x0e\x4c\x30\x8c\x40\xee\x36\x5c\x9d\x7b\xac\x75\x08\xce\x86\x59\x46\xc5\xd5\xf4\xbc\x77\xcc\x26\x39\x1c\x6d\x68\xbe\x04\x91\x75\x46\x1b\x81\x8a\xeb\x58\x64\xf6\x32\x4d\xd8\xcb\xfd\x3d\x8a\x12\xc9\xf0\x20\x1d\xe4\xbe\xe8\xed\x22\xf2\xf8\x16\xf7\xdd\x5a\xe2\x10\xee\x18\x29\xa3\xe8\x90\xc3\x51\x94\x79\x59\xc2\x31\xc8\x4f\xa1\x3b\xc5\xbb\xd7\xba\xbc\x3f\x48\x36\x1e\x8e\x91\x2c\x45\x0f\xe7\xd6\xee\xde\xef\x13\x3d\x33\xef\x34\x2d\xe1\x10\x72\x36\xc8\x28\x87\xa3\xa8\xba\x5b\x79\xd8\x9a\xb5\xc1\x6d\x19\xe7\xb6\x62\xfd\xf3\xe1\xdc\xfb\x6f\xcb\xda\x39\xac\x0d\x7a\xff\xaf\xd1\x2a\x5e\x30\x8a\x8f\xb8\xea\x5b\x4c\x19\x15\x9c\xda\x24\xc6\xcf\x9e\x08\x3c\x9c\x09\x3f\xb2\xdd\x31\xe2\x4f\x6a\xb7\x69\x78\x1b\xc4\xd3\x9a\xff\xa3\xf2\x21\x2f\x59\x1c\xba\x86\xfd\x41\x73\xbe\x84\xad\x2b\xf9\x3f\xe9\x86\x3b\x91\x12\xf5\x25\x52\x87\x14\x2c\x9e\x81\xc9\x34\xbd\x62\xb6\x9a\xbc\xfa\xa1\x21\xfd\x81\xb9\x7a\x16\x85\x17\x4a\x27\x4e\x1b\xdf\x64\xd7\xfd\x1b\xbe\x1c\x7b\x9f\xfe\x37\x00\x0f\xbf\x0c\x27\x5c\x0d\x00\x00")
+
+func templatesServer_resources_typed_interfaceTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServer_resources_typed_interfaceTmpl,
+		"templates/server_resources_typed_interface.tmpl",
+	)
+}
+
+func templatesServer_resources_typed_interfaceTmpl() (*asset, error) {
+	bytes, err := templatesServer_resources_typed_interfaceTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server_resources_typed_interface.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStructTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x7f\x6f\xe3\xb8\x11\xfd\xdb\xfa\x14\x53\x21\x77\xb0\x02\xc7\xe9\xdf\xe9\xf9\x80\xf6\xd2\xa2\x29\xe2\xdd\xe0\x36\xbb\x58\x20\x08\x36\x8c\x34\xb2\xd9\x48\x94\x97\xa4\xed\x04\x5c\x7e\xf7\x62\x28\x4a\xa2\x64\xd9\xe9\x0f\xa0\x1b\x01\x6b\x91\x9c\x37\x6f\x66\x1e\x87\xb4\x8d\xc9\x30\xe7\x02\x21\x56\x5a\x6e\x53\xfd\x4d\x63\xb9\x29\x98\xc6\xd8\xda\x68\xc3\xd2\x17\xb6\x42\x30\x66\x7e\x57\x7f\xfc\xc0\x4a\xb4\x36\x8a\x78\xb9\xa9\xa4\x86\x69\x04\x00\x60\x0c\x48\x26\x56\x08\x67\x2f\x33\x38\xdb\xc1\xd5\x02\xe6\x37\x6e\xc1\x1d\xd3\x6b\x05\x17\xd6\xba\x75\xf4\xc4\xc6\xc0\xd9\x0b\x58\x1b\x37\xa6\x28\x32\xb7\x22\x89\xa2\x0e\xa8\x06\xb9\x46\x95\x4a\xbe\xd1\xbc\x12\x60\x6d\x74\x79\x09\xc6\x9c\xed\xac\x05\x63\x50\x64\xd6\x92\x01\xcf\x61\xfe\x51\xe0\x2d\x17\x78\x8d\xb9\x43\x32\xa6\x37\xe4\x46\x2e\x00\x0b\x85\x6e\xf5\x8d\xfa\xba\xbc\xbd\xae\xd2\x6d\x89\x42\x3b\x03\xfd\xb6\xa1\x20\x61\x4e\xe1\x81\xb5\x50\xe7\x02\x8c\xe3\xf8\x75\x79\xeb\xc6\x5f\xcb\xc2\x2d\x70\x83\xbf\x55\x42\x93\xb9\xd2\x92\x8b\x15\x3c\xbd\x96\xc5\x55\x3c\xe3\x42\xa0\x7c\x2d\x8b\xf8\x29\x0a\x9c\xbe\xef\x82\x96\x12\xb5\xc6\x55\x90\xb0\xa1\x77\x78\xfa\xa7\xaa\xc4\x55\x7c\x11\x83\xf3\x69\x4c\x63\x65\x6d\xfc\xd4\xa2\xd5\xf9\x69\xde\x9a\xf2\xe0\x1b\x15\x88\x15\x5b\x74\xf9\xfd\x1b\xc7\x22\x53\xa1\x37\xca\x2f\x4d\x3b\x9a\xd6\xd2\x00\xcf\x01\xbf\x7b\xab\xf9\x8d\xfa\xad\x2a\x37\x95\xe2\xae\x26\x39\x2b\x14\x5a\xdb\x59\xdd\xbf\x6d\xe8\xdd\x53\x34\x86\x3c\x5a\x7b\x80\xf1\xb1\xe4\x5a\x63\x06\x5a\x6e\xd1\xda\x59\x55\x72\x52\x9d\x7e\xf3\x65\x6d\x23\xf3\x06\x5f\x97\xb7\xf7\x6c\x65\x6d\xec\x80\xfc\xe0\x17\x56\xf0\x8c\xe9\x4a\x2a\x6b\x61\x57\xbf\x60\x60\x14\xce\xc7\x1e\xf8\xa9\x15\xce\x78\x9a\xa8\x04\x7f\xce\x32\x17\x1c\x2b\xee\x64\xb5\x41\xa9\x39\x2a\x0a\x2b\xcc\xd2\xd8\x1a\x28\xd9\xe6\xa1\x56\xc3\xa3\x31\x47\x61\xac\x1d\x16\xf0\xe2\xa0\x6a\x5e\x3a\xa1\xc2\xff\xce\xd4\x3f\x3e\x7d\xfc\xb0\x44\xbd\xae\xea\x8a\x35\x7c\x3d\xfc\xdb\x1d\xd3\x1a\xa5\x50\x7e\xa3\x6c\x9a\xd7\x2a\x07\xbd\x46\x10\xac\xc4\xf6\x65\xd3\xb1\x7e\xc1\x8d\x06\x2e\x68\x8b\xd7\x25\x1f\x65\x1e\xed\x98\xa4\x25\x43\x67\x5f\x98\xb4\x16\x16\xf0\xf0\x78\x2e\x71\x85\xaf\x9b\xf9\xef\xee\xbf\x4e\xd5\xb5\xf2\x46\x59\xd2\x12\x6f\xb5\xdc\x2a\x4d\xc2\xe2\x05\x4e\x8d\xd9\x48\x2e\x74\x0e\xf1\x4f\xdf\x63\x98\x5b\x9b\xcc\x46\xf2\x43\x1f\x7d\xa8\x9f\x45\xc9\xa4\x5a\xb3\x82\x32\x04\x19\xa6\x55\x86\xca\xc5\x99\x61\x5a\x30\x89\x59\x18\x70\x95\x77\xb1\x9e\x4a\xe2\xcc\x21\x54\x7a\x8d\x32\x30\xa7\xd4\xee\xd7\x95\xaa\x13\x0a\x25\xd3\xe9\x1a\x15\xb0\x26\xdf\xc0\x24\x7a\x0a\x19\x70\xa1\xab\x51\xad\x34\x2a\x6c\xdc\x7f\xd2\x92\xa7\x1a\xac\x9d\x13\xfe\x67\xf1\x22\xaa\xbd\x08\x49\x13\xaa\xa8\x34\xb0\xa2\xa8\xf6\x98\x35\xf6\xf9\x56\xa4\x30\x55\x70\xde\x46\x94\xf4\xb3\x31\x7d\x86\x87\xc7\xe7\x37\x8d\x09\xa0\x94\x95\xf4\xed\xc6\x35\x23\x56\x70\xa6\x82\x5c\x50\x8e\xa9\xcc\xac\x9e\x71\x0b\x69\xdf\x4a\x49\xbd\x82\x34\x3b\x6f\xb1\xa7\xcf\x33\xf8\x99\x25\x7f\x72\xb3\x7f\x58\x80\xe0\x85\x87\xa6\x47\xa2\xde\x4a\x41\x73\x6e\xa8\x83\xa6\x88\x7a\x1b\xc5\xa1\xfe\xce\xf6\x4b\x54\x8a\xad\xf0\x7d\x9f\x0e\xe1\x3f\xf2\x7b\xa2\xc4\x6e\x9e\x8d\x0a\x1e\x1c\xf6\x40\x77\xf4\x96\x57\xd2\x95\xde\x98\x31\x50\x92\x8d\x64\x7b\x5f\x1f\xca\x5b\xad\x7f\x47\x3b\x60\xda\x90\xba\xf6\x02\x25\xe5\x52\x19\x5a\x56\xf4\xa8\x3d\xd7\xe9\xda\x79\x0b\x4c\x53\xa6\xe8\xac\x3a\x34\xb5\xf6\xaa\x5d\x44\x4f\x5a\x09\xcd\xc5\x16\xdb\xc1\x0e\xb9\x1f\x52\x48\xe8\x58\x96\xe8\xaf\x16\x7b\x46\x51\xb9\xc6\xdf\x4e\x50\x4e\xbe\xcd\x40\x62\x17\xf0\xd1\x5e\xd1\x05\xe2\x6b\x2d\x71\xbe\x24\x60\xda\x03\x62\x35\xa5\x68\x93\x20\xdc\xa1\xf3\x85\x3b\x34\x0e\xa6\x9f\x25\xb2\x97\xde\xa8\x8d\x0e\x3f\xf1\xbc\xc5\xe9\x7b\x20\x71\xee\xe0\x64\xdb\xee\xad\x3f\xaa\x51\xc9\xf6\x33\xf8\x79\x77\x4c\xa1\x47\x94\x7a\xc8\xd4\x3b\x39\x26\xce\x63\xb8\x47\xc5\xfc\xef\x1e\x4e\xc6\x9e\x20\x34\x8e\xfe\x40\x25\x7b\x84\x05\xec\xfe\x77\xf9\xb5\x8d\x30\x1a\x64\x2a\x2f\xf5\xfc\xaf\xd4\xc1\xf2\x69\xbc\xed\xf7\xc7\x37\x78\xfa\x69\xf7\x14\xcf\xdc\x46\x49\x8e\x38\xa9\x5d\x9d\x2b\x58\x74\x1d\x6f\xca\x92\x28\x70\x41\xfb\xfd\xe4\x89\x10\x51\x73\x5e\x06\x47\x0d\x8a\xd3\x47\x0d\x13\x99\x3b\x44\xc6\xb2\xd6\x3f\x87\x9a\x46\xde\x8e\x24\xa1\xa3\x69\x02\xd3\xba\x8d\xcf\xea\x36\x9e\xbc\xd7\xc7\x9f\x67\x3d\x7d\x7a\xac\xa9\x6b\xec\x53\x95\x24\x61\xa3\xf5\x22\xfd\xf1\x03\x0a\x14\x53\x35\x5a\xe3\x84\x24\xf7\x47\x30\xc3\xb2\x3c\xcf\x06\xdd\xf6\xff\xd7\xe5\x05\x2f\x86\xce\x9b\xde\x3c\x83\x5d\xd7\x89\xc6\x03\x0a\xf1\xd8\x7e\x3c\x5b\xbb\x4e\x4c\x3c\x1f\xe7\x72\x8c\x4f\xc7\x89\xfe\x5c\x38\xed\x36\x91\x6c\x1f\x70\xf6\xd6\x3d\xc7\x6e\x7d\x32\xbc\x06\xfa\x0b\x4f\x73\x23\xfc\x50\xe9\xbf\x30\x89\x37\x42\xa3\xcc\x59\x7a\x44\x45\xfe\x16\x8c\xd3\xfe\x05\xc0\x98\xcb\x73\xc8\xe9\xf6\x0f\x05\xee\xb0\x68\x2e\xd0\x74\xa9\x3f\xbf\xb4\xf6\xe8\xd7\xba\xfe\x37\x86\x9a\xca\xd9\x6e\xfe\x59\xf0\xef\x5b\xbc\xd1\x58\xb6\x73\x25\xdd\xc4\x3d\x0f\x32\x25\x3d\xf0\x86\xac\xb1\x8f\xf5\xf7\x1f\xd3\x74\x1c\x7f\x86\xf4\x0a\x17\x22\x74\x39\x0f\x81\x1f\x76\xd4\x78\x06\x50\xb6\x11\x18\xe9\x39\x5c\x9d\x50\x01\x69\xb0\x07\x1d\x9e\x36\x23\xdd\x26\x58\x09\xe5\x56\x69\x78\x46\xd8\xba\x70\xe3\x24\x70\x67\x4c\xd0\x6d\xba\x6a\xb5\xc9\x3e\x0f\xfe\xd5\xfb\xf6\x30\xf1\x7e\x36\x28\x00\x15\xfa\x7e\xbe\xe4\xa2\x4e\xed\x85\xed\xc5\xa6\x12\xf8\x85\x36\x7e\xb7\xc2\xda\xd3\xc1\x14\x28\x40\xad\xab\x6d\x91\x51\x18\xbf\x2e\x60\x68\x3e\x88\x29\x6c\xa1\x2d\x1b\xf6\x7a\x8c\xcd\xaf\x1e\x8e\xbd\xfe\x17\x6c\x7e\x59\x0c\xad\x4f\x92\x21\xe5\xcd\xef\x87\xca\x9b\x5c\x5e\x42\xc9\x5e\x10\xd4\x56\x22\x70\x0d\x5c\xf9\x6a\x39\xb3\xf2\x1d\x25\x4e\x46\x64\x08\x26\x9a\x4c\xca\x03\xa5\x4d\x6c\x34\xf1\x91\x97\x9d\xb0\x48\x4c\x93\xc9\x48\xac\x69\x55\x14\x98\xba\x3a\x73\xe5\xee\xf2\xad\x88\x26\xbd\x00\x7b\xaa\x49\x59\x51\xc0\xaa\xba\xf0\x2a\xa9\xe4\xbc\xd9\xd0\x14\x3e\xa1\x04\xbf\x73\x0c\x74\x13\xcc\x34\x95\xf2\xbc\xba\x9b\x6d\xf7\xfb\x44\x30\x7d\xe8\x6c\xaa\x92\xe1\xaf\x35\x61\x4b\x32\x06\x45\x66\x6d\xf4\xaf\x01\x00\x08\x78\x8c\x44\x4c\x12\x00\x00")
 
 func templatesStructTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -976,7 +1228,11 @@ var _bindata = map[string]func() (*asset, error){
 	"templates/enum_go.tmpl": templatesEnum_goTmpl,
 	"templates/enum_nim.tmpl": templatesEnum_nimTmpl,
 	"templates/enum_python.tmpl": templatesEnum_pythonTmpl,
+	"templates/error_go.tmpl": templatesError_goTmpl,
+	"templates/file_go.tmpl": templatesFile_goTmpl,
+	"templates/form_go.tmpl": templatesForm_goTmpl,
 	"templates/generic_main.tmpl": templatesGeneric_mainTmpl,
+	"templates/header_go.tmpl": templatesHeader_goTmpl,
 	"templates/index.html.tmpl": templatesIndexHtmlTmpl,
 	"templates/init_py.tmpl": templatesInit_pyTmpl,
 	"templates/input_validators_python.tmpl": templatesInput_validators_pythonTmpl,
@@ -988,14 +1244,22 @@ var _bindata = map[string]func() (*asset, error){
 	"templates/oauth2_middleware.tmpl": templatesOauth2_middlewareTmpl,
 	"templates/oauth2_middleware_python.tmpl": templatesOauth2_middleware_pythonTmpl,
 	"templates/object_nim.tmpl": templatesObject_nimTmpl,
+	"templates/params_go.tmpl": templatesParams_goTmpl,
+	"templates/params_nim.tmpl": templatesParams_nimTmpl,
+	"templates/params_validator_python.tmpl": templatesParams_validator_pythonTmpl,
 	"templates/python_server_resource.tmpl": templatesPython_server_resourceTmpl,
+	"templates/query_string_go.tmpl": templatesQuery_string_goTmpl,
 	"templates/requirements_python.tmpl": templatesRequirements_pythonTmpl,
+	"templates/response_go.tmpl": templatesResponse_goTmpl,
+	"templates/router_go.tmpl": templatesRouter_goTmpl,
 	"templates/server_main_go.tmpl": templatesServer_main_goTmpl,
 	"templates/server_main_nim.tmpl": templatesServer_main_nimTmpl,
 	"templates/server_main_python.tmpl": templatesServer_main_pythonTmpl,
 	"templates/server_resources_api.tmpl": templatesServer_resources_apiTmpl,
 	"templates/server_resources_api_nim.tmpl": templatesServer_resources_api_nimTmpl,
 	"templates/server_resources_interface.tmpl": templatesServer_resources_interfaceTmpl,
+	"templates/server_resources_typed_api.tmpl": templatesServer_resources_typed_apiTmpl,
+	"templates/server_resources_typed_interface.tmpl": templatesServer_resources_typed_interfaceTmpl,
 	"templates/struct.tmpl": templatesStructTmpl,
 	"templates/struct_capnp.tmpl": templatesStruct_capnpTmpl,
 	"templates/struct_input_validator.tmpl": templatesStruct_input_validatorTmpl,
@@ -1059,7 +1323,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"enum_go.tmpl": &bintree{templatesEnum_goTmpl, map[string]*bintree{}},
 		"enum_nim.tmpl": &bintree{templatesEnum_nimTmpl, map[string]*bintree{}},
 		"enum_python.tmpl": &bintree{templatesEnum_pythonTmpl, map[string]*bintree{}},
+		"error_go.tmpl": &bintree{templatesError_goTmpl, map[string]*bintree{}},
+		"file_go.tmpl": &bintree{templatesFile_goTmpl, map[string]*bintree{}},
+		"form_go.tmpl": &bintree{templatesForm_goTmpl, map[string]*bintree{}},
 		"generic_main.tmpl": &bintree{templatesGeneric_mainTmpl, map[string]*bintree{}},
+		"header_go.tmpl": &bintree{templatesHeader_goTmpl, map[string]*bintree{}},
 		"index.html.tmpl": &bintree{templatesIndexHtmlTmpl, map[string]*bintree{}},
 		"init_py.tmpl": &bintree{templatesInit_pyTmpl, map[string]*bintree{}},
 		"input_validators_python.tmpl": &bintree{templatesInput_validators_pythonTmpl, map[string]*bintree{}},
@@ -1071,14 +1339,22 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"oauth2_middleware.tmpl": &bintree{templatesOauth2_middlewareTmpl, map[string]*bintree{}},
 		"oauth2_middleware_python.tmpl": &bintree{templatesOauth2_middleware_pythonTmpl, map[string]*bintree{}},
 		"object_nim.tmpl": &bintree{templatesObject_nimTmpl, map[string]*bintree{}},
+		"params_go.tmpl": &bintree{templatesParams_goTmpl, map[string]*bintree{}},
+		"params_nim.tmpl": &bintree{templatesParams_nimTmpl, map[string]*bintree{}},
+		"params_validator_python.tmpl": &bintree{templatesParams_validator_pythonTmpl, map[string]*bintree{}},
 		"python_server_resource.tmpl": &bintree{templatesPython_server_resourceTmpl, map[string]*bintree{}},
+		"query_string_go.tmpl": &bintree{templatesQuery_string_goTmpl, map[string]*bintree{}},
 		"requirements_python.tmpl": &bintree{templatesRequirements_pythonTmpl, map[string]*bintree{}},
+		"response_go.tmpl": &bintree{templatesResponse_goTmpl, map[string]*bintree{}},
+		"router_go.tmpl": &bintree{templatesRouter_goTmpl, map[string]*bintree{}},
 		"server_main_go.tmpl": &bintree{templatesServer_main_goTmpl, map[string]*bintree{}},
 		"server_main_nim.tmpl": &bintree{templatesServer_main_nimTmpl, map[string]*bintree{}},
 		"server_main_python.tmpl": &bintree{templatesServer_main_pythonTmpl, map[string]*bintree{}},
 		"server_resources_api.tmpl": &bintree{templatesServer_resources_apiTmpl, map[string]*bintree{}},
 		"server_resources_api_nim.tmpl": &bintree{templatesServer_resources_api_nimTmpl, map[string]*bintree{}},
 		"server_resources_interface.tmpl": &bintree{templatesServer_resources_interfaceTmpl, map[string]*bintree{}},
+		"server_resources_typed_api.tmpl": &bintree{templatesServer_resources_typed_apiTmpl, map[string]*bintree{}},
+		"server_resources_typed_interface.tmpl": &bintree{templatesServer_resources_typed_interfaceTmpl, map[string]*bintree{}},
 		"struct.tmpl": &bintree{templatesStructTmpl, map[string]*bintree{}},
 		"struct_capnp.tmpl": &bintree{templatesStruct_capnpTmpl, map[string]*bintree{}},
 		"struct_input_validator.tmpl": &bintree{templatesStruct_input_validatorTmpl, map[string]*bintree{}},
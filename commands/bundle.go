@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// BundleCommand is executed to write a RAML specification as a single self contained file
+type BundleCommand struct {
+	RamlFile string //raml file
+	Output   string //output file, default to stdout
+}
+
+// Execute parses the RAML specification and writes the bundle,
+// with the resource types, traits and libraries resolved.
+func (command *BundleCommand) Execute() error {
+	log.Debugf("Bundling %v", command.RamlFile)
+
+	apiDef := new(raml.APIDefinition)
+	if err := raml.ParseFile(command.RamlFile, apiDef); err != nil {
+		return err
+	}
+
+	b, err := raml.Bundle(apiDef)
+	if err != nil {
+		return err
+	}
+
+	if command.Output == "" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+	return ioutil.WriteFile(command.Output, b, 0644)
+}
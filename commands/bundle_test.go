@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBundle(t *testing.T) {
+	Convey("bundle command", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		Convey("specification with libraries", func() {
+			cmd := BundleCommand{
+				RamlFile: "../raml/samples/bundle/api.raml",
+				Output:   filepath.Join(targetDir, "api.raml"),
+			}
+			So(cmd.Execute(), ShouldBeNil)
+
+			b, err := ioutil.ReadFile(cmd.Output)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldNotContainSubstring, "uses:")
+
+			apiDef := new(raml.APIDefinition)
+			So(raml.ParseFile(cmd.Output, apiDef), ShouldBeNil)
+			So(raml.HasErrors(raml.Validate(apiDef)), ShouldBeFalse)
+			So(apiDef.Types, ShouldContainKey, "common_Id")
+		})
+
+		Convey("invalid specification", func() {
+			cmd := BundleCommand{
+				RamlFile: "../raml/samples/not_exist.raml",
+				Output:   filepath.Join(targetDir, "api.raml"),
+			}
+			So(cmd.Execute(), ShouldNotBeNil)
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
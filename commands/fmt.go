@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"io/ioutil"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// FmtCommand is executed to rewrite a RAML file in the canonical form
+type FmtCommand struct {
+	RamlFile string //raml file, an API definition or a library
+	Output   string //output file, default to the raml file itself
+}
+
+// Execute formats the RAML file and writes the result
+// to the output file or back to the RAML file.
+func (command *FmtCommand) Execute() error {
+	log.Debugf("Formatting %v", command.RamlFile)
+
+	b, err := raml.FormatFile(command.RamlFile)
+	if err != nil {
+		return err
+	}
+
+	output := command.Output
+	if output == "" {
+		output = command.RamlFile
+	}
+	return ioutil.WriteFile(output, b, 0644)
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFmt(t *testing.T) {
+	Convey("fmt command", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		Convey("rewrite in place", func() {
+			b, err := ioutil.ReadFile("../raml/samples/type_expressions.raml")
+			So(err, ShouldBeNil)
+			ramlFile := filepath.Join(targetDir, "api.raml")
+			So(ioutil.WriteFile(ramlFile, b, 0644), ShouldBeNil)
+
+			cmd := FmtCommand{RamlFile: ramlFile}
+			So(cmd.Execute(), ShouldBeNil)
+			formatted, err := ioutil.ReadFile(ramlFile)
+			So(err, ShouldBeNil)
+
+			// formatting a formatted file doesn't change it
+			So(cmd.Execute(), ShouldBeNil)
+			reformatted, err := ioutil.ReadFile(ramlFile)
+			So(err, ShouldBeNil)
+			So(string(reformatted), ShouldEqual, string(formatted))
+		})
+
+		Convey("output file", func() {
+			cmd := FmtCommand{
+				RamlFile: "../raml/samples/libraries/files.raml",
+				Output:   filepath.Join(targetDir, "files.raml"),
+			}
+			So(cmd.Execute(), ShouldBeNil)
+			_, err := os.Stat(cmd.Output)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("overlay", func() {
+			cmd := FmtCommand{
+				RamlFile: "../raml/samples/overlays/overlay.raml",
+				Output:   filepath.Join(targetDir, "overlay.raml"),
+			}
+			So(cmd.Execute(), ShouldNotBeNil)
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"net/http"
+
+	"github.com/Jumpscale/go-raml/mock"
+	"github.com/Jumpscale/go-raml/raml"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// MockCommand is executed to serve a mock server of a RAML specification
+type MockCommand struct {
+	RamlFile string //raml file
+	Address  string //address the mock server listens on, e.g. :5000
+}
+
+// Execute serves the mock server of a RAML specification,
+// the examples which don't match their type are reported as warnings
+func (command *MockCommand) Execute() error {
+	apiDef := new(raml.APIDefinition)
+	if err := raml.ParseFile(command.RamlFile, apiDef); err != nil {
+		return err
+	}
+	for _, d := range raml.ValidateExamples(apiDef) {
+		log.Warn(d)
+	}
+
+	log.Infof("Serving the mock server of %v on %v", command.RamlFile, command.Address)
+	return http.ListenAndServe(command.Address, mock.New(apiDef))
+}
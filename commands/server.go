@@ -15,6 +15,9 @@ type ServerCommand struct {
 	NoMainGeneration bool   //do not generate a main.go file
 	ImportPath       string // root import path of the code, such as : github.com/jumpscale/restapi
 	NoAPIDocs        bool   // do not generate API Docs in /apidocs/ endpoint
+	TypedHandlers    bool   // generate typed handler interfaces, only for Go
+	Router           string // router of the server: gorilla, stdlib, chi or echo, only for Go
+	PreserveRegions  bool   // keep the hand written code of the existing files
 }
 
 // Execute generates a Go server from an RAML specification
@@ -28,5 +31,6 @@ func (command *ServerCommand) Execute() error {
 	}
 
 	return codegen.GenerateServer(command.RamlFile, command.Dir, command.PackageName,
-		command.Language, apiDocsDir, command.ImportPath, !command.NoMainGeneration)
+		command.Language, apiDocsDir, command.ImportPath, !command.NoMainGeneration, command.TypedHandlers, command.Router,
+		command.PreserveRegions)
 }
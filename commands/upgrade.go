@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// UpgradeCommand is executed to upgrade a RAML 0.8 specification to RAML 1.0
+type UpgradeCommand struct {
+	RamlFile string //raml file
+	Output   string //output file, default to stdout
+}
+
+// Execute converts the RAML 0.8 specification and writes
+// the equivalent RAML 1.0 specification.
+func (command *UpgradeCommand) Execute() error {
+	log.Debugf("Upgrading %v", command.RamlFile)
+
+	b, err := raml.UpgradeFile(command.RamlFile)
+	if err != nil {
+		return err
+	}
+
+	if command.Output == "" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+	return ioutil.WriteFile(command.Output, b, 0644)
+}
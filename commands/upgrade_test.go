@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUpgrade(t *testing.T) {
+	Convey("upgrade command", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		Convey("RAML 0.8 specification", func() {
+			cmd := UpgradeCommand{
+				RamlFile: "../raml/samples/raml08/api.raml",
+				Output:   filepath.Join(targetDir, "api.raml"),
+			}
+			So(cmd.Execute(), ShouldBeNil)
+
+			b, err := ioutil.ReadFile(cmd.Output)
+			So(err, ShouldBeNil)
+			So(strings.HasPrefix(string(b), "#%RAML 1.0\n"), ShouldBeTrue)
+
+			apiDef := new(raml.APIDefinition)
+			So(raml.ParseFile(cmd.Output, apiDef), ShouldBeNil)
+			So(raml.HasErrors(raml.Validate(apiDef)), ShouldBeFalse)
+		})
+
+		Convey("RAML 1.0 specification", func() {
+			cmd := UpgradeCommand{
+				RamlFile: "../raml/samples/validate/valid.raml",
+				Output:   filepath.Join(targetDir, "api.raml"),
+			}
+			So(cmd.Execute(), ShouldNotBeNil)
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
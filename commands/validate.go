@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// ErrInvalidSpec is returned when the RAML specification is invalid
+var ErrInvalidSpec = errors.New("invalid RAML specification")
+
+// ValidateCommand is executed to validate a RAML specification
+type ValidateCommand struct {
+	RamlFile string    //raml file
+	Strict   bool      //treat warnings as errors
+	Output   io.Writer //where the problems are printed, default to stdout
+}
+
+// Execute validates a RAML specification and prints all problems found.
+// It returns ErrInvalidSpec if the specification can't be parsed or has errors.
+func (command *ValidateCommand) Execute() error {
+	log.Debugf("Validating %v", command.RamlFile)
+	out := command.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
+	apiDef := new(raml.APIDefinition)
+	if err := raml.ParseFile(command.RamlFile, apiDef); err != nil {
+		ramlErr, ok := err.(*raml.Error)
+		if !ok {
+			return err
+		}
+		for _, ed := range ramlErr.Errors {
+			fmt.Fprintf(out, "%v: error: %v\n", ed.Position, ed.Message)
+		}
+		return ErrInvalidSpec
+	}
+
+	diags := raml.Validate(apiDef)
+	for _, d := range diags {
+		fmt.Fprintln(out, d)
+	}
+	if raml.HasErrors(diags) || (command.Strict && len(diags) > 0) {
+		return ErrInvalidSpec
+	}
+	return nil
+}
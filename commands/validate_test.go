@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidate(t *testing.T) {
+	Convey("validate command", t, func() {
+		var out bytes.Buffer
+
+		Convey("valid specification", func() {
+			cmd := ValidateCommand{
+				RamlFile: "../raml/samples/validate/valid.raml",
+				Output:   &out,
+			}
+			So(cmd.Execute(), ShouldBeNil)
+			So(out.String(), ShouldBeEmpty)
+		})
+
+		Convey("invalid specification", func() {
+			cmd := ValidateCommand{
+				RamlFile: "../raml/samples/validate/invalid.raml",
+				Output:   &out,
+			}
+			So(cmd.Execute(), ShouldEqual, ErrInvalidSpec)
+			So(out.String(), ShouldContainSubstring, "invalid.raml:7:5: error: undefined type `Address`\n")
+		})
+
+		Convey("parse error", func() {
+			cmd := ValidateCommand{
+				RamlFile: "../raml/samples/bad_raml.raml",
+				Output:   &out,
+			}
+			So(cmd.Execute(), ShouldEqual, ErrInvalidSpec)
+			So(out.String(), ShouldContainSubstring, "bad_raml.raml:44:16: error: ")
+		})
+
+		Convey("warnings in strict mode", func() {
+			cmd := ValidateCommand{
+				RamlFile: "../codegen/fixtures/server_resources/deliveries.raml",
+				Output:   &out,
+			}
+			So(cmd.Execute(), ShouldBeNil)
+			So(out.String(), ShouldContainSubstring, "warning: ")
+
+			cmd.Strict = true
+			So(cmd.Execute(), ShouldEqual, ErrInvalidSpec)
+		})
+	})
+}
@@ -17,11 +17,16 @@ var Version = "0.1-Dev"
 var ApplicationName = "RAML code generation toolset"
 
 var (
-	serverCommand = &commands.ServerCommand{}
-	clientCommand = &commands.ClientCommand{}
-	capnpCommand  = &commands.CapnpCommand{}
-	specCommand   = &commands.SpecCommand{}
-	docsCommand   = &commands.DocsCommand{}
+	serverCommand   = &commands.ServerCommand{}
+	clientCommand   = &commands.ClientCommand{}
+	capnpCommand    = &commands.CapnpCommand{}
+	specCommand     = &commands.SpecCommand{}
+	docsCommand     = &commands.DocsCommand{}
+	validateCommand = &commands.ValidateCommand{}
+	upgradeCommand  = &commands.UpgradeCommand{}
+	fmtCommand      = &commands.FmtCommand{}
+	bundleCommand   = &commands.BundleCommand{}
+	mockCommand     = &commands.MockCommand{}
 )
 
 func main() {
@@ -92,6 +97,22 @@ func main() {
 					Usage:       "import path of the generated code",
 					Destination: &serverCommand.ImportPath,
 				},
+				cli.BoolFlag{
+					Name:        "typed-handlers",
+					Usage:       "Generate typed handler interfaces, only for Go",
+					Destination: &serverCommand.TypedHandlers,
+				},
+				cli.StringFlag{
+					Name:        "router",
+					Value:       "gorilla",
+					Usage:       "Router of the server: gorilla, stdlib, chi or echo, only for Go",
+					Destination: &serverCommand.Router,
+				},
+				cli.BoolFlag{
+					Name:        "preserve-regions",
+					Usage:       "Keep the hand written code of the existing files, see Regenerating a server",
+					Destination: &serverCommand.PreserveRegions,
+				},
 			},
 			Action: func(c *cli.Context) {
 				if err := serverCommand.Execute(); err != nil {
@@ -169,6 +190,124 @@ func main() {
 				}
 			},
 		},
+		{
+			Name:  "validate",
+			Usage: "Validate a RAML specification, exit with status 1 if it is invalid",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "ramlfile",
+					Value:       ".",
+					Usage:       "Source raml file",
+					Destination: &validateCommand.RamlFile,
+				},
+				cli.BoolFlag{
+					Name:        "strict",
+					Usage:       "Treat warnings as errors",
+					Destination: &validateCommand.Strict,
+				},
+			},
+			Action: func(c *cli.Context) {
+				if err := validateCommand.Execute(); err != nil {
+					if err != commands.ErrInvalidSpec {
+						log.Error(err)
+					}
+					os.Exit(1)
+				}
+			},
+		},
+		{
+			Name:  "upgrade",
+			Usage: "Upgrade a RAML 0.8 specification to RAML 1.0",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "ramlfile",
+					Value:       ".",
+					Usage:       "Source RAML 0.8 file",
+					Destination: &upgradeCommand.RamlFile,
+				},
+				cli.StringFlag{
+					Name:        "output",
+					Usage:       "Destination file, the result is printed to stdout if empty",
+					Destination: &upgradeCommand.Output,
+				},
+			},
+			Action: func(c *cli.Context) {
+				if err := upgradeCommand.Execute(); err != nil {
+					log.Error(err)
+					os.Exit(1)
+				}
+			},
+		},
+		{
+			Name:  "fmt",
+			Usage: "Rewrite a RAML API definition or library in the canonical form",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "ramlfile",
+					Value:       ".",
+					Usage:       "Source raml file",
+					Destination: &fmtCommand.RamlFile,
+				},
+				cli.StringFlag{
+					Name:        "output",
+					Usage:       "Destination file, the source file is rewritten if empty",
+					Destination: &fmtCommand.Output,
+				},
+			},
+			Action: func(c *cli.Context) {
+				if err := fmtCommand.Execute(); err != nil {
+					log.Error(err)
+					os.Exit(1)
+				}
+			},
+		},
+		{
+			Name:  "bundle",
+			Usage: "Write a RAML specification as a single self contained file",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "ramlfile",
+					Value:       ".",
+					Usage:       "Source raml file",
+					Destination: &bundleCommand.RamlFile,
+				},
+				cli.StringFlag{
+					Name:        "output",
+					Usage:       "Destination file, the result is printed to stdout if empty",
+					Destination: &bundleCommand.Output,
+				},
+			},
+			Action: func(c *cli.Context) {
+				if err := bundleCommand.Execute(); err != nil {
+					log.Error(err)
+					os.Exit(1)
+				}
+			},
+		},
+		{
+			Name:  "mock",
+			Usage: "Serve a mock server of a RAML specification, which responds with the examples",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "ramlfile",
+					Value:       ".",
+					Usage:       "Source raml file",
+					Destination: &mockCommand.RamlFile,
+				},
+				cli.StringFlag{
+					Name:        "address",
+					Value:       ":5000",
+					Usage:       "Address the mock server listens on",
+					Destination: &mockCommand.Address,
+				},
+			},
+			Action: func(c *cli.Context) {
+				if err := mockCommand.Execute(); err != nil {
+					log.Error(err)
+					os.Exit(1)
+				}
+			},
+		},
 		{
 			Name:  "spec",
 			Usage: "Generate a RAML specification from a go server",
@@ -0,0 +1,319 @@
+// Package mock serves an API from it's RAML specification, without generating code.
+//
+// Each method responds with the example of the body of a declared response,
+// or with a value synthesized from the type of the body and it's facets.
+// The requests are validated against the specification,
+// and the status code of the response is selected by the X-Mock-Status header
+// or the mock-status query parameter.
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/codegen/resource"
+	"github.com/Jumpscale/go-raml/raml"
+
+	"github.com/gorilla/mux"
+)
+
+// switches of the status code of the response
+const (
+	StatusHeader = "X-Mock-Status"
+	StatusQuery  = "mock-status"
+)
+
+// Error is the payload of the error responses of the mock server,
+// the errors are the violations of the constraints of the request
+type Error struct {
+	Message string      `json:"error"`
+	Errors  []Violation `json:"errors,omitempty"`
+}
+
+// Violation is a constraint of the request which isn't satisfied,
+// it is in the URI, the query string, the headers or the body
+type Violation struct {
+	In      string `json:"in"`
+	Name    string `json:"name,omitempty"`
+	Message string `json:"message"`
+}
+
+// Server is the mock server of an API definition
+type Server struct {
+	apiDef *raml.APIDefinition
+	router *mux.Router
+}
+
+// New creates the mock server of an API definition
+func New(apiDef *raml.APIDefinition) *Server {
+	s := &Server{
+		apiDef: apiDef,
+		router: mux.NewRouter(),
+	}
+
+	var methods []method
+	for uri := range apiDef.Resources {
+		r := apiDef.Resources[uri]
+		methods = append(methods, resourceMethods(&r)...)
+	}
+
+	// the literal path segments are routed before the URI parameters,
+	// e.g. /users/me before /users/{id}
+	sort.Sort(byRoute(methods))
+	for _, m := range methods {
+		s.router.Handle(m.endpoint, s.methodHandler(m)).Methods(m.Name)
+	}
+	return s
+}
+
+// ServeHTTP serves a request by the method of it's resource.
+// The cross-origin requests are allowed,
+// so the mock server can be used by an application served on another origin.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" { // preflight request
+		w.Header().Set("Access-Control-Allow-Methods", r.Header.Get("Access-Control-Request-Method"))
+		if headers := r.Header.Get("Access-Control-Request-Headers"); headers != "" {
+			w.Header().Set("Access-Control-Allow-Headers", headers)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	s.router.ServeHTTP(w, r)
+}
+
+// method is a method of a resource served by the mock server
+type method struct {
+	*raml.Method
+	endpoint string
+	params   []resource.RequestParam
+}
+
+// resourceMethods returns the methods of a resource and of it's nested resources
+func resourceMethods(r *raml.Resource) []method {
+	var methods []method
+	for _, m := range r.Methods {
+		rm := resource.Method{Method: m, RAMLResource: r}
+		methods = append(methods, method{
+			Method:   m,
+			endpoint: r.FullURI(),
+			params:   rm.RequestParams(),
+		})
+	}
+	for _, n := range r.Nested {
+		methods = append(methods, resourceMethods(n)...)
+	}
+	return methods
+}
+
+// byRoute sorts the methods by endpoint, the URI parameters are sorted after the other characters
+type byRoute []method
+
+func (b byRoute) Len() int      { return len(b) }
+func (b byRoute) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byRoute) Less(i, j int) bool {
+	ei := strings.Replace(b[i].endpoint, "{", "\xff", -1)
+	ej := strings.Replace(b[j].endpoint, "{", "\xff", -1)
+	if ei != ej {
+		return ei < ej
+	}
+	return b[i].Name < b[j].Name
+}
+
+// methodHandler returns the handler of the requests of a method,
+// which validates the request and writes the response of the selected status code
+func (s *Server) methodHandler(m method) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if violations := checkParams(r, m.params); len(violations) > 0 {
+			writeError(w, http.StatusBadRequest, Error{Message: "invalid request parameters", Errors: violations})
+			return
+		}
+		if err := s.checkBody(r, m.Bodies); err != nil {
+			writeError(w, http.StatusBadRequest, Error{
+				Message: "invalid request body",
+				Errors:  []Violation{{In: "body", Message: err.Error()}},
+			})
+			return
+		}
+
+		code, err := responseCode(r, m.Responses)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, Error{Message: err.Error()})
+			return
+		}
+		s.writeResponse(w, r, code, m.Responses[raml.HTTPCode(strconv.Itoa(code))])
+	}
+}
+
+// checkParams returns the violations of the constraints of the URI, query and header parameters of a request
+func checkParams(r *http.Request, params []resource.RequestParam) []Violation {
+	vars := mux.Vars(r)
+	var violations []Violation
+	for _, p := range params {
+		var values []string
+		switch p.In {
+		case resource.ParamInURI:
+			if v, ok := vars[p.Name]; ok {
+				values = []string{v}
+			}
+		case resource.ParamInQuery:
+			values = r.URL.Query()[p.Name]
+		case resource.ParamInHeader:
+			values = r.Header[http.CanonicalHeaderKey(p.Name)]
+		}
+		if !p.Array && len(values) > 1 {
+			values = values[:1]
+		}
+		if len(values) == 0 {
+			if p.Required {
+				violations = append(violations, Violation{In: p.In, Name: p.Name, Message: "is required"})
+			}
+			continue
+		}
+		for _, v := range values {
+			for _, msg := range p.Check(v) {
+				violations = append(violations, Violation{In: p.In, Name: p.Name, Message: msg})
+			}
+		}
+	}
+	return violations
+}
+
+// checkBody checks the JSON body of a request against the declared body,
+// the bodies of the other media types and the empty bodies aren't checked
+func (s *Server) checkBody(r *http.Request, bodies raml.Bodies) error {
+	b := jsonBody(bodies)
+	if b == nil {
+		return nil
+	}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return nil
+	}
+	return s.apiDef.CheckJSONBody(b.Type, b.Properties, data)
+}
+
+// responseCode returns the status code of the response of a request.
+// It is the code selected by the X-Mock-Status header or the mock-status query parameter,
+// which must be declared, or the first declared 2xx code.
+func responseCode(r *http.Request, responses map[raml.HTTPCode]raml.Response) (int, error) {
+	var codes []int
+	for code := range responses {
+		if c, err := strconv.Atoi(string(code)); err == nil {
+			codes = append(codes, c)
+		}
+	}
+	sort.Ints(codes)
+
+	selected := r.Header.Get(StatusHeader)
+	if selected == "" {
+		selected = r.URL.Query().Get(StatusQuery)
+	}
+	if selected != "" {
+		for _, c := range codes {
+			if strconv.Itoa(c) == selected {
+				return c, nil
+			}
+		}
+		return 0, fmt.Errorf("status code %v is not declared, the declared status codes are %v", selected, codes)
+	}
+
+	for _, c := range codes {
+		if c >= 200 && c < 300 {
+			return c, nil
+		}
+	}
+	if len(codes) > 0 {
+		return codes[0], nil
+	}
+	return http.StatusOK, nil
+}
+
+// writeResponse writes the headers, the status code and the body of a response,
+// the body is of the media type accepted by the request, JSON by default
+func (s *Server) writeResponse(w http.ResponseWriter, r *http.Request, code int, resp raml.Response) {
+	for name, h := range resp.Headers {
+		if val := s.apiDef.SampleParameterValue(raml.NamedParameter(h)); val != nil {
+			w.Header().Set(string(name), fmt.Sprint(val))
+		}
+	}
+
+	mediaType := responseMediaType(r, resp.Bodies)
+	switch {
+	case mediaType == "":
+		w.WriteHeader(code)
+	case mediaType == raml.MediaTypeJSON:
+		b := jsonBody(resp.Bodies)
+		val := s.apiDef.SampleBodyValue(b.Type, b.Properties, b.Example, b.Examples)
+		w.Header().Set("Content-Type", mediaType)
+		w.WriteHeader(code)
+		if val != nil {
+			json.NewEncoder(w).Encode(val)
+		}
+	default: // only the examples of the other media types are written
+		w.Header().Set("Content-Type", mediaType)
+		w.WriteHeader(code)
+		w.Write([]byte(resp.Bodies.ByMediaType()[mediaType].Example))
+	}
+}
+
+// responseMediaType returns the media type of the body of a response,
+// which is the first declared media type accepted by the request, or JSON if it is declared.
+// It returns an empty string if the response has no body.
+func responseMediaType(r *http.Request, bodies raml.Bodies) string {
+	var mediaTypes []string
+	for mediaType := range bodies.ByMediaType() {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	if len(mediaTypes) == 0 {
+		if jsonBody(bodies) != nil {
+			return raml.MediaTypeJSON
+		}
+		return ""
+	}
+	sort.Strings(mediaTypes)
+
+	accept := r.Header.Get("Accept")
+	for _, mediaType := range mediaTypes {
+		if strings.Contains(accept, mediaType) {
+			return mediaType
+		}
+	}
+	if bodies.ApplicationJSON != nil {
+		return raml.MediaTypeJSON
+	}
+	return mediaTypes[0]
+}
+
+// jsonBody returns the JSON body of the bodies,
+// a body declared without media type is a JSON body
+func jsonBody(bodies raml.Bodies) *raml.BodiesProperty {
+	if bodies.ApplicationJSON != nil {
+		return bodies.ApplicationJSON
+	}
+	if bodies.Type == "" && len(bodies.Properties) == 0 && bodies.Example == "" {
+		return nil
+	}
+	b := &raml.BodiesProperty{
+		Type:       bodies.Type,
+		Properties: bodies.Properties,
+	}
+	if bodies.Example != "" {
+		b.Example = bodies.Example
+	}
+	return b
+}
+
+func writeError(w http.ResponseWriter, code int, e Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(e)
+}
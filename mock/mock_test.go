@@ -0,0 +1,142 @@
+package mock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMock(t *testing.T) {
+	Convey("mock server", t, func() {
+		apiDef := new(raml.APIDefinition)
+		err := raml.ParseFile("./fixtures/api.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		server := New(apiDef)
+
+		serve := func(method, url, body string, headers map[string]string) *httptest.ResponseRecorder {
+			req, err := http.NewRequest(method, url, strings.NewReader(body))
+			So(err, ShouldBeNil)
+			for k, v := range headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, req)
+			return w
+		}
+		decode := func(w *httptest.ResponseRecorder, v interface{}) {
+			So(json.NewDecoder(w.Body).Decode(v), ShouldBeNil)
+		}
+		requestID := map[string]string{"X-Request-Id": "abcdef01"}
+
+		Convey("named example", func() {
+			w := serve("GET", "/users", "", nil)
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Header().Get("Content-Type"), ShouldEqual, "application/json")
+
+			var users []map[string]interface{}
+			decode(w, &users)
+			So(users, ShouldHaveLength, 1)
+			So(users[0]["name"], ShouldEqual, "alice")
+		})
+
+		Convey("JSON document example", func() {
+			w := serve("GET", "/users/me", "", nil)
+			So(w.Code, ShouldEqual, http.StatusOK)
+
+			var user map[string]interface{}
+			decode(w, &user)
+			So(user["name"], ShouldEqual, "carol")
+		})
+
+		Convey("synthesized body is valid", func() {
+			w := serve("GET", "/users/3", "", requestID)
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(apiDef.CheckJSONBody("User", nil, w.Body.Bytes()), ShouldBeNil)
+		})
+
+		Convey("selected status code", func() {
+			w := serve("GET", "/users/3?mock-status=404", "", requestID)
+			So(w.Code, ShouldEqual, http.StatusNotFound)
+
+			var e map[string]interface{}
+			decode(w, &e)
+			So(e["message"], ShouldEqual, "user not found")
+
+			w = serve("GET", "/users/3", "", map[string]string{"X-Request-Id": "abcdef01", StatusHeader: "404"})
+			So(w.Code, ShouldEqual, http.StatusNotFound)
+
+			w = serve("GET", "/users/3?mock-status=500", "", requestID)
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("response without body", func() {
+			w := serve("DELETE", "/users/3", "", nil)
+			So(w.Code, ShouldEqual, http.StatusNoContent)
+			So(w.Body.Len(), ShouldEqual, 0)
+		})
+
+		Convey("invalid parameters", func() {
+			w := serve("GET", "/users/abc?page=0", "", map[string]string{"X-Request-Id": "xyz"})
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+
+			var e Error
+			decode(w, &e)
+			So(e.Message, ShouldEqual, "invalid request parameters")
+
+			in := map[string]bool{}
+			for _, v := range e.Errors {
+				in[v.In+" "+v.Name] = true
+			}
+			So(in, ShouldContainKey, "uri id")
+			So(in, ShouldContainKey, "header X-Request-Id")
+
+			w = serve("GET", "/users?page=0", "", nil)
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+
+			w = serve("GET", "/users?active=TRUE", "", nil)
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+			w = serve("GET", "/users?active=true", "", nil)
+			So(w.Code, ShouldEqual, http.StatusOK)
+
+			w = serve("GET", "/users/3", "", nil)
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("request body", func() {
+			valid := `{"id": 3, "name": "dave", "email": "dave@example.com", "role": "member", "created": "2017-05-04T10:00:00Z"}`
+			w := serve("POST", "/users", valid, nil)
+			So(w.Code, ShouldEqual, http.StatusCreated)
+			So(w.Header().Get("Location"), ShouldEqual, "/users/3")
+
+			w = serve("POST", "/users", `{"id": 3, "name": "dave"}`, nil)
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+
+			var e Error
+			decode(w, &e)
+			So(e.Message, ShouldEqual, "invalid request body")
+			So(e.Errors[0].In, ShouldEqual, "body")
+		})
+
+		Convey("cross-origin requests", func() {
+			w := serve("OPTIONS", "/users", "", map[string]string{
+				"Origin":                         "http://example.com",
+				"Access-Control-Request-Method":  "POST",
+				"Access-Control-Request-Headers": "Content-Type",
+			})
+			So(w.Code, ShouldEqual, http.StatusNoContent)
+			So(w.Header().Get("Access-Control-Allow-Origin"), ShouldEqual, "*")
+			So(w.Header().Get("Access-Control-Allow-Methods"), ShouldEqual, "POST")
+			So(w.Header().Get("Access-Control-Allow-Headers"), ShouldEqual, "Content-Type")
+
+			w = serve("GET", "/users/me", "", nil)
+			So(w.Header().Get("Access-Control-Allow-Origin"), ShouldEqual, "*")
+		})
+	})
+}
@@ -0,0 +1,483 @@
+package raml
+
+// This file contains the annotation types and annotations support.
+// See http://docs.raml.org/specs/1.0/#raml-10-spec-annotations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Annotation targets, the locations in which an annotation can be applied.
+const (
+	AnnotationTargetAPI                    = "API"
+	AnnotationTargetDocumentationItem      = "DocumentationItem"
+	AnnotationTargetResource               = "Resource"
+	AnnotationTargetMethod                 = "Method"
+	AnnotationTargetResponse               = "Response"
+	AnnotationTargetRequestBody            = "RequestBody"
+	AnnotationTargetResponseBody           = "ResponseBody"
+	AnnotationTargetTypeDeclaration        = "TypeDeclaration"
+	AnnotationTargetExample                = "Example"
+	AnnotationTargetResourceType           = "ResourceType"
+	AnnotationTargetTrait                  = "Trait"
+	AnnotationTargetSecurityScheme         = "SecurityScheme"
+	AnnotationTargetSecuritySchemeMethod   = "SecuritySchemeMethod"
+	AnnotationTargetSecuritySchemeSettings = "SecuritySchemeSettings"
+	AnnotationTargetAnnotationType         = "AnnotationType"
+	AnnotationTargetLibrary                = "Library"
+	AnnotationTargetOverlay                = "Overlay"
+	AnnotationTargetExtension              = "Extension"
+)
+
+// AnnotationType declares an annotation.
+// Annotation type declarations have the same syntax as data type declarations,
+// with an additional allowedTargets facet.
+type AnnotationType struct {
+	Name string `yaml:"-"`
+
+	// The declared type of the annotation value.
+	// If there is no type and no properties, it defaults to string.
+	Type interface{} `yaml:"type"`
+
+	// An alternate, human-friendly name for the annotation type
+	DisplayName string `yaml:"displayName"`
+
+	// A substantial, human-friendly description of the annotation type.
+	Description string `yaml:"description"`
+
+	// The properties of the annotation value, if the value is an object.
+	// we use `interface{}` as property type to support syntactic sugar & shortcut
+	Properties map[string]interface{} `yaml:"properties"`
+
+	// Enumeration of possible values of the annotation.
+	Enum []interface{} `yaml:"enum"`
+
+	// The locations to which annotations are restricted.
+	// If this facet is specified, annotations of this type may be applied
+	// only on a node corresponding to one of the locations.
+	AllowedTargets []string `yaml:"allowedTargets"`
+}
+
+// UnmarshalYAML unmarshals an annotation type which might be:
+// - empty, the type is string
+// - a type name or type expression
+// - a map of the annotation type facets
+func (at *AnnotationType) UnmarshalYAML(unmarshaler func(interface{}) error) error {
+	var typeName string
+	if err := unmarshaler(&typeName); err == nil {
+		at.Type = typeName
+		return nil
+	}
+
+	var atd struct {
+		Type        interface{}            `yaml:"type"`
+		DisplayName string                 `yaml:"displayName"`
+		Description string                 `yaml:"description"`
+		Properties  map[string]interface{} `yaml:"properties"`
+		Enum        []interface{}          `yaml:"enum"`
+
+		// allowedTargets could be a single target or list of targets
+		AllowedTargets interface{} `yaml:"allowedTargets"`
+	}
+	if err := unmarshaler(&atd); err != nil {
+		return err
+	}
+
+	*at = AnnotationType{
+		Type:        atd.Type,
+		DisplayName: atd.DisplayName,
+		Description: atd.Description,
+		Properties:  atd.Properties,
+		Enum:        atd.Enum,
+	}
+	switch targets := atd.AllowedTargets.(type) {
+	case string:
+		at.AllowedTargets = []string{targets}
+	case []interface{}:
+		for _, t := range targets {
+			at.AllowedTargets = append(at.AllowedTargets, fmt.Sprint(t))
+		}
+	}
+	return nil
+}
+
+// TypeName returns the declared type name of the annotation value
+func (at AnnotationType) TypeName() string {
+	typeName, _ := at.Type.(string)
+	typeName = strings.TrimSpace(typeName)
+	if typeName == "" {
+		if len(at.Properties) > 0 {
+			return "object"
+		}
+		return "string"
+	}
+	return typeName
+}
+
+// isAllowedTarget returns true if the annotation type could be applied to the target
+func (at AnnotationType) isAllowedTarget(target string) bool {
+	if len(at.AllowedTargets) == 0 {
+		return true
+	}
+	for _, t := range at.AllowedTargets {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
+
+// validateValue checks the annotation value against the declared type
+func (at AnnotationType) validateValue(val interface{}) error {
+	if len(at.Enum) > 0 && !inInterfaceSlice(val, at.Enum) {
+		return fmt.Errorf("value %v is not one of %v", val, at.Enum)
+	}
+	if err := validateAnnotationValue(at.TypeName(), val); err != nil {
+		return err
+	}
+
+	// check required properties of an object
+	if len(at.Properties) == 0 {
+		return nil
+	}
+	obj, _ := val.(map[interface{}]interface{})
+	for name, p := range at.Properties {
+		prop := ToProperty(name, p)
+		pVal, ok := obj[prop.Name]
+		if !ok {
+			if prop.Required {
+				return fmt.Errorf("missing required property `%v`", prop.Name)
+			}
+			continue
+		}
+		if err := validateAnnotationValue(prop.Type, pVal); err != nil {
+			return fmt.Errorf("property `%v`: %v", prop.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateAnnotationValue checks an annotation value against a type expression.
+// User defined types are not checked.
+func validateAnnotationValue(typeName string, val interface{}) error {
+	te, err := ParseTypeExpr(typeName)
+	if err != nil {
+		return err
+	}
+	return checkBuiltinExprValue(te, val)
+}
+
+// checkBuiltinExprValue checks a value against a type expression,
+// only the built-in types are checked.
+func checkBuiltinExprValue(te *TypeExpr, val interface{}) error {
+	switch te.Kind {
+	case TypeExprUnion: // value must be valid for one of the types
+		for _, m := range te.Members {
+			if err := checkBuiltinExprValue(m, val); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %v is not of type %v", val, te)
+	case TypeExprArray:
+		arr, ok := val.([]interface{})
+		if !ok {
+			return fmt.Errorf("value %v is not an array", val)
+		}
+		for _, elem := range arr {
+			if err := checkBuiltinExprValue(te.Items, elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	case TypeExprName:
+		return checkBuiltinValue(te.Name, val)
+	default:
+		return nil
+	}
+}
+
+// checkBuiltinValue checks a value against a built-in type.
+// Any other type name is considered valid.
+func checkBuiltinValue(typeName string, val interface{}) error {
+	var ok bool
+	switch typeName {
+	case "nil":
+		ok = val == nil
+	case "string", "date-only", "time-only", "datetime-only", "datetime":
+		_, ok = val.(string)
+	case "number":
+		switch val.(type) {
+		case int, int64, uint64, float64:
+			ok = true
+		}
+	case "integer":
+		switch val.(type) {
+		case int, int64, uint64:
+			ok = true
+		}
+	case "boolean":
+		_, ok = val.(bool)
+	case "object":
+		_, ok = val.(map[interface{}]interface{})
+	case "array":
+		_, ok = val.([]interface{})
+	default: // any & user defined types
+		ok = true
+	}
+	if !ok {
+		return fmt.Errorf("value %v is not of type %v", val, typeName)
+	}
+	return nil
+}
+
+// Annotation is a value of an annotation type applied to a node
+type Annotation struct {
+	// position of the annotation in the RAML file
+	Position `yaml:"-"`
+
+	// Name of the annotation, without the parentheses
+	Name string
+
+	// Value of the annotation
+	Value interface{}
+}
+
+// UnmarshalYAML unmarshals annotation value, which could be any value
+func (a *Annotation) UnmarshalYAML(unmarshaler func(interface{}) error) error {
+	return unmarshaler(&a.Value)
+}
+
+// Annotations is map of annotation name to the annotation.
+// The key is the annotation name without the parentheses,
+// prefixed with the library name if the annotation type is defined in a library.
+type Annotations map[string]Annotation
+
+// newAnnotations creates annotations map from the map created by yaml parser
+// which still has parentheses in the keys
+func newAnnotations(raw map[interface{}]interface{}) Annotations {
+	annots := Annotations{}
+	for k, v := range raw {
+		key := fmt.Sprint(k)
+		if !isAnnotationKey(key) {
+			continue
+		}
+		name := annotationName(key)
+		annots[name] = Annotation{Name: name, Value: v}
+	}
+	return annots
+}
+
+// postProcess checks all annotations against their annotation type
+// and removes the parentheses from the keys.
+// pos is the position of the annotated node, used in errors
+// when the annotation position is unknown.
+func (annots *Annotations) postProcess(pos Position, target string, annotationTypes func(string) (AnnotationType, bool)) error {
+	if len(*annots) == 0 {
+		return nil
+	}
+	processed := Annotations{}
+	for key, a := range *annots {
+		name := annotationName(key)
+		a.Name = name
+
+		errPos := a.Position
+		if !errPos.IsValid() {
+			errPos = pos
+		}
+
+		at, ok := annotationTypes(name)
+		if !ok {
+			return newError(errPos, "unknown annotation type: %v", name)
+		}
+		if !at.isAllowedTarget(target) {
+			return newError(errPos, "annotation %v is not allowed for %v, allowed targets=%v", name, target, at.AllowedTargets)
+		}
+		if err := at.validateValue(a.Value); err != nil {
+			return newError(errPos, "invalid annotation %v: %v", name, err)
+		}
+		processed[name] = a
+	}
+	*annots = processed
+	return nil
+}
+
+// annotationName returns annotation name without parentheses
+func annotationName(key string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(key), "("), ")")
+}
+
+// annotationTypeFinder returns function to find an annotation type by it's name.
+// annotation type from library is prefixed with the library name, e.g. lib.deprecated
+func annotationTypeFinder(annotationTypes map[string]AnnotationType, libraries map[string]*Library) func(string) (AnnotationType, bool) {
+	return func(name string) (AnnotationType, bool) {
+		splitted := strings.Split(name, ".")
+		switch len(splitted) {
+		case 1:
+			at, ok := annotationTypes[name]
+			return at, ok
+		case 2:
+			l, ok := libraries[splitted[0]]
+			if !ok {
+				return AnnotationType{}, false
+			}
+			at, ok := l.AnnotationTypes[splitted[1]]
+			return at, ok
+		}
+		return AnnotationType{}, false
+	}
+}
+
+// postProcessAnnotations checks all the annotations in the API definition
+func (apiDef *APIDefinition) postProcessAnnotations() error {
+	finder := annotationTypeFinder(apiDef.AnnotationTypes, apiDef.Libraries)
+	filePos := Position{File: apiDef.Filename}
+
+	if err := apiDef.Annotations.postProcess(filePos, AnnotationTargetAPI, finder); err != nil {
+		return err
+	}
+	if err := typesAnnotations(apiDef.Types, finder); err != nil {
+		return err
+	}
+	if err := securitySchemesAnnotations(filePos, apiDef.SecuritySchemes, finder); err != nil {
+		return err
+	}
+	for uri, r := range apiDef.Resources {
+		if err := r.postProcessAnnotations(finder); err != nil {
+			return err
+		}
+		apiDef.Resources[uri] = r
+	}
+	return nil
+}
+
+// postProcessAnnotations checks all annotations in the library
+func (l *Library) postProcessAnnotations(fileName string) error {
+	finder := annotationTypeFinder(l.AnnotationTypes, l.Libraries)
+	filePos := Position{File: fileName}
+
+	if err := l.Annotations.postProcess(filePos, AnnotationTargetLibrary, finder); err != nil {
+		return err
+	}
+	if err := typesAnnotations(l.Types, finder); err != nil {
+		return err
+	}
+	return securitySchemesAnnotations(filePos, l.SecuritySchemes, finder)
+}
+
+// postProcessAnnotations checks annotations of the resource,
+// it's methods, and it's nested resources
+func (r *Resource) postProcessAnnotations(finder func(string) (AnnotationType, bool)) error {
+	if err := r.Annotations.postProcess(r.Position, AnnotationTargetResource, finder); err != nil {
+		return err
+	}
+	for _, m := range r.Methods {
+		if err := m.postProcessAnnotations(finder); err != nil {
+			return err
+		}
+	}
+	for _, n := range r.Nested {
+		if err := n.postProcessAnnotations(finder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// postProcessAnnotations checks annotations of the method, it's body and responses
+func (m *Method) postProcessAnnotations(finder func(string) (AnnotationType, bool)) error {
+	if err := m.Annotations.postProcess(m.Position, AnnotationTargetMethod, finder); err != nil {
+		return err
+	}
+	if err := m.Bodies.postProcessAnnotations(m.Position, AnnotationTargetRequestBody, finder); err != nil {
+		return err
+	}
+	for code, resp := range m.Responses {
+		if err := resp.Annotations.postProcess(resp.Position, AnnotationTargetResponse, finder); err != nil {
+			return err
+		}
+		if err := resp.Bodies.postProcessAnnotations(resp.Position, AnnotationTargetResponseBody, finder); err != nil {
+			return err
+		}
+		m.Responses[code] = resp
+	}
+	return nil
+}
+
+// postProcessAnnotations checks annotations of the bodies.
+// target is either request or response body,
+// pos is the position of the method or response of the bodies.
+func (b *Bodies) postProcessAnnotations(pos Position, target string, finder func(string) (AnnotationType, bool)) error {
+	if err := b.Annotations.postProcess(pos, target, finder); err != nil {
+		return err
+	}
+	if b.ApplicationJSON != nil {
+		if err := b.ApplicationJSON.Annotations.postProcess(pos, target, finder); err != nil {
+			return err
+		}
+		if err := propertiesAnnotations(pos, b.ApplicationJSON.Properties, finder); err != nil {
+			return err
+		}
+	}
+	if b.ApplicationXML != nil {
+		if err := b.ApplicationXML.Annotations.postProcess(pos, target, finder); err != nil {
+			return err
+		}
+		if err := propertiesAnnotations(pos, b.ApplicationXML.Properties, finder); err != nil {
+			return err
+		}
+	}
+	for mediaType, body := range b.ForMIMEType {
+		if err := body.Annotations.postProcess(pos, target, finder); err != nil {
+			return err
+		}
+		b.ForMIMEType[mediaType] = body
+	}
+	return nil
+}
+
+// typesAnnotations checks annotations of the types and their properties
+func typesAnnotations(types map[string]Type, finder func(string) (AnnotationType, bool)) error {
+	for name, t := range types {
+		if err := t.Annotations.postProcess(t.Position, AnnotationTargetTypeDeclaration, finder); err != nil {
+			return err
+		}
+		if err := propertiesAnnotations(t.Position, t.Properties, finder); err != nil {
+			return err
+		}
+		types[name] = t
+	}
+	return nil
+}
+
+// propertiesAnnotations checks annotations of the properties.
+// Properties are created on the fly by ToProperty, so we only check it here.
+func propertiesAnnotations(pos Position, props map[string]interface{}, finder func(string) (AnnotationType, bool)) error {
+	for name, p := range props {
+		prop := ToProperty(name, p)
+		if err := prop.Annotations.postProcess(pos, AnnotationTargetTypeDeclaration, finder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// securitySchemesAnnotations checks annotations of the security schemes
+func securitySchemesAnnotations(pos Position, schemes map[string]SecurityScheme, finder func(string) (AnnotationType, bool)) error {
+	for name, ss := range schemes {
+		if err := ss.Annotations.postProcess(pos, AnnotationTargetSecurityScheme, finder); err != nil {
+			return err
+		}
+		if err := ss.DescribedBy.Annotations.postProcess(pos, AnnotationTargetSecuritySchemeMethod, finder); err != nil {
+			return err
+		}
+		for code, resp := range ss.DescribedBy.Responses {
+			if err := resp.Annotations.postProcess(pos, AnnotationTargetResponse, finder); err != nil {
+				return err
+			}
+			ss.DescribedBy.Responses[code] = resp
+		}
+		schemes[name] = ss
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAnnotations(t *testing.T) {
+	Convey("annotations", t, func() {
+		apiDef := new(APIDefinition)
+
+		Convey("annotation types & annotations", func() {
+			err := ParseFile("./samples/annotations/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			So(apiDef.AnnotationTypes, ShouldContainKey, "internal")
+			So(apiDef.AnnotationTypes["internal"].AllowedTargets, ShouldResemble, []string{"Resource", "Method"})
+			So(apiDef.AnnotationTypes["owner"].AllowedTargets, ShouldResemble, []string{"Method"})
+			So(apiDef.AnnotationTypes["deprecated"].TypeName(), ShouldEqual, "string")
+			So(apiDef.Libraries["meta"].AnnotationTypes, ShouldContainKey, "sensitive")
+
+			So(apiDef.Annotations["badge"].Value, ShouldEqual, "experimental")
+
+			users := apiDef.Resources["/users"]
+			So(users.Annotations, ShouldContainKey, "internal")
+
+			So(users.Get.Annotations, ShouldContainKey, "owner")
+			So(users.Get.Annotations["deprecated"].Value, ShouldEqual, "use /accounts instead")
+
+			resp := users.Get.Responses["200"]
+			So(resp.Annotations["badge"].Value, ShouldEqual, "stable")
+			So(resp.Bodies.ApplicationJSON.Annotations["badge"].Value, ShouldEqual, "json")
+			So(resp.Bodies.ForMIMEType, ShouldNotContainKey, "(badge)")
+
+			user := apiDef.Types["User"]
+			So(user.Annotations["meta.sensitive"].Value, ShouldEqual, true)
+			password := ToProperty("password", user.Properties["password"])
+			So(password.Annotations["meta.sensitive"].Value, ShouldEqual, true)
+		})
+
+		Convey("not allowed target", func() {
+			err := ParseFile("./samples/annotations/bad_target.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "not allowed")
+		})
+
+		Convey("not allowed target of security scheme method", func() {
+			err := ParseFile("./samples/annotations/bad_security_scheme_method.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "not allowed for SecuritySchemeMethod")
+		})
+
+		Convey("invalid value", func() {
+			err := ParseFile("./samples/annotations/bad_value.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "team")
+		})
+
+		Convey("unknown annotation type", func() {
+			err := ParseFile("./samples/annotations/unknown.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "unknown annotation type")
+		})
+	})
+}
@@ -2,13 +2,14 @@ package raml
 
 import (
 	"fmt"
-	"path/filepath"
 	"strings"
 )
 
 // APIDefinition describes the basic information of an API, such as its
 // title and base URI, and describes how to define common schema references.
 type APIDefinition struct {
+	// The RAML version of the parsed document, e.g. "#%RAML 0.8".
+	// RAML 0.8 documents are converted to RAML 1.0 by the parser.
 	RAMLVersion string `yaml:"-"`
 
 	// A short, plain-text label for the API.
@@ -47,7 +48,7 @@ type APIDefinition struct {
 	// The media type applies to requests having a body,
 	// the expected responses, and examples using the same sequence of media type strings.
 	// Each value needs to conform to the media type specification in RFC6838.
-	MediaType string `yaml:"mediaType"`
+	MediaType MediaTypes `yaml:"mediaType"`
 
 	// Additional overall documentation for the API.
 	// The API definition can include a variety of documents that serve as a
@@ -71,7 +72,12 @@ type APIDefinition struct {
 	// Declarations of resource types for use within the API.
 	ResourceTypes map[string]ResourceType `yaml:"resourceTypes"`
 
-	// TODO : annontation types
+	// Declarations of annotation types for use by annotations.
+	AnnotationTypes map[string]AnnotationType `yaml:"annotationTypes"`
+
+	// Annotations to be applied to this API.
+	// An annotation is a map having a key that begins with "(" and ends with ")"
+	Annotations Annotations `yaml:",regexp:^\\(.*\\)$"`
 
 	// Declarations of security schemes for use within the API.
 	SecuritySchemes map[string]SecurityScheme `yaml:"securitySchemes"`
@@ -99,57 +105,94 @@ type APIDefinition struct {
 // - allocate map fields
 func (apiDef *APIDefinition) PostProcess(filename string) error {
 	apiDef.Filename = filename
-	// libraries
-	apiDef.Libraries = map[string]*Library{}
-
-	for name, path := range apiDef.Uses {
-		lib := &Library{Filename: path}
-		if err := ParseFile(filepath.Join(filepath.Dir(apiDef.Filename), path), lib); err != nil {
-			return fmt.Errorf("apiDef.PostProcess() failed to parse library	name=%v, path=%v\n\terr=%v",
-				name, path, err)
-		}
-		apiDef.Libraries[name] = lib
+	// libraries are parsed by the parser
+	if apiDef.Libraries == nil {
+		apiDef.Libraries = map[string]*Library{}
 	}
 
+	apiDef.BaseURIParameters = postProcessNamedParameters(apiDef.BaseURIParameters)
+
 	// traits
 	for name, t := range apiDef.Traits {
 		t.postProcess(name)
 		apiDef.Traits[name] = t
 	}
 
+	traits := allTraits(apiDef.Traits, apiDef.Libraries)
+
 	// resource types
 	for name, rt := range apiDef.ResourceTypes {
-		rt.postProcess(name, apiDef.Traits)
+		if err := rt.postProcess(name, traits); err != nil {
+			return err
+		}
 		apiDef.ResourceTypes[name] = rt
 	}
+	resourceTypes := allResourceTypes(apiDef.ResourceTypes, apiDef.Libraries)
+	if err := inheritResourceTypes(resourceTypes); err != nil {
+		return err
+	}
+	for name := range apiDef.ResourceTypes {
+		apiDef.ResourceTypes[name] = resourceTypes[name]
+	}
 
 	// resources
 	for k := range apiDef.Resources {
 		r := apiDef.Resources[k]
-		if err := r.postProcess(k, nil, apiDef.allResourceTypes(), apiDef.Traits); err != nil {
+		if err := r.postProcess(k, nil, resourceTypes, traits); err != nil {
 			return err
 		}
+		r.applyMediaTypes(apiDef.MediaType)
 		apiDef.Resources[k] = r
 	}
-	return nil
+
+	// annotations
+	for name, at := range apiDef.AnnotationTypes {
+		at.Name = name
+		apiDef.AnnotationTypes[name] = at
+	}
+	return apiDef.postProcessAnnotations()
+}
+
+func (apiDef *APIDefinition) libraryFiles() map[string]string {
+	return apiDef.Uses
+}
+
+func (apiDef *APIDefinition) setLibraries(libs map[string]*Library) {
+	apiDef.Libraries = libs
 }
 
-// AllResourceTypes gets all resource type that defined in this api definition.
+// allResourceTypes gets all resource types that could be inherited in a document.
 // resource types could be from:
-// - this document itself
-// - library
-func (apiDef *APIDefinition) allResourceTypes() map[string]ResourceType {
-	rts := apiDef.ResourceTypes
-	if len(rts) == 0 {
-		rts = map[string]ResourceType{}
+// - the document itself
+// - library, the name is prefixed with the library name
+func allResourceTypes(resourceTypes map[string]ResourceType, libraries map[string]*Library) map[string]ResourceType {
+	all := map[string]ResourceType{}
+	for name, rt := range resourceTypes {
+		all[name] = rt
 	}
+	for libName, l := range libraries {
+		for name, rt := range l.ResourceTypes {
+			all[fmt.Sprintf("%v.%v", libName, name)] = rt
+		}
+	}
+	return all
+}
 
-	for libName, l := range apiDef.Libraries {
-		for rtName, rt := range l.ResourceTypes {
-			rts[fmt.Sprintf("%v.%v", libName, rtName)] = rt
+// allTraits gets all traits that could be applied in a document.
+// traits could be from:
+// - the document itself
+// - library, the name is prefixed with the library name
+func allTraits(traits map[string]Trait, libraries map[string]*Library) map[string]Trait {
+	all := map[string]Trait{}
+	for name, t := range traits {
+		all[name] = t
+	}
+	for libName, l := range libraries {
+		for name, t := range l.Traits {
+			all[fmt.Sprintf("%v.%v", libName, name)] = t
 		}
 	}
-	return rts
+	return all
 }
 
 // FindLibFile find lbrary file by it's name
@@ -0,0 +1,140 @@
+package raml
+
+// This file contains the bundling of an API definition into a single document.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gigforks/yaml"
+)
+
+// bundleSeparator separates the library name and the declaration name
+// of a library declaration in a bundle, e.g. `lib.Person` becomes `lib_Person`
+const bundleSeparator = "_"
+
+// Bundle writes an API definition as a single self contained RAML 1.0 document,
+// for the tools which don't support includes, libraries, traits, or resource types:
+// - the resource types and traits are applied, they are not declared in the bundle
+// - the types, annotation types and security schemes of the libraries are declared
+// in the bundle, prefixed with the library name, e.g. `lib.Person` becomes `lib_Person`
+// - the included files are inlined
+func Bundle(apiDef *APIDefinition) ([]byte, error) {
+	w := marshaler{
+		resolved: true,
+		rename:   libraryRenamer("", apiDef.Libraries, nil),
+	}
+	doc, err := w.apiDefinition(apiDef)
+	if err != nil {
+		return nil, err
+	}
+	return marshalDocument(ramlVersion10, doc)
+}
+
+// bundleDecls writes the declarations of the API definition and it's libraries
+func (w *marshaler) bundleDecls(apiDef *APIDefinition) (mapping, error) {
+	types := w.typeDecls(apiDef.Types)
+	annotationTypes := w.annotationTypeDecls(apiDef.AnnotationTypes)
+	securitySchemes := w.securitySchemeDecls(apiDef.SecuritySchemes)
+
+	libTypes, libAnnotationTypes, libSecuritySchemes := libraryDecls("", apiDef.Libraries)
+
+	var m mapping
+	for _, decls := range []struct {
+		key       string
+		decls     yaml.MapSlice
+		libDecls  mapping
+		declNames []string
+	}{
+		{"types", types, libTypes, sortedKeys(apiDef.Types)},
+		{"annotationTypes", annotationTypes, libAnnotationTypes, sortedKeys(apiDef.AnnotationTypes)},
+		{"securitySchemes", securitySchemes, libSecuritySchemes, sortedKeys(apiDef.SecuritySchemes)},
+	} {
+		for _, item := range decls.libDecls {
+			if inStringSlice(fmt.Sprint(item.Key), decls.declNames) {
+				return nil, fmt.Errorf("%v of library `%v` conflicts with the declaration in the API definition",
+					decls.key, item.Key)
+			}
+		}
+		all := append(decls.decls, decls.libDecls...)
+		sort.SliceStable(all, func(i, j int) bool {
+			return fmt.Sprint(all[i].Key) < fmt.Sprint(all[j].Key)
+		})
+		m.set(decls.key, all)
+	}
+	return m, nil
+}
+
+// libraryDecls writes the declarations of the libraries and the libraries used by them,
+// the names are prefixed with the library names.
+func libraryDecls(prefix string, libraries map[string]*Library) (types, annotationTypes, securitySchemes mapping) {
+	for _, name := range sortedKeys(libraries) {
+		lib := libraries[name]
+		libPrefix := prefix + name + bundleSeparator
+
+		declared := func(name string) bool {
+			_, isType := lib.Types[name]
+			_, isAnnotationType := lib.AnnotationTypes[name]
+			_, isSecurityScheme := lib.SecuritySchemes[name]
+			return isType || isAnnotationType || isSecurityScheme
+		}
+		w := marshaler{
+			resolved: true,
+			rename:   libraryRenamer(libPrefix, lib.Libraries, declared),
+		}
+		types = append(types, prefixKeys(libPrefix, w.typeDecls(lib.Types))...)
+		annotationTypes = append(annotationTypes, prefixKeys(libPrefix, w.annotationTypeDecls(lib.AnnotationTypes))...)
+		securitySchemes = append(securitySchemes, prefixKeys(libPrefix, w.securitySchemeDecls(lib.SecuritySchemes))...)
+
+		libTypes, libAnnotationTypes, libSecuritySchemes := libraryDecls(libPrefix, lib.Libraries)
+		types = append(types, libTypes...)
+		annotationTypes = append(annotationTypes, libAnnotationTypes...)
+		securitySchemes = append(securitySchemes, libSecuritySchemes...)
+	}
+	return
+}
+
+// libraryRenamer returns function to rename the references in a document to the bundle names.
+// prefix is the prefix of the declarations of the document,
+// declared returns true if a name is declared in the document.
+func libraryRenamer(prefix string, libraries map[string]*Library, declared func(string) bool) func(string) string {
+	return func(name string) string {
+		// reference to a library declaration
+		if i := strings.Index(name, "."); i > 0 {
+			if libPrefix, ok := findLibraryPrefix(libraries, name[:i]); ok {
+				return prefix + libPrefix + name[i+1:]
+			}
+			return name
+		}
+		if declared != nil && declared(name) {
+			return prefix + name
+		}
+		return name
+	}
+}
+
+// findLibraryPrefix finds the bundle prefix of a library by it's name.
+// The library is searched in the libraries, then in the libraries used by them,
+// because the properties inherited from the resource types and traits of a library
+// refer to the libraries used by the library.
+func findLibraryPrefix(libraries map[string]*Library, name string) (string, bool) {
+	if _, ok := libraries[name]; ok {
+		return name + bundleSeparator, true
+	}
+	for _, libName := range sortedKeys(libraries) {
+		if prefix, ok := findLibraryPrefix(libraries[libName].Libraries, name); ok {
+			return libName + bundleSeparator + prefix, true
+		}
+	}
+	return "", false
+}
+
+// prefixKeys prefixes all keys of a mapping
+func prefixKeys(prefix string, ms yaml.MapSlice) mapping {
+	m := make(mapping, 0, len(ms))
+	for _, item := range ms {
+		m = append(m, yaml.MapItem{Key: prefix + fmt.Sprint(item.Key), Value: item.Value})
+	}
+	return m
+}
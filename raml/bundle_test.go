@@ -0,0 +1,96 @@
+package raml
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBundle(t *testing.T) {
+	Convey("bundle", t, func() {
+		// bundle parses the file and returns the bundle and the re-parsed bundle
+		bundle := func(file string) (string, *APIDefinition) {
+			apiDef := new(APIDefinition)
+			So(ParseFile("./samples/"+file, apiDef), ShouldBeNil)
+
+			b, err := Bundle(apiDef)
+			So(err, ShouldBeNil)
+
+			// the bundle is self contained
+			bundled := new(APIDefinition)
+			So(ParseFS(fstest.MapFS{"api.raml": {Data: b}}, "api.raml", bundled), ShouldBeNil)
+			So(HasErrors(Validate(bundled)), ShouldBeFalse)
+
+			// bundling the bundle doesn't change it
+			rebundled, err := Bundle(bundled)
+			So(err, ShouldBeNil)
+			So(string(rebundled), ShouldEqual, string(b))
+			return string(b), bundled
+		}
+
+		Convey("self contained", func() {
+			files := []string{
+				"resource_type_chain.raml",
+				"simple_with_lib.raml",
+				"annotations/api.raml",
+				"bundle/api.raml",
+				"includes/api.raml",
+				"jsonschema/api.raml",
+				"xml/api.raml",
+				"validate/valid.raml",
+			}
+			for _, file := range files {
+				doc, _ := bundle(file)
+				So(strings.HasPrefix(doc, "#%RAML 1.0\n"), ShouldBeTrue)
+				for _, s := range []string{"uses:", "traits:", "resourceTypes:", "!include", "\n  type:", "\n  is:"} {
+					So(doc, ShouldNotContainSubstring, s)
+				}
+			}
+		})
+
+		Convey("resource types and traits are applied", func() {
+			_, apiDef := bundle("resource_type_chain.raml")
+			So(apiDef.ResourceTypes, ShouldBeEmpty)
+			So(apiDef.Traits, ShouldBeEmpty)
+
+			books := apiDef.Resources["/books"]
+			So(books.Type, ShouldBeNil)
+			So(books.Get.QueryParameters["q"].Description, ShouldEqual, "search book by name")
+			So(books.Get.QueryParameters["page"].Description, ShouldEqual, "page of books")
+			So(books.Get.Headers["Authorization"].Description, ShouldEqual, "authorized by method")
+			So(books.Delete.Responses["204"].Description, ShouldEqual, "the book is deleted")
+		})
+
+		Convey("library declarations are namespaced", func() {
+			doc, apiDef := bundle("bundle/api.raml")
+			So(apiDef.Libraries, ShouldBeEmpty)
+			So(apiDef.Types, ShouldContainKey, "Document")
+			So(apiDef.Types, ShouldContainKey, "common_Id")
+			So(apiDef.Types, ShouldContainKey, "common_Audit")
+			So(apiDef.Types, ShouldContainKey, "files_file-type_File")
+			So(apiDef.SecuritySchemes, ShouldContainKey, "common_basic")
+			So(doc, ShouldNotContainSubstring, "common.")
+
+			So(apiDef.Types["Document"].Properties["id"], ShouldEqual, "common_Id")
+			So(apiDef.Types["common_Audit"].Properties["by"], ShouldEqual, "common_Id")
+			So(apiDef.SecuredBy[0].Name, ShouldEqual, "common_basic")
+
+			// the types of the library resource types refer to the libraries used by the library
+			docByID := apiDef.Resources["/documents"].Nested["/{id}"]
+			So(docByID.URIParameters["id"].Type, ShouldEqual, "common_Id")
+			So(docByID.Get.Headers, ShouldContainKey, HTTPHeader("drm-key"))
+			So(docByID.Get.Responses["201"].Bodies.ApplicationJSON.Type, ShouldEqual, "files_file-type_File")
+		})
+
+		Convey("conflicting names", func() {
+			apiDef := new(APIDefinition)
+			So(ParseFile("./samples/bundle/api.raml", apiDef), ShouldBeNil)
+			apiDef.Types["common_Id"] = Type{Type: "string"}
+
+			_, err := Bundle(apiDef)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
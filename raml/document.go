@@ -0,0 +1,502 @@
+package raml
+
+// This file contains the loading of RAML files as YAML nodes.
+// The !include tags are resolved on the nodes and the file of every node is kept,
+// so the decoded values and the errors have the position where they are declared,
+// even when they come from an included file.
+
+import (
+	"bytes"
+	"encoding/base64"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gigforks/yaml"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+var (
+	// line number of the YAML errors, e.g. `yaml: line 3: mapping values are not allowed in this context`
+	yamlErrorLineRegexp = regexp.MustCompile(`^(?s)(?:yaml: )?line (\d+): (.*)$`)
+)
+
+// document is a RAML document loaded as YAML nodes,
+// the included files are inlined in the document.
+type document struct {
+	// root node, nil if the document is empty
+	root *yamlv3.Node
+
+	// file of the nodes, the nodes of an included file are in the included file
+	files map[*yamlv3.Node]string
+}
+
+// position returns the position of a node of the document
+func (doc *document) position(n *yamlv3.Node) Position {
+	return Position{File: doc.files[n], Line: n.Line, Column: n.Column}
+}
+
+// loadDocument loads the contents of a RAML file as YAML nodes,
+// and resolves it's !include tags
+func (p *Parser) loadDocument(filePath string, contents []byte) (*document, error) {
+	doc := &document{files: map[*yamlv3.Node]string{}}
+	root, err := p.loadNode(doc, filePath, contents)
+	if err != nil {
+		return nil, err
+	}
+	doc.root = root
+	return doc, nil
+}
+
+// loadNode parses a YAML file of the document and returns it's root node,
+// nil if the file is empty
+func (p *Parser) loadNode(doc *document, filePath string, contents []byte) (*yamlv3.Node, error) {
+	var n yamlv3.Node
+	if err := yamlv3.Unmarshal(contents, &n); err != nil {
+		return nil, yamlSyntaxError(filePath, err)
+	}
+	if len(n.Content) == 0 {
+		return nil, nil
+	}
+	return p.resolveNode(doc, filePath, n.Content[0])
+}
+
+// resolveNode resolves the aliases and the !include tags of a node and of it's children.
+// The comments and anchors are removed, so the node could be written
+// as part of another document.
+func (p *Parser) resolveNode(doc *document, filePath string, n *yamlv3.Node) (*yamlv3.Node, error) {
+	switch {
+	case n.Kind == yamlv3.AliasNode:
+		// the anchored node is already resolved, it is copied
+		// so the copy could be changed independently, like a decoded value
+		return doc.copyNode(n.Alias), nil
+	case n.Kind == yamlv3.ScalarNode && isLocalTag(n.Tag):
+		return p.includeNode(doc, filePath, n)
+	}
+
+	n.Anchor = ""
+	n.HeadComment, n.LineComment, n.FootComment = "", "", ""
+	doc.files[n] = filePath
+	for i, child := range n.Content {
+		resolved, err := p.resolveNode(doc, filePath, child)
+		if err != nil {
+			return nil, err
+		}
+		n.Content[i] = resolved
+	}
+	return n, nil
+}
+
+// includeNode returns the node which replaces a node tagged with !include.
+// The content of the included file depends on the file extension:
+// - .raml, .yaml, .yml : parsed as YAML fragment
+// - .json, .xsd, .xml, .md, .txt : string
+// - others : binary
+func (p *Parser) includeNode(doc *document, filePath string, n *yamlv3.Node) (*yamlv3.Node, error) {
+	pos := Position{File: filePath, Line: n.Line, Column: n.Column}
+	if n.Tag != "!include" {
+		return nil, newError(pos, "unsupported tag %v", n.Tag)
+	}
+	includedFile := strings.TrimSpace(n.Value)
+	if includedFile == "" {
+		return nil, newError(pos, "Error including file: file name cannot be empty")
+	}
+
+	// included files are relative to the including file
+	includedPath := p.join(p.dir(filePath), includedFile)
+	contents, err := p.readFileContents(includedPath)
+	if err != nil {
+		return nil, newError(pos, "Error including file %s:\n    %s", includedFile, err.Error())
+	}
+
+	included := &yamlv3.Node{
+		Kind:   yamlv3.ScalarNode,
+		Tag:    "!!str",
+		Line:   n.Line,
+		Column: n.Column,
+	}
+	switch strings.ToLower(path.Ext(includedFile)) {
+	case ".raml", ".yaml", ".yml":
+		root, err := p.loadNode(doc, includedPath, contents)
+		if err != nil || root != nil {
+			return root, err
+		}
+		included.Tag, included.Value = "!!null", "null"
+	case ".json":
+		// the references to other files are relative to the included file
+		contents, err = p.resolveJSONSchemaRefs(includedPath, contents)
+		if err != nil {
+			return nil, newError(pos, "Error including file %s:\n    %s", includedFile, err.Error())
+		}
+		included.Value = string(contents)
+	case ".xsd", ".xml", ".md", ".txt":
+		included.Value = string(contents)
+	default:
+		included.Tag = "!!binary"
+		included.Value = base64.StdEncoding.EncodeToString(contents)
+	}
+	doc.files[included] = filePath
+	return included, nil
+}
+
+// isLocalTag returns true if the tag is a local tag, e.g. `!include`
+func isLocalTag(tag string) bool {
+	return strings.HasPrefix(tag, "!") && !strings.HasPrefix(tag, "!!")
+}
+
+// copyNode returns a deep copy of a node, the copies are in the same files
+func (doc *document) copyNode(n *yamlv3.Node) *yamlv3.Node {
+	c := *n
+	c.Content = make([]*yamlv3.Node, len(n.Content))
+	for i, child := range n.Content {
+		c.Content[i] = doc.copyNode(child)
+	}
+	if file, ok := doc.files[n]; ok {
+		doc.files[&c] = file
+	}
+	return &c
+}
+
+// newNode returns a new node of the document,
+// it has the position of another node which it is derived from
+func (doc *document) newNode(like *yamlv3.Node, kind yamlv3.Kind, tag, value string) *yamlv3.Node {
+	n := &yamlv3.Node{
+		Kind:   kind,
+		Tag:    tag,
+		Value:  value,
+		Line:   like.Line,
+		Column: like.Column,
+	}
+	doc.files[n] = doc.files[like]
+	return n
+}
+
+// setMappingValue sets the scalar value of a key of a mapping node,
+// the key is appended if not exist
+func (doc *document) setMappingValue(n *yamlv3.Node, key, tag, value string) {
+	if idx := mappingIndex(n, key); idx >= 0 {
+		val := n.Content[idx]
+		val.Kind, val.Tag, val.Value, val.Style = yamlv3.ScalarNode, tag, value, 0
+		val.Content = nil
+		return
+	}
+	n.Content = append(n.Content,
+		doc.newNode(n, yamlv3.ScalarNode, "!!str", key),
+		doc.newNode(n, yamlv3.ScalarNode, tag, value))
+}
+
+// mappingIndex returns the index of the value of a key in a mapping node,
+// -1 if not found
+func mappingIndex(n *yamlv3.Node, key string) int {
+	if n == nil || n.Kind != yamlv3.MappingNode {
+		return -1
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// mappingValue returns the value of a key in a mapping node, nil if not found
+func mappingValue(n *yamlv3.Node, key string) *yamlv3.Node {
+	if idx := mappingIndex(n, key); idx >= 0 {
+		return n.Content[idx]
+	}
+	return nil
+}
+
+// isNullNode returns true if the node is a null scalar, e.g. `get:` without value
+func isNullNode(n *yamlv3.Node) bool {
+	return n.Kind == yamlv3.ScalarNode && n.ShortTag() == "!!null"
+}
+
+// encode writes the document in YAML
+func (doc *document) encode() ([]byte, error) {
+	if doc.root == nil {
+		return []byte{}, nil
+	}
+	var buf bytes.Buffer
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc.root); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decode decodes the document into a value, e.g. an APIDefinition,
+// and sets the position of the decoded values.
+// It returns the decoded YAML document.
+func (doc *document) decode(out interface{}) ([]byte, error) {
+	contents, err := doc.encode()
+	if err != nil {
+		return nil, newError(Position{File: doc.files[doc.root]}, "%v", err)
+	}
+	if err := yaml.Unmarshal(contents, out); err != nil {
+		return nil, doc.decodeError(contents, err)
+	}
+	doc.setPositions(doc.root, reflect.ValueOf(out))
+	return contents, nil
+}
+
+// decodeError converts an error of decoding the YAML document to RAML error,
+// the lines of the YAML document are converted to the position of the nodes
+func (doc *document) decodeError(contents []byte, err error) *Error {
+	lines := doc.linePositions(contents)
+	return yamlDecodeError(err, func(line int) Position {
+		if pos, ok := lines[line]; ok {
+			return pos
+		}
+		return Position{File: doc.files[doc.root]}
+	})
+}
+
+// linePositions maps the lines of the YAML document written by encode
+// to the position of the first node written on them, the values are preferred to the keys.
+// A block mapping isn't written on the line it starts with, it is the line of it's first key.
+func (doc *document) linePositions(contents []byte) map[int]Position {
+	var encoded yamlv3.Node
+	if err := yamlv3.Unmarshal(contents, &encoded); err != nil || len(encoded.Content) == 0 || doc.root == nil {
+		return nil
+	}
+
+	values := map[int]Position{}
+	keys := map[int]Position{}
+	var walk func(enc, orig *yamlv3.Node, isKey bool)
+	walk = func(enc, orig *yamlv3.Node, isKey bool) {
+		lines := values
+		if isKey {
+			lines = keys
+		}
+		blockMapping := enc.Kind == yamlv3.MappingNode && enc.Style&yamlv3.FlowStyle == 0
+		if _, ok := lines[enc.Line]; !ok && !blockMapping {
+			lines[enc.Line] = doc.position(orig)
+		}
+		for i := 0; i < len(enc.Content) && i < len(orig.Content); i++ {
+			walk(enc.Content[i], orig.Content[i], enc.Kind == yamlv3.MappingNode && i%2 == 0)
+		}
+	}
+	walk(encoded.Content[0], doc.root, false)
+
+	for line, pos := range keys {
+		if _, ok := values[line]; !ok {
+			values[line] = pos
+		}
+	}
+	return values
+}
+
+// yamlSyntaxError converts an error of parsing a YAML file to RAML error
+func yamlSyntaxError(filePath string, err error) *Error {
+	pos := Position{File: filePath}
+	msg := err.Error()
+	if m := yamlErrorLineRegexp.FindStringSubmatch(msg); m != nil {
+		pos.Line, _ = strconv.Atoi(m[1])
+		msg = "yaml: " + m[2]
+	}
+	return newError(pos, "%v", msg)
+}
+
+// positioner is implemented by the values which keep the position
+// of the node they are decoded from, i.e. the values embedding Position
+type positioner interface {
+	setPosition(pos Position)
+}
+
+// examplePositioner is implemented by the values which keep the positions
+// of their examples, i.e. the values embedding examplePositions
+type examplePositioner interface {
+	setExamplePositions(n *yamlv3.Node, position func(*yamlv3.Node) Position)
+}
+
+var (
+	positionerType        = reflect.TypeOf((*positioner)(nil)).Elem()
+	examplePositionerType = reflect.TypeOf((*examplePositioner)(nil)).Elem()
+
+	// types having positions, see hasPositions
+	positionTypes   = map[reflect.Type]bool{}
+	positionTypesMu sync.Mutex
+)
+
+// setPositions sets the position of the values decoded from a node and from it's children.
+// The values are matched with the nodes like the YAML decoder does:
+// the struct fields by their yaml tag, the map values by their key
+// and the slice elements by their index.
+func (doc *document) setPositions(n *yamlv3.Node, v reflect.Value) {
+	if !v.IsValid() || !hasPositions(v.Type()) {
+		return
+	}
+	if v.Kind() == reflect.Ptr {
+		if !v.IsNil() {
+			doc.setPositions(n, v.Elem())
+		}
+		return
+	}
+
+	if v.CanAddr() && v.Addr().Type().Implements(positionerType) {
+		v.Addr().Interface().(positioner).setPosition(doc.position(n))
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(examplePositionerType) && n.Kind == yamlv3.MappingNode {
+		v.Addr().Interface().(examplePositioner).setExamplePositions(n, doc.position)
+	}
+
+	switch {
+	case v.Kind() == reflect.Struct && n.Kind == yamlv3.MappingNode:
+		doc.setStructPositions(n, v)
+	case v.Kind() == reflect.Map && n.Kind == yamlv3.MappingNode:
+		doc.setMapPositions(n, v, nil)
+	case v.Kind() == reflect.Slice && n.Kind == yamlv3.SequenceNode:
+		for i := 0; i < v.Len() && i < len(n.Content); i++ {
+			doc.setPositions(n.Content[i], v.Index(i))
+		}
+	}
+}
+
+// setStructPositions sets the positions of the fields of a struct decoded from a mapping node
+func (doc *document) setStructPositions(n *yamlv3.Node, v reflect.Value) {
+	fields := yamlFields(v.Type())
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key := n.Content[i].Value
+		if idx, ok := fields.names[key]; ok {
+			doc.setPositions(n.Content[i+1], v.Field(idx))
+			continue
+		}
+		// the first map whose regexp matches the key, see the `regexp` flag of the YAML decoder
+		for _, rf := range fields.regexps {
+			if rf.re.MatchString(key) {
+				if field := v.Field(rf.index); field.Kind() == reflect.Map {
+					doc.setMapPositions(n, field, n.Content[i:i+2])
+				}
+				break
+			}
+		}
+	}
+}
+
+// setMapPositions sets the positions of the values of a map decoded from a mapping node.
+// pairs are the key and value nodes of the map, all the pairs of the node if nil.
+func (doc *document) setMapPositions(n *yamlv3.Node, v reflect.Value, pairs []*yamlv3.Node) {
+	if pairs == nil {
+		pairs = n.Content
+	}
+	kt, et := v.Type().Key(), v.Type().Elem()
+	if kt.Kind() != reflect.String || v.IsNil() {
+		return
+	}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		key := reflect.ValueOf(pairs[i].Value).Convert(kt)
+		val := v.MapIndex(key)
+		if !val.IsValid() {
+			continue
+		}
+		// map values aren't addressable
+		elem := reflect.New(et).Elem()
+		elem.Set(val)
+		doc.setPositions(pairs[i+1], elem)
+		v.SetMapIndex(key, elem)
+	}
+}
+
+// hasPositions returns true if a value of type t could have positions,
+// in itself or in it's fields and elements
+func hasPositions(t reflect.Type) bool {
+	positionTypesMu.Lock()
+	defer positionTypesMu.Unlock()
+	if has, ok := positionTypes[t]; ok {
+		return has
+	}
+	has := typeHasPositions(t, map[reflect.Type]bool{})
+	positionTypes[t] = has
+	return has
+}
+
+// typeHasPositions returns true if type t could have positions,
+// the types being visited are skipped, e.g. the nested resources of a resource
+func typeHasPositions(t reflect.Type, visiting map[reflect.Type]bool) bool {
+	if has, ok := positionTypes[t]; ok {
+		return has
+	}
+	if visiting[t] {
+		return false
+	}
+	visiting[t] = true
+
+	has := reflect.PtrTo(t).Implements(positionerType) || reflect.PtrTo(t).Implements(examplePositionerType)
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		has = has || typeHasPositions(t.Elem(), visiting)
+	case reflect.Map:
+		has = has || typeHasPositions(t.Elem(), visiting)
+	case reflect.Struct:
+		for i := 0; i < t.NumField() && !has; i++ {
+			has = typeHasPositions(t.Field(i).Type, visiting)
+		}
+	}
+	if has {
+		// a type without positions is only known when all it's fields are visited
+		positionTypes[t] = true
+	}
+	return has
+}
+
+// structFields are the fields of a struct decoded from a mapping
+type structFields struct {
+	names   map[string]int // index of the fields by their key
+	regexps []regexpField  // fields of the keys matching a regexp
+}
+
+type regexpField struct {
+	re    *regexp.Regexp
+	index int
+}
+
+var (
+	structFieldsCache   = map[reflect.Type]structFields{}
+	structFieldsCacheMu sync.Mutex
+)
+
+// yamlFields returns the fields of a struct by their YAML key,
+// following the rules of the YAML decoder
+func yamlFields(t reflect.Type) structFields {
+	structFieldsCacheMu.Lock()
+	defer structFieldsCacheMu.Unlock()
+	if fields, ok := structFieldsCache[t]; ok {
+		return fields
+	}
+
+	fields := structFields{names: map[string]int{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("yaml")
+		if tag == "" && strings.Index(string(field.Tag), ":") < 0 {
+			tag = string(field.Tag)
+		}
+		switch {
+		case tag == "-":
+		case strings.HasPrefix(tag, ",regexp:"):
+			fields.regexps = append(fields.regexps, regexpField{
+				re:    regexp.MustCompile(strings.TrimPrefix(tag, ",regexp:")),
+				index: i,
+			})
+		default:
+			name := strings.Split(tag, ",")[0]
+			if name == "" {
+				name = strings.ToLower(field.Name)
+			}
+			fields.names[name] = i
+		}
+	}
+	structFieldsCache[t] = fields
+	return fields
+}
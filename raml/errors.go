@@ -31,6 +31,7 @@ package raml
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/gigforks/yaml"
@@ -39,45 +40,85 @@ import (
 // An Error is returned by the ParseFile function when RAML or YAML problems
 // are encountered when parsing the RAML document.
 type Error struct {
-	Errors []string
+	Errors []ErrorDetail
+}
+
+// ErrorDetail is a single problem found in a RAML document,
+// with the position where it is found.
+type ErrorDetail struct {
+	Position
+	Message string
+}
+
+func (ed ErrorDetail) String() string {
+	if pos := ed.Position.String(); pos != "" {
+		return pos + ": " + ed.Message
+	}
+	return ed.Message
 }
 
 func (e *Error) Error() string {
+	errs := make([]string, 0, len(e.Errors))
+	for _, ed := range e.Errors {
+		errs = append(errs, ed.String())
+	}
 	return fmt.Sprintf("Error parsing RAML:\n  %s\n",
-		strings.Join(e.Errors, "\n  "))
+		strings.Join(errs, "\n  "))
+}
+
+// newError creates RAML error of a node in the given position
+func newError(pos Position, format string, args ...interface{}) *Error {
+	return &Error{
+		Errors: []ErrorDetail{{Position: pos, Message: fmt.Sprintf(format, args...)}},
+	}
 }
 
-// Populate the RAML error value with converted YAML error strings (with
-// additional context)
-func populateRAMLError(ramlError *Error,
-	yamlErrors *yaml.TypeError) {
+// toRAMLError converts an error to RAML error.
+// pos is used as the error position if the error is not a RAML error.
+func toRAMLError(err error, pos Position) *Error {
+	if ramlErr, ok := err.(*Error); ok {
+		return ramlErr
+	}
+	return newError(pos, "%v", err)
+}
 
-	// Go over the errors
-	for _, currErr := range yamlErrors.Errors {
+// yamlDecodeError converts the error of decoding a YAML document to RAML error,
+// the YAML error strings are converted with additional context.
+// position returns the position of a line of the YAML document, 0 if the line is unknown.
+func yamlDecodeError(err error, position func(line int) Position) *Error {
+	yamlErrors := []string{err.Error()}
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		yamlErrors = typeErr.Errors
+	}
 
-		// Create the RAML errors
-		ramlError.Errors =
-			append(ramlError.Errors, convertYAMLError(currErr))
+	ramlError := new(Error)
+	for _, currErr := range yamlErrors {
+		var line int
+		if m := yamlErrorLineRegexp.FindStringSubmatch(currErr); m != nil {
+			line, _ = strconv.Atoi(m[1])
+			currErr = m[2]
+		}
+		ramlError.Errors = append(ramlError.Errors, ErrorDetail{
+			Position: position(line),
+			Message:  convertYAMLError(currErr),
+		})
 	}
+	return ramlError
 }
 
-// Convert a YAML error string into RAML error string, with more context
+// Convert a YAML error message into RAML error message, with more context
 func convertYAMLError(yamlError string) string {
 
 	if strings.Contains(yamlError, "cannot unmarshal") {
 
 		yamlErrorParts := strings.Split(yamlError, " ")
 
-		if len(yamlErrorParts) >= 7 {
-
-			fmt.Println(yamlError)
+		if len(yamlErrorParts) >= 5 {
 
 			var ok bool
 			var source string
 			var target string
 			var targetName string
-			line := yamlErrorParts[1]
-			line = line[:len(line)-1]
 
 			// TODO: support more complex types:
 			// map[string]raml.NamedParameter -->
@@ -94,16 +135,15 @@ func convertYAMLError(yamlError string) string {
 			// would output:
 			//   mapping of name string to named parameter
 
-			if source, ok = yamlTypeToName[yamlErrorParts[4]]; !ok {
-				source = yamlErrorParts[4]
+			if source, ok = yamlTypeToName[yamlErrorParts[2]]; !ok {
+				source = yamlErrorParts[2]
 			}
-			fmt.Println("source: ", source)
 
-			if source == "string" {
-				source = fmt.Sprintf("string (got %s)", yamlErrorParts[5])
-				target = yamlErrorParts[7]
+			if source == "string" && len(yamlErrorParts) >= 6 {
+				source = fmt.Sprintf("string (got %s)", yamlErrorParts[3])
+				target = yamlErrorParts[5]
 			} else {
-				target = yamlErrorParts[6]
+				target = yamlErrorParts[4]
 
 			}
 			if targetName, ok = ramlTypeNames[target]; !ok {
@@ -112,8 +152,8 @@ func convertYAMLError(yamlError string) string {
 
 			target, _ = ramlTypes[target]
 
-			return fmt.Sprintf("line %s: %s cannot be of "+
-				"type %s, must be %s", line, targetName, source, target)
+			return fmt.Sprintf("%s cannot be of "+
+				"type %s, must be %s", targetName, source, target)
 
 		}
 	}
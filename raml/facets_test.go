@@ -0,0 +1,60 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFacets(t *testing.T) {
+	Convey("facets", t, func() {
+		apiDef := new(APIDefinition)
+		So(ParseFile("./samples/facets/api.raml", apiDef), ShouldBeNil)
+		So(Validate(apiDef), ShouldBeEmpty)
+
+		Convey("user defined facets", func() {
+			So(apiDef.Types["CustomDate"].Facets, ShouldResemble, map[string]interface{}{
+				"onlyFutureDates?": "boolean",
+				"noHolidays":       "boolean",
+			})
+			So(apiDef.Types["PossibleMeetingDate"].FacetValues, ShouldResemble, map[string]interface{}{
+				"noHolidays": true,
+			})
+		})
+
+		Convey("number facets", func() {
+			price := apiDef.Types["Price"]
+			So(*price.Minimum, ShouldEqual, 0.5)
+			So(*price.Maximum, ShouldEqual, 99.5)
+			So(*price.MultipleOf, ShouldEqual, 0.5)
+		})
+
+		Convey("additional properties and pattern properties", func() {
+			ext := apiDef.Types["Extensible"]
+			So(ext.AllowsAdditionalProperties(), ShouldBeFalse)
+			So(apiDef.Types["Product"].AllowsAdditionalProperties(), ShouldBeTrue)
+
+			patterns := ext.PatternProperties()
+			So(patterns, ShouldHaveLength, 1)
+			So(patterns[0].NamePattern(), ShouldEqual, "^x-")
+			So(patterns[0].Type, ShouldEqual, "string")
+			So(ToProperty("name", ext.Properties["name"]).IsPattern(), ShouldBeFalse)
+		})
+
+		Convey("invalid facets", func() {
+			apiDef := new(APIDefinition)
+			So(ParseFile("./samples/facets/invalid.raml", apiDef), ShouldBeNil)
+
+			messages := map[string]bool{}
+			for _, d := range Validate(apiDef) {
+				messages[d.Message] = true
+			}
+			So(messages, ShouldContainKey, "facet `pattern` of type `CustomDate` overrides a built-in facet")
+			So(messages, ShouldContainKey, "invalid value of facet `noHolidays` of type `MeetingDate`: value yes please is not of type boolean")
+			So(messages, ShouldContainKey, "unknown facet `onlyFutureDates` of type `MeetingDate`")
+			So(messages, ShouldContainKey, "required facet `noHolidays` of type `HolidayDate` is not set")
+			So(messages, ShouldContainKey, "invalid example of type `Extensible`: property `x-owner`: value team is not of type integer")
+			So(messages, ShouldContainKey, "invalid example of type `Strict`: unknown property `owner`")
+		})
+	})
+}
@@ -135,4 +135,33 @@ func TestInflection(t *testing.T) {
 		}
 	})
 
+	Convey("chained functions", t, func() {
+		dicts := map[string]interface{}{"resourcePathName": "users", "version": 2}
+		var tests = []struct {
+			Param  string
+			Result string
+		}{
+			{"resourcePathName", "users"},
+			{"resourcePathName | !singularize", "user"},
+			{"resourcePathName|!singularize|!uppercamelcase", "User"},
+			{"resourcePathName | !singularize | !pluralize | !uppercase", "USERS"},
+			{"version | !uppercase", "2"},
+		}
+
+		for _, test := range tests {
+			val, ok, err := getParamValue(test.Param, dicts)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+			So(val, ShouldEqual, test.Result)
+		}
+
+		_, ok, err := getParamValue("resourcePath | !uppercase", dicts)
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeFalse)
+
+		_, _, err = getParamValue("resourcePathName | !singularise", dicts)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "!singularise")
+	})
+
 }
@@ -0,0 +1,742 @@
+package raml
+
+// This file contains the support of JSON Schema draft-04 and draft-07
+// in the types and bodies declarations.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// JSONSchema is a JSON Schema document or a subschema of it.
+// Only the keywords which could be expressed using RAML types are kept.
+// see http://json-schema.org/specification-links.html
+type JSONSchema struct {
+	Ref         string        `json:"$ref"`
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Default     interface{}   `json:"default"`
+	Enum        []interface{} `json:"enum"`
+
+	// Type is a type name or a list of type names
+	Type interface{} `json:"type"`
+
+	// ---- object ----
+	Properties           map[string]*JSONSchema `json:"properties"`
+	Required             []string               `json:"required"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties"`
+	MinProperties        *int                   `json:"minProperties"`
+	MaxProperties        *int                   `json:"maxProperties"`
+	Definitions          map[string]*JSONSchema `json:"definitions"`
+
+	// ---- array ----
+	Items       *JSONSchema `json:"items"`
+	MinItems    *int        `json:"minItems"`
+	MaxItems    *int        `json:"maxItems"`
+	UniqueItems bool        `json:"uniqueItems"`
+
+	// ---- string ----
+	Pattern   string `json:"pattern"`
+	MinLength *int   `json:"minLength"`
+	MaxLength *int   `json:"maxLength"`
+	Format    string `json:"format"`
+
+	// ---- number ----
+	Minimum    *float64 `json:"minimum"`
+	Maximum    *float64 `json:"maximum"`
+	MultipleOf *float64 `json:"multipleOf"`
+
+	// boolean in draft-04, number in draft-07
+	ExclusiveMinimum interface{} `json:"exclusiveMinimum"`
+	ExclusiveMaximum interface{} `json:"exclusiveMaximum"`
+
+	// ---- combinations ----
+	AllOf []*JSONSchema `json:"allOf"`
+	AnyOf []*JSONSchema `json:"anyOf"`
+	OneOf []*JSONSchema `json:"oneOf"`
+
+	// false for the `false` boolean schema, which doesn't allow any value
+	allowed bool
+}
+
+// UnmarshalJSON unmarshals a schema which might be:
+// - a JSON object
+// - a boolean schema, `true` allows any value and `false` doesn't allow any value
+func (s *JSONSchema) UnmarshalJSON(data []byte) error {
+	type rawSchema JSONSchema // to avoid recursion
+
+	var allowed bool
+	if err := json.Unmarshal(data, &allowed); err == nil {
+		*s = JSONSchema{allowed: allowed}
+		return nil
+	}
+
+	var rs rawSchema
+	if err := json.Unmarshal(data, &rs); err != nil {
+		return err
+	}
+	*s = JSONSchema(rs)
+	s.allowed = true
+
+	// tuple validation of draft-04, the type of the first item is used
+	var items []*JSONSchema
+	var raw struct {
+		Items json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(data, &raw); err == nil && bytes.HasPrefix(bytes.TrimSpace(raw.Items), []byte("[")) {
+		if err := json.Unmarshal(raw.Items, &items); err != nil {
+			return err
+		}
+		if len(items) > 0 {
+			s.Items = items[0]
+		}
+	}
+	return nil
+}
+
+// ParseJSONSchema parses a JSON schema document
+func ParseJSONSchema(schema string) (*JSONSchema, error) {
+	var s JSONSchema
+	if err := json.Unmarshal([]byte(schema), &s); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %v", err)
+	}
+	return &s, nil
+}
+
+// IsJSONSchema returns true if the value of a type or schema node is a JSON schema
+func IsJSONSchema(s string) bool {
+	s = strings.TrimSpace(s)
+	return strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}")
+}
+
+// JSONSchema returns the JSON schema of a type declared using a JSON schema
+func (t Type) JSONSchema() (string, bool) {
+	for _, v := range []interface{}{t.Type, t.Schema} {
+		if s, ok := v.(string); ok && IsJSONSchema(s) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// types returns the type names of the schema, without `null`.
+// nullable is true if `null` is one of the types.
+func (s *JSONSchema) types() (types []string, nullable bool) {
+	switch v := s.Type.(type) {
+	case string:
+		types = []string{v}
+	case []interface{}:
+		for _, t := range v {
+			if name, ok := t.(string); ok {
+				types = append(types, name)
+			}
+		}
+	}
+	for i := 0; i < len(types); i++ {
+		if types[i] == "null" && len(types) > 1 {
+			types = append(types[:i], types[i+1:]...)
+			nullable = true
+			i--
+		}
+	}
+	if len(types) == 0 {
+		switch {
+		case len(s.Properties) > 0 || s.AdditionalProperties != nil:
+			types = []string{"object"}
+		case s.Items != nil:
+			types = []string{"array"}
+		}
+	}
+	return types, nullable
+}
+
+// isNamed returns true if the schema is converted to a named RAML type
+// when it is used as a property or array items
+func (s *JSONSchema) isNamed() bool {
+	if len(s.AllOf) > 0 || len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
+		return true
+	}
+	types, _ := s.types()
+	return len(types) == 1 && types[0] == "object" && len(s.Properties) > 0
+}
+
+// JSONSchemaTypes converts a JSON schema into RAML type declarations.
+// The schema is declared as type `name`, the definitions referenced using `$ref`
+// and the nested object schemas are declared as types prefixed by `name`,
+// e.g. `#/definitions/address` of `Person` schema is declared as `PersonAddress`.
+func JSONSchemaTypes(name, schema string) (map[string]Type, error) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(schema), &doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema of `%v`: %v", name, err)
+	}
+	root, err := ParseJSONSchema(schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON schema of `%v`: %v", name, err)
+	}
+	c := jsonSchemaConverter{
+		name:  name,
+		doc:   doc,
+		types: map[string]Type{},
+		refs:  map[string]string{"#": name},
+	}
+	if err := c.declare(name, root); err != nil {
+		return nil, fmt.Errorf("JSON schema of `%v`: %v", name, err)
+	}
+	return c.types, nil
+}
+
+// ExpandJSONSchemaTypes returns the types with the types declared using JSON schema
+// replaced by their RAML type declarations, see JSONSchemaTypes
+func ExpandJSONSchemaTypes(types map[string]Type) (map[string]Type, error) {
+	expanded := make(map[string]Type, len(types))
+	for name, t := range types {
+		expanded[name] = t
+	}
+	for _, name := range sortedKeys(types) {
+		schema, ok := types[name].JSONSchema()
+		if !ok {
+			continue
+		}
+		schemaTypes, err := JSONSchemaTypes(name, schema)
+		if err != nil {
+			return nil, err
+		}
+		for typeName, t := range schemaTypes {
+			if _, declared := types[typeName]; declared && typeName != name {
+				return nil, fmt.Errorf("type `%v` of the JSON schema of `%v` is already declared", typeName, name)
+			}
+			if t.Description == "" && typeName == name {
+				t.Description = types[name].Description
+			}
+			expanded[typeName] = t
+		}
+	}
+	return expanded, nil
+}
+
+// jsonSchemaConverter converts a JSON schema into RAML types
+type jsonSchemaConverter struct {
+	name  string            // name of the root type
+	doc   interface{}       // the JSON document, to resolve the references
+	types map[string]Type   // the converted types
+	refs  map[string]string // type names of the resolved references
+}
+
+// declare declares a schema as a named type
+func (c *jsonSchemaConverter) declare(name string, s *JSONSchema) error {
+	if _, ok := c.types[name]; ok {
+		return fmt.Errorf("duplicate type `%v`", name)
+	}
+	c.types[name] = Type{} // reserve the name, the schema might refer to itself
+	t, err := c.toType(name, s)
+	if err != nil {
+		return err
+	}
+	c.types[name] = t
+	return nil
+}
+
+// toType converts a schema to a RAML type declaration
+func (c *jsonSchemaConverter) toType(name string, s *JSONSchema) (Type, error) {
+	t := Type{
+		DisplayName: s.Title,
+		Description: s.Description,
+		Default:     s.Default,
+	}
+
+	switch {
+	case s.Ref != "":
+		ref, err := c.ref(s.Ref)
+		if err != nil {
+			return t, err
+		}
+		t.Type = ref
+		return t, nil
+	case len(s.AllOf) > 0:
+		return c.allOf(t, name, s)
+	case len(s.OneOf) > 0 || len(s.AnyOf) > 0:
+		union, err := c.union(name, append(s.OneOf, s.AnyOf...))
+		t.Type = union
+		return t, err
+	}
+
+	types, _ := s.types()
+	if len(types) != 1 {
+		expr, err := c.typeExpr(name, s)
+		t.Type = expr
+		return t, err
+	}
+
+	switch types[0] {
+	case "object":
+		t.Type = "object"
+		if len(s.Properties) == 0 && s.AdditionalProperties != nil && s.AdditionalProperties.allowed {
+			// a map, e.g. `{ "additionalProperties": { "type": "string" } }`
+			expr, err := c.typeExpr(name+"Value", s.AdditionalProperties)
+			t.Type = expr + "{}"
+			return t, err
+		}
+		props, err := c.properties(name, s)
+		t.Properties = props
+		if s.AdditionalProperties != nil && !s.AdditionalProperties.allowed {
+			t.AdditionalProperties = boolPtr(false)
+		}
+		t.MinProperties = intValue(s.MinProperties)
+		t.MaxProperties = intValue(s.MaxProperties)
+		return t, err
+	case "array":
+		t.Type = "array"
+		if s.Items != nil {
+			items, err := c.typeExpr(name+"Item", s.Items)
+			if err != nil {
+				return t, err
+			}
+			t.Items = items
+		}
+		t.MinItems = intValue(s.MinItems)
+		t.MaxItems = intValue(s.MaxItems)
+		t.UniqueItems = s.UniqueItems
+		return t, nil
+	}
+
+	t.Type = scalarType(types[0], s.Format)
+	if len(s.Enum) > 0 {
+		t.Enum = s.Enum
+	}
+	t.Pattern = s.Pattern
+	t.MinLength = intValue(s.MinLength)
+	t.MaxLength = intValue(s.MaxLength)
+	if min, ok := s.minimum(); ok {
+		t.Minimum = &min
+	}
+	if max, ok := s.maximum(); ok {
+		t.Maximum = &max
+	}
+	t.MultipleOf = s.MultipleOf
+	return t, nil
+}
+
+// allOf converts the `allOf` schemas to inheritance:
+// the referenced schemas are the parents, and the properties of the
+// inline schemas are merged
+func (c *jsonSchemaConverter) allOf(t Type, name string, s *JSONSchema) (Type, error) {
+	var parents []interface{}
+	merged := JSONSchema{Properties: map[string]*JSONSchema{}, allowed: true}
+	for k, v := range s.Properties {
+		merged.Properties[k] = v
+	}
+	merged.Required = append(merged.Required, s.Required...)
+
+	for i, member := range s.AllOf {
+		types, _ := member.types()
+		switch {
+		case member.Ref != "" || member.isNamed() && len(member.Properties) == 0:
+			expr, err := c.typeExpr(fmt.Sprintf("%vParent%v", name, i+1), member)
+			if err != nil {
+				return t, err
+			}
+			parents = append(parents, expr)
+		case len(types) == 0 || types[0] == "object":
+			for k, v := range member.Properties {
+				merged.Properties[k] = v
+			}
+			merged.Required = append(merged.Required, member.Required...)
+		}
+	}
+
+	props, err := c.properties(name, &merged)
+	if err != nil {
+		return t, err
+	}
+	t.Properties = props
+	switch len(parents) {
+	case 0:
+		t.Type = "object"
+	case 1:
+		t.Type = parents[0]
+	default:
+		t.Type = parents
+	}
+	return t, nil
+}
+
+// union returns the union type expression of the `oneOf` or `anyOf` schemas
+func (c *jsonSchemaConverter) union(name string, members []*JSONSchema) (string, error) {
+	exprs := make([]string, 0, len(members))
+	for i, member := range members {
+		expr, err := c.typeExpr(fmt.Sprintf("%vOption%v", name, i+1), member)
+		if err != nil {
+			return "", err
+		}
+		if strings.Contains(expr, "|") {
+			expr = "(" + expr + ")"
+		}
+		exprs = append(exprs, expr)
+	}
+	return strings.Join(exprs, " | "), nil
+}
+
+// properties converts the properties of an object schema
+func (c *jsonSchemaConverter) properties(name string, s *JSONSchema) (map[string]interface{}, error) {
+	if len(s.Properties) == 0 {
+		return nil, nil
+	}
+	props := map[string]interface{}{}
+	for _, propName := range sortedKeys(s.Properties) {
+		prop, err := c.property(name+upperCamelCase(propName), s.Properties[propName], inStringSlice(propName, s.Required))
+		if err != nil {
+			return nil, fmt.Errorf("property `%v`: %v", propName, err)
+		}
+		props[propName] = prop
+	}
+	return props, nil
+}
+
+// property converts a property schema, the same way as it is written in RAML.
+// name is the name of the type declared for a nested object schema.
+func (c *jsonSchemaConverter) property(name string, s *JSONSchema, required bool) (map[interface{}]interface{}, error) {
+	expr, err := c.typeExpr(name, s)
+	if err != nil {
+		return nil, err
+	}
+	_, nullable := s.types()
+	prop := map[interface{}]interface{}{
+		"type":     expr,
+		"required": required && !nullable,
+	}
+	setProp := func(key string, value interface{}, ok bool) {
+		if ok {
+			prop[key] = value
+		}
+	}
+	setProp("description", s.Description, s.Description != "")
+	setProp("default", s.Default, s.Default != nil)
+	if s.Ref != "" || s.isNamed() {
+		return prop, nil
+	}
+	setProp("enum", s.Enum, len(s.Enum) > 0)
+	setProp("pattern", s.Pattern, s.Pattern != "")
+	setProp("minLength", intValue(s.MinLength), s.MinLength != nil)
+	setProp("maxLength", intValue(s.MaxLength), s.MaxLength != nil)
+	min, ok := s.minimum()
+	setProp("minimum", min, ok)
+	max, ok := s.maximum()
+	setProp("maximum", max, ok)
+	if s.MultipleOf != nil {
+		setProp("multipleOf", *s.MultipleOf, true)
+	}
+	setProp("minItems", intValue(s.MinItems), s.MinItems != nil)
+	setProp("maxItems", intValue(s.MaxItems), s.MaxItems != nil)
+	setProp("uniqueItems", true, s.UniqueItems)
+	return prop, nil
+}
+
+// typeExpr returns the type expression of a schema used as a property or array items.
+// The nested object schemas are declared as named types.
+func (c *jsonSchemaConverter) typeExpr(name string, s *JSONSchema) (string, error) {
+	if s.Ref != "" {
+		return c.ref(s.Ref)
+	}
+	if s.isNamed() {
+		return name, c.declare(name, s)
+	}
+
+	types, _ := s.types()
+	exprs := make([]string, 0, len(types))
+	for _, typ := range types {
+		switch typ {
+		case "object":
+			if s.AdditionalProperties != nil && s.AdditionalProperties.allowed {
+				expr, err := c.typeExpr(name+"Value", s.AdditionalProperties)
+				if err != nil {
+					return "", err
+				}
+				exprs = append(exprs, expr+"{}")
+			} else {
+				exprs = append(exprs, "object")
+			}
+		case "array":
+			if s.Items == nil {
+				exprs = append(exprs, "array")
+				continue
+			}
+			expr, err := c.typeExpr(name+"Item", s.Items)
+			if err != nil {
+				return "", err
+			}
+			if strings.Contains(expr, "|") {
+				expr = "(" + expr + ")"
+			}
+			exprs = append(exprs, expr+"[]")
+		default:
+			exprs = append(exprs, scalarType(typ, s.Format))
+		}
+	}
+	if len(exprs) == 0 {
+		return "any", nil
+	}
+	return strings.Join(exprs, " | "), nil
+}
+
+// ref returns the type name of a reference, the referenced schema
+// is declared when it is referenced for the first time.
+// Only the local references are supported, the references to other files
+// are resolved when the file is included.
+func (c *jsonSchemaConverter) ref(ref string) (string, error) {
+	if name, ok := c.refs[ref]; ok {
+		return name, nil
+	}
+	if !strings.HasPrefix(ref, "#") {
+		return "", fmt.Errorf("unsupported reference `%v`, only the local references are supported", ref)
+	}
+
+	// resolve the JSON pointer
+	// https://tools.ietf.org/html/rfc6901
+	v := c.doc
+	name := c.name
+	for _, token := range strings.Split(strings.TrimPrefix(ref[1:], "/"), "/") {
+		token = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+		switch val := v.(type) {
+		case map[string]interface{}:
+			v = val[token]
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(val) {
+				return "", fmt.Errorf("invalid reference `%v`", ref)
+			}
+			v = val[i]
+		default:
+			v = nil
+		}
+		if v == nil {
+			return "", fmt.Errorf("reference `%v` not found", ref)
+		}
+		if token != "definitions" && token != "properties" {
+			name += upperCamelCase(token)
+		}
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	var s JSONSchema
+	if err := json.Unmarshal(b, &s); err != nil {
+		return "", fmt.Errorf("invalid schema `%v`: %v", ref, err)
+	}
+
+	c.refs[ref] = name
+	return name, c.declare(name, &s)
+}
+
+// minimum returns the minimum of a numeric schema,
+// the exclusive minimum of an integer is converted to the inclusive one
+func (s *JSONSchema) minimum() (float64, bool) {
+	min, ok := s.exclusiveBound(s.Minimum, s.ExclusiveMinimum)
+	if ok && s.isExclusive(s.ExclusiveMinimum) && s.isInteger() {
+		min = math.Floor(min) + 1
+	}
+	return min, ok
+}
+
+// maximum returns the maximum of a numeric schema,
+// the exclusive maximum of an integer is converted to the inclusive one
+func (s *JSONSchema) maximum() (float64, bool) {
+	max, ok := s.exclusiveBound(s.Maximum, s.ExclusiveMaximum)
+	if ok && s.isExclusive(s.ExclusiveMaximum) && s.isInteger() {
+		max = math.Ceil(max) - 1
+	}
+	return max, ok
+}
+
+// exclusiveBound returns the bound of a numeric schema:
+// the exclusive bound is a boolean in draft-04 and a number in draft-07
+func (s *JSONSchema) exclusiveBound(bound *float64, exclusive interface{}) (float64, bool) {
+	if v, ok := exclusive.(float64); ok {
+		return v, true
+	}
+	if bound != nil {
+		return *bound, true
+	}
+	return 0, false
+}
+
+func (s *JSONSchema) isExclusive(exclusive interface{}) bool {
+	switch v := exclusive.(type) {
+	case bool:
+		return v
+	case float64:
+		return true
+	}
+	return false
+}
+
+func (s *JSONSchema) isInteger() bool {
+	types, _ := s.types()
+	return len(types) == 1 && types[0] == "integer"
+}
+
+// scalarType returns the RAML type of a JSON schema scalar type
+func scalarType(typ, format string) string {
+	switch typ {
+	case "null":
+		return "nil"
+	case "string":
+		switch format {
+		case "date-time":
+			return "datetime"
+		case "date":
+			return "date-only"
+		case "time":
+			return "time-only"
+		}
+	}
+	return typ
+}
+
+func intValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// resolveJSONSchemaRefs resolves the references to other files of an included
+// JSON schema, which are relative to the schema file.
+// The referenced files are put under the `definitions` of the schema
+// and the references are replaced by local references, e.g. `address.json#/definitions/street`
+// becomes `#/definitions/address/definitions/street`.
+// The schema is returned unchanged if it doesn't refer to other files.
+func (p *Parser) resolveJSONSchemaRefs(file string, data []byte) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := unmarshalJSONNumber(data, &doc); err != nil || !hasExternalRef(doc) {
+		return data, nil // not a JSON schema
+	}
+
+	r := jsonSchemaRefResolver{
+		p:        p,
+		files:    map[string]string{},
+		resolved: map[string]interface{}{},
+	}
+	if defs, ok := doc["definitions"].(map[string]interface{}); ok {
+		for name := range defs {
+			r.resolved[name] = defs[name]
+		}
+	}
+	if err := r.resolve(doc, file, ""); err != nil {
+		return nil, err
+	}
+	if len(r.resolved) > 0 {
+		doc["definitions"] = r.resolved
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// jsonSchemaRefResolver resolves the references to other files of a JSON schema
+type jsonSchemaRefResolver struct {
+	p        *Parser
+	files    map[string]string      // definition names of the resolved files
+	resolved map[string]interface{} // definitions of the root schema
+}
+
+// resolve replaces the references under v, which is found in file.
+// prefix is the JSON pointer of the file schema in the root schema.
+func (r *jsonSchemaRefResolver) resolve(v interface{}, file, prefix string) error {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if ref, ok := val["$ref"].(string); ok && !strings.Contains(ref, "://") {
+			i := strings.Index(ref, "#")
+			if i < 0 {
+				i = len(ref)
+			}
+			target, fragment := ref[:i], strings.TrimPrefix(ref[i:], "#")
+			if target == "" {
+				val["$ref"] = "#" + prefix + fragment
+			} else {
+				name, err := r.resolveFile(r.p.join(r.p.dir(file), target))
+				if err != nil {
+					return err
+				}
+				val["$ref"] = "#/definitions/" + name + fragment
+			}
+		}
+		for k, child := range val {
+			if k == "enum" || k == "default" || k == "examples" {
+				continue
+			}
+			if err := r.resolve(child, file, prefix); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, child := range val {
+			if err := r.resolve(child, file, prefix); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolveFile puts a referenced file into the definitions of the root schema,
+// it returns the definition name
+func (r *jsonSchemaRefResolver) resolveFile(file string) (string, error) {
+	if name, ok := r.files[file]; ok {
+		return name, nil
+	}
+	data, err := r.p.readFileContents(file)
+	if err != nil {
+		return "", err
+	}
+	var doc interface{}
+	if err := unmarshalJSONNumber(data, &doc); err != nil {
+		return "", fmt.Errorf("invalid JSON schema %v: %v", file, err)
+	}
+
+	// unique definition name from the file name
+	base := strings.TrimSuffix(path.Base(file), path.Ext(file))
+	name := base
+	for i := 2; ; i++ {
+		if _, exists := r.resolved[name]; !exists {
+			break
+		}
+		name = fmt.Sprintf("%v%v", base, i)
+	}
+	r.files[file] = name
+	r.resolved[name] = doc
+	return name, r.resolve(doc, file, "/definitions/"+name)
+}
+
+// hasExternalRef returns true if a JSON document has a reference to other file
+func hasExternalRef(v interface{}) bool {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if ref, ok := val["$ref"].(string); ok && !strings.HasPrefix(ref, "#") && !strings.Contains(ref, "://") {
+			return true
+		}
+		for _, k := range sortedKeys(val) {
+			if hasExternalRef(val[k]) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, child := range val {
+			if hasExternalRef(child) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unmarshalJSONNumber unmarshals JSON document and keeps the numbers as is
+func unmarshalJSONNumber(data []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	return d.Decode(v)
+}
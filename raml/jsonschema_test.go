@@ -0,0 +1,96 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestJSONSchema(t *testing.T) {
+	Convey("JSON schema", t, func() {
+		apiDef := new(APIDefinition)
+		So(ParseFile("./samples/jsonschema/api.raml", apiDef), ShouldBeNil)
+		So(Validate(apiDef), ShouldBeEmpty)
+
+		types, err := ExpandJSONSchemaTypes(apiDef.Types)
+		So(err, ShouldBeNil)
+
+		Convey("schema is kept as is", func() {
+			schema, ok := apiDef.Types["Pet"].JSONSchema()
+			So(ok, ShouldBeTrue)
+			So(schema, ShouldContainSubstring, `"title": "A pet"`)
+		})
+
+		Convey("object", func() {
+			pet := types["Pet"]
+			So(pet.Type, ShouldEqual, "object")
+			So(pet.DisplayName, ShouldEqual, "A pet")
+			So(pet.AllowsAdditionalProperties(), ShouldBeFalse)
+
+			props := map[string]Property{}
+			for name, p := range pet.Properties {
+				props[name] = ToProperty(name, p)
+			}
+			So(props["name"].Required, ShouldBeTrue)
+			So(*props["name"].MaxLength, ShouldEqual, 64)
+			So(props["age"].Required, ShouldBeFalse)
+			So(*props["age"].Minimum, ShouldEqual, 1) // exclusive minimum of integer
+			So(props["kind"].Enum, ShouldResemble, []interface{}{"cat", "dog"})
+			So(props["nickname"].Type, ShouldEqual, "string")
+			So(props["nickname"].Required, ShouldBeFalse) // nullable
+			So(props["tags"].Type, ShouldEqual, "PetTag[]")
+			So(props["tags"].UniqueItems, ShouldBeTrue)
+		})
+
+		Convey("references", func() {
+			So(types["PetTag"].Properties, ShouldContainKey, "label")
+
+			// references to other files are resolved when the schema is included
+			So(ToProperty("id", types["Pet"].Properties["id"]).Type, ShouldEqual, "PetCommonId")
+			So(types["PetCommonId"].Type, ShouldEqual, "string")
+			So(types["PetCommonId"].Pattern, ShouldEqual, "^[a-z0-9]+$")
+
+			// local references of the other file
+			audit := types["PetCommonAudit"]
+			So(ToProperty("createdBy", audit.Properties["createdBy"]).Type, ShouldEqual, "PetCommonId")
+			So(ToProperty("createdAt", audit.Properties["createdAt"]).Type, ShouldEqual, "datetime")
+		})
+
+		Convey("combinations", func() {
+			owner := types["Owner"]
+			So(owner.Type, ShouldEqual, "OwnerCommonAudit")
+			So(owner.Properties, ShouldContainKey, "name")
+
+			prop := func(name string) Property {
+				return ToProperty(name, owner.Properties[name])
+			}
+			So(prop("pets").Type, ShouldEqual, "OwnerPet[]")
+			So(prop("labels").Type, ShouldEqual, "string{}")
+			So(prop("address").Type, ShouldEqual, "OwnerAddress")
+			So(prop("contact").Type, ShouldEqual, "OwnerContact")
+			So(types["OwnerContact"].Type, ShouldEqual, "OwnerEmail | OwnerContactOption2")
+			So(types["OwnerContactOption2"].Properties, ShouldContainKey, "phone")
+		})
+
+		Convey("body", func() {
+			body := apiDef.Resources["/pets"].Post.Bodies.ApplicationJSON
+			So(IsJSONSchema(body.Type), ShouldBeTrue)
+
+			bodyTypes, err := JSONSchemaTypes("PetsPostReqBody", body.Type)
+			So(err, ShouldBeNil)
+			So(bodyTypes, ShouldContainKey, "PetsPostReqBodyTag")
+			So(ToProperty("tags", bodyTypes["PetsPostReqBody"].Properties["tags"]).Type, ShouldEqual, "PetsPostReqBodyTag[]")
+		})
+
+		Convey("invalid schema", func() {
+			_, err := JSONSchemaTypes("Invalid", `{ "properties": { "a": { "$ref": "#/definitions/missing" } } }`)
+			So(err, ShouldNotBeNil)
+
+			_, err = JSONSchemaTypes("Invalid", `{ "type": "object",`)
+			So(err, ShouldNotBeNil)
+
+			apiDef.Types["Invalid"] = Type{Type: `{ "items": { "$ref": "other.json#/a" } }`}
+			So(HasErrors(Validate(apiDef)), ShouldBeTrue)
+		})
+	})
+}
@@ -1,10 +1,5 @@
 package raml
 
-import (
-	"fmt"
-	"path/filepath"
-)
-
 // Library is used to combine any collection of data type declarations,
 // resource type declarations, trait declarations, and security scheme declarations
 // into modular, externalized, reusable groups.
@@ -15,32 +10,37 @@ type Library struct {
 	ResourceTypes   map[string]ResourceType   `yaml:"resourceTypes"`
 	Traits          map[string]Trait          `yaml:"traits"`
 	SecuritySchemes map[string]SecurityScheme `yaml:"securitySchemes"`
+	AnnotationTypes map[string]AnnotationType `yaml:"annotationTypes"`
 	Uses            map[string]string         `yaml:"uses"`
 
 	// Describes the content or purpose of a specific library.
 	// The value is a string and MAY be formatted using markdown.
 	Usage string `yaml:"usage"`
 
+	// Annotations to be applied to this library.
+	Annotations Annotations `yaml:",regexp:^\\(.*\\)$"`
+
 	Libraries map[string]*Library `yaml:"-"`
 	Filename  string              `yaml:"-"`
 }
 
+func (l *Library) libraryFiles() map[string]string {
+	return l.Uses
+}
+
+func (l *Library) setLibraries(libs map[string]*Library) {
+	l.Libraries = libs
+}
+
 // PostProcess doing additional processing
 // that couldn't be done by yaml parser such as :
 // - inheritance
 // - setting some additional values not exist in the .raml
 // - allocate map fields
 func (l *Library) PostProcess(fileName string) error {
-	// libraries
-	l.Libraries = map[string]*Library{}
-	for name, path := range l.Uses {
-		lib := &Library{Filename: path}
-		if err := ParseFile(filepath.Join(filepath.Dir(fileName), path), lib); err != nil {
-			return fmt.Errorf("l.PostProcess() failed to parse library	name=%v, path=%v, err=%v",
-				name, path, err)
-		}
-		l.Libraries[name] = lib
-
+	// libraries are parsed by the parser
+	if l.Libraries == nil {
+		l.Libraries = map[string]*Library{}
 	}
 
 	// traits
@@ -50,9 +50,25 @@ func (l *Library) PostProcess(fileName string) error {
 	}
 
 	// resource types
+	traits := allTraits(l.Traits, l.Libraries)
 	for name, rt := range l.ResourceTypes {
-		rt.postProcess(name, l.Traits)
+		if err := rt.postProcess(name, traits); err != nil {
+			return err
+		}
 		l.ResourceTypes[name] = rt
 	}
-	return nil
+	resourceTypes := allResourceTypes(l.ResourceTypes, l.Libraries)
+	if err := inheritResourceTypes(resourceTypes); err != nil {
+		return err
+	}
+	for name := range l.ResourceTypes {
+		l.ResourceTypes[name] = resourceTypes[name]
+	}
+
+	// annotations
+	for name, at := range l.AnnotationTypes {
+		at.Name = name
+		l.AnnotationTypes[name] = at
+	}
+	return l.postProcessAnnotations(fileName)
 }
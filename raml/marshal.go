@@ -0,0 +1,895 @@
+package raml
+
+// This file contains the writer of RAML documents.
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gigforks/yaml"
+)
+
+const fragmentLibrary = "Library"
+
+// Marshal writes an API definition as RAML 1.0 document in the canonical form:
+// - the properties are written in a fixed order
+// - the declarations and the resources are sorted by name
+// - the properties inherited from resource types and traits are omitted
+// - the included files are inlined
+// The libraries are not written, they are only referred by `uses`.
+func Marshal(apiDef *APIDefinition) ([]byte, error) {
+	w := marshaler{
+		traits:        allTraits(apiDef.Traits, apiDef.Libraries),
+		resourceTypes: allResourceTypes(apiDef.ResourceTypes, apiDef.Libraries),
+	}
+	doc, err := w.apiDefinition(apiDef)
+	if err != nil {
+		return nil, err
+	}
+	return marshalDocument(ramlVersion10, doc)
+}
+
+// MarshalLibrary writes a library as RAML 1.0 library in the canonical form,
+// see Marshal.
+func MarshalLibrary(lib *Library) ([]byte, error) {
+	w := marshaler{
+		traits:        allTraits(lib.Traits, lib.Libraries),
+		resourceTypes: allResourceTypes(lib.ResourceTypes, lib.Libraries),
+	}
+	doc, err := w.library(lib)
+	if err != nil {
+		return nil, err
+	}
+	return marshalDocument(ramlVersion10+" "+fragmentLibrary, doc)
+}
+
+// FormatFile parses an API definition or library file
+// and writes it in the canonical form, see Marshal.
+func FormatFile(filePath string) ([]byte, error) {
+	return NewParser(nil).Format(filePath)
+}
+
+// Format parses an API definition or library file
+// and writes it in the canonical form, see Marshal.
+func (p *Parser) Format(filePath string) ([]byte, error) {
+	filePos := Position{File: filePath}
+
+	version, fragment, _, err := p.readRAMLFile(filePath)
+	if err != nil {
+		return nil, toRAMLError(err, filePos)
+	}
+	if version != ramlVersion10 {
+		return nil, newError(filePos, "%v is not a RAML 1.0 file, it could be upgraded using the upgrade command", filePath)
+	}
+
+	switch fragment {
+	case "":
+		apiDef := new(APIDefinition)
+		if err := p.ParseFile(filePath, apiDef); err != nil {
+			return nil, err
+		}
+		return Marshal(apiDef)
+	case fragmentLibrary:
+		lib := &Library{Filename: filePath}
+		if err := p.ParseFile(filePath, lib); err != nil {
+			return nil, err
+		}
+		return MarshalLibrary(lib)
+	default:
+		return nil, newError(filePos, "can't format RAML %v fragment", fragment)
+	}
+}
+
+// marshalDocument writes a RAML document with the given RAML version line
+func marshalDocument(header string, doc yaml.MapSlice) ([]byte, error) {
+	b, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(header+"\n"), b...), nil
+}
+
+// marshaler converts the RAML types to YAML nodes.
+// The traits and resource types are needed to find the inherited properties.
+type marshaler struct {
+	traits        map[string]Trait
+	resourceTypes map[string]ResourceType
+
+	// resolved is true if the resource types and traits are already applied,
+	// so all properties of the resources are written, without
+	// the resource types, the traits, and the references to them.
+	resolved bool
+
+	// rename renames the references to types, annotation types and security schemes,
+	// nil if the references are written as is.
+	rename func(string) string
+}
+
+// mapping is a YAML mapping which omits the empty values
+type mapping yaml.MapSlice
+
+// set sets the value of a key if the value is not empty.
+// A non nil pointer is written as the pointed value.
+func (m *mapping) set(key interface{}, val interface{}) {
+	v := reflect.ValueOf(val)
+	if !v.IsValid() {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		*m = append(*m, yaml.MapItem{Key: key, Value: v.Elem().Interface()})
+		return
+	case reflect.Map, reflect.Slice, reflect.String:
+		if v.Len() == 0 {
+			return
+		}
+	default:
+		if v.IsZero() {
+			return
+		}
+	}
+	*m = append(*m, yaml.MapItem{Key: key, Value: val})
+}
+
+// setAny sets the value of a key of any type, only nil value is omitted.
+// It is used for the values like example and default,
+// in which zero values like false and 0 are meaningful.
+func (m *mapping) setAny(key interface{}, val interface{}) {
+	if val == nil {
+		return
+	}
+	*m = append(*m, yaml.MapItem{Key: key, Value: val})
+}
+
+// append appends the items of other mapping
+func (m *mapping) append(other mapping) {
+	*m = append(*m, other...)
+}
+
+func (w *marshaler) apiDefinition(apiDef *APIDefinition) (yaml.MapSlice, error) {
+	var m mapping
+	m.set("title", apiDef.Title)
+	m.set("version", apiDef.Version)
+	m.set("baseUri", apiDef.BaseURI)
+	m.set("baseUriParameters", w.namedParameters(apiDef.BaseURIParameters, nil))
+	m.set("protocols", apiDef.Protocols)
+	m.setAny("mediaType", apiDef.MediaType.value())
+	m.set("documentation", documentation(apiDef.Documentation))
+	m.set("schemas", schemas(apiDef.Schemas))
+	if w.resolved {
+		// the declarations of the libraries are written in the document
+		decls, err := w.bundleDecls(apiDef)
+		if err != nil {
+			return nil, err
+		}
+		m.append(decls)
+	} else {
+		m.set("uses", sortedStrings(apiDef.Uses))
+		m.set("types", w.typeDecls(apiDef.Types))
+		m.set("traits", w.traitDecls(apiDef.Traits))
+
+		rts, err := w.resourceTypeDecls(apiDef.ResourceTypes)
+		if err != nil {
+			return nil, err
+		}
+		m.set("resourceTypes", rts)
+
+		m.set("annotationTypes", w.annotationTypeDecls(apiDef.AnnotationTypes))
+		m.set("securitySchemes", w.securitySchemeDecls(apiDef.SecuritySchemes))
+	}
+	m.set("securedBy", w.definitionChoices(apiDef.SecuredBy))
+	m.append(w.annotations(apiDef.Annotations))
+
+	resources := make(map[string]*Resource, len(apiDef.Resources))
+	for uri := range apiDef.Resources {
+		r := apiDef.Resources[uri]
+		resources[uri] = &r
+	}
+	rs, err := w.resources(resources)
+	if err != nil {
+		return nil, err
+	}
+	m.append(rs)
+	return yaml.MapSlice(m), nil
+}
+
+func (w *marshaler) library(lib *Library) (yaml.MapSlice, error) {
+	var m mapping
+	m.set("usage", lib.Usage)
+	m.set("uses", sortedStrings(lib.Uses))
+	m.set("types", w.typeDecls(lib.Types))
+	m.set("traits", w.traitDecls(lib.Traits))
+
+	rts, err := w.resourceTypeDecls(lib.ResourceTypes)
+	if err != nil {
+		return nil, err
+	}
+	m.set("resourceTypes", rts)
+
+	m.set("annotationTypes", w.annotationTypeDecls(lib.AnnotationTypes))
+	m.set("securitySchemes", w.securitySchemeDecls(lib.SecuritySchemes))
+	m.append(w.annotations(lib.Annotations))
+	return yaml.MapSlice(m), nil
+}
+
+// resources writes the resources sorted by the URI
+func (w *marshaler) resources(resources map[string]*Resource) (mapping, error) {
+	var m mapping
+	for _, uri := range sortedKeys(resources) {
+		r, err := w.resource(resources[uri])
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, yaml.MapItem{Key: uri, Value: r})
+	}
+	return m, nil
+}
+
+// resource writes a resource without the properties inherited
+// from it's resource type and traits
+func (w *marshaler) resource(r *Resource) (yaml.MapSlice, error) {
+	base := &Resource{}
+	var rt *ResourceType
+	if !w.resolved {
+		var err error
+		if base, err = w.inheritedResource(r); err != nil {
+			return nil, err
+		}
+		if rt, err = base.getResourceType(w.resourceTypes); err != nil {
+			return nil, err
+		}
+	}
+
+	var m mapping
+	m.set("displayName", r.DisplayName)
+	m.set("description", omitInherited(r.Description, base.Description))
+	m.append(w.annotations(r.Annotations))
+	if !w.resolved {
+		m.set("type", w.definitionChoice(r.Type))
+		m.set("is", w.definitionChoices(r.Is))
+	}
+	m.set("securedBy", w.definitionChoices(r.SecuredBy))
+	m.set("uriParameters", w.namedParameters(r.URIParameters, base.URIParameters))
+
+	for _, name := range methodNames {
+		method := r.MethodByName(name)
+		if method == nil {
+			continue
+		}
+		mm := w.method(method, base.MethodByName(name))
+
+		// a method which only has the inherited properties
+		// is implicitly declared by the resource type
+		if len(mm) == 0 && rt != nil && hasMethod(rt.methods, name) {
+			continue
+		}
+		m = append(m, yaml.MapItem{Key: strings.ToLower(name), Value: yaml.MapSlice(mm)})
+	}
+
+	nested, err := w.resources(r.Nested)
+	if err != nil {
+		return nil, err
+	}
+	m.append(nested)
+	return yaml.MapSlice(m), nil
+}
+
+// inheritedResource returns a resource which only declares the resource type,
+// the traits, and the methods of the given resource.
+// So it only has the properties inherited by the given resource.
+func (w *marshaler) inheritedResource(r *Resource) (*Resource, error) {
+	base := &Resource{
+		Position: r.Position,
+		URI:      r.URI,
+		Parent:   r.Parent,
+		Type:     r.Type,
+		Is:       r.Is,
+	}
+	for _, name := range methodNames {
+		if m := r.MethodByName(name); m != nil {
+			base.assignMethod(&Method{Position: m.Position, Name: name, Is: m.Is}, name)
+		}
+	}
+	if err := base.inherit(w.resourceTypes, w.traits); err != nil {
+		return nil, err
+	}
+	base.postProcessNamedParameters()
+	return base, nil
+}
+
+// resourceTypeDecls writes the resource types declarations sorted by name
+func (w *marshaler) resourceTypeDecls(resourceTypes map[string]ResourceType) (yaml.MapSlice, error) {
+	var m mapping
+	for _, name := range sortedKeys(resourceTypes) {
+		rt, err := w.resourceType(name, resourceTypes[name])
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, yaml.MapItem{Key: name, Value: rt})
+	}
+	return yaml.MapSlice(m), nil
+}
+
+// resourceType writes a resource type without the properties inherited
+// from it's parent resource type and traits
+func (w *marshaler) resourceType(name string, rt ResourceType) (yaml.MapSlice, error) {
+	base, parent, err := w.inheritedResourceType(name, rt)
+	if err != nil {
+		return nil, err
+	}
+
+	var m mapping
+	m.set("usage", rt.Usage)
+	m.set("description", omitInherited(rt.Description, base.Description))
+	m.set("type", w.definitionChoice(rt.Type))
+	m.set("is", w.definitionChoices(rt.Is))
+	m.set("uriParameters", w.namedParameters(rt.URIParameters, base.URIParameters))
+	m.set("uriParameters?", w.namedParameters(rt.OptionalURIParameters, nil))
+	m.set("baseUriParameters", w.namedParameters(rt.BaseURIParameters, base.BaseURIParameters))
+	m.set("baseUriParameters?", w.namedParameters(rt.OptionalBaseURIParameters, nil))
+
+	for _, optional := range []bool{false, true} {
+		for _, methodName := range methodNames {
+			method := *rt.methodField(methodName, optional)
+			if method == nil {
+				continue
+			}
+			mm := w.method(method, *base.methodField(methodName, optional))
+
+			// a method which only has the inherited properties
+			// is implicitly declared by the parent resource type
+			if len(mm) == 0 && parent != nil {
+				parentMethods := parent.methods
+				if optional {
+					parentMethods = parent.optionalMethods
+				}
+				if hasMethod(parentMethods, methodName) {
+					continue
+				}
+			}
+
+			key := strings.ToLower(methodName)
+			if optional {
+				key += "?"
+			}
+			m = append(m, yaml.MapItem{Key: key, Value: yaml.MapSlice(mm)})
+		}
+	}
+	return yaml.MapSlice(m), nil
+}
+
+// inheritedResourceType returns a resource type which only declares the parent resource type,
+// the traits, and the methods of the given resource type.
+// So it only has the properties inherited by the given resource type.
+// It also returns the parent resource type, if any.
+func (w *marshaler) inheritedResourceType(name string, rt ResourceType) (*ResourceType, *ResourceType, error) {
+	base := &ResourceType{
+		Position: rt.Position,
+		Type:     rt.Type,
+		Is:       rt.Is,
+	}
+	for _, methodName := range methodNames {
+		for _, optional := range []bool{false, true} {
+			if m := *rt.methodField(methodName, optional); m != nil {
+				*base.methodField(methodName, optional) = &Method{Position: m.Position, Name: methodName, Is: m.Is}
+			}
+		}
+	}
+	if err := base.postProcess(name, w.traits); err != nil {
+		return nil, nil, err
+	}
+
+	if rt.Type == nil || rt.Type.Name == "" {
+		return base, nil, nil
+	}
+	parent, ok := w.resourceTypes[rt.Type.Name]
+	if !ok {
+		return nil, nil, newError(rt.Position, "can't find resource type named :%v", rt.Type.Name)
+	}
+	if err := base.inherit(&parent, copyDicts(rt.Type.Parameters)); err != nil {
+		return nil, nil, err
+	}
+	return base, &parent, nil
+}
+
+// method writes a method without the properties inherited
+// by the base method, which only has the inherited properties.
+func (w *marshaler) method(method, base *Method) mapping {
+	if base == nil {
+		base = &Method{}
+	}
+	var m mapping
+	m.set("displayName", method.DisplayName)
+	m.set("description", omitInherited(method.Description, base.Description))
+	m.append(w.annotations(method.Annotations))
+	if !w.resolved {
+		m.set("is", w.definitionChoices(method.Is))
+	}
+	m.set("securedBy", w.definitionChoices(method.SecuredBy))
+
+	var protocols []string
+	for _, p := range method.Protocols {
+		if !inStringSlice(p, base.Protocols) {
+			protocols = append(protocols, p)
+		}
+	}
+	m.set("protocols", protocols)
+
+	m.set("queryParameters", w.namedParameters(method.QueryParameters, base.QueryParameters))
+	if !reflect.DeepEqual(method.QueryString, base.QueryString) {
+		m.set("queryString", w.queryString(method.QueryString))
+	}
+	m.set("headers", w.headers(method.Headers, base.Headers))
+	if !reflect.DeepEqual(method.Bodies, base.Bodies) {
+		m.set("body", w.bodies(method.Bodies))
+	}
+	m.set("responses", w.responses(method.Responses, base.Responses))
+	return m
+}
+
+func (w *marshaler) traitDecls(traits map[string]Trait) yaml.MapSlice {
+	var m mapping
+	for _, name := range sortedKeys(traits) {
+		m = append(m, yaml.MapItem{Key: name, Value: w.trait(traits[name])})
+	}
+	return yaml.MapSlice(m)
+}
+
+func (w *marshaler) trait(t Trait) yaml.MapSlice {
+	var m mapping
+	m.set("usage", t.Usage)
+	m.set("description", t.Description)
+	m.set("protocols", t.Protocols)
+	m.set("queryParameters", w.namedParameters(t.QueryParameters, nil))
+	m.set("queryParameters?", w.namedParameters(t.OptionalQueryParameters, nil))
+	m.set("queryString", w.queryString(t.QueryString))
+	m.set("headers", w.headers(t.Headers, nil))
+	m.set("headers?", w.headers(t.OptionalHeaders, nil))
+	m.set("body", w.bodies(t.Bodies))
+	m.set("body?", w.bodies(t.OptionalBodies))
+	m.set("responses", w.responses(t.Responses, nil))
+	m.set("responses?", w.responses(t.OptionalResponses, nil))
+	return yaml.MapSlice(m)
+}
+
+// namedParameters writes the named parameters sorted by name,
+// the parameters which are equal to the inherited parameters are omitted.
+func (w *marshaler) namedParameters(params, inherited map[string]NamedParameter) yaml.MapSlice {
+	var m mapping
+	for _, name := range sortedKeys(params) {
+		np := params[name]
+		if ip, ok := inherited[name]; ok && reflect.DeepEqual(np, ip) {
+			continue
+		}
+		m = append(m, yaml.MapItem{Key: name, Value: w.namedParameter(np)})
+	}
+	return yaml.MapSlice(m)
+}
+
+// namedParameter writes a named parameter, the display name defaulting to
+// the name and the default `required: true` are omitted
+func (w *marshaler) namedParameter(np NamedParameter) yaml.MapSlice {
+	m := mapping{}
+	if np.DisplayName != np.Name {
+		m.set("displayName", np.DisplayName)
+	}
+	m.set("description", np.Description)
+	m.set("type", w.typeExpr(np.Type))
+	m.setAny("enum", np.Enum)
+	m.set("pattern", np.Pattern)
+	m.set("minLength", np.MinLength)
+	m.set("maxLength", np.MaxLength)
+	m.set("minimum", np.Minimum)
+	m.set("maximum", np.Maximum)
+	m.set("multipleOf", np.MultipleOf)
+	m.set("format", np.Format)
+	m.set("items", w.typeExpr(np.Items))
+	m.set("minItems", np.MinItems)
+	m.set("maxItems", np.MaxItems)
+	m.set("uniqueItems", np.UniqueItems)
+	m.setAny("example", np.Example)
+	m.set("examples", sortedValues(np.Examples))
+	m.set("repeat", np.Repeat)
+	if !np.Required {
+		m.setAny("required", false)
+	}
+	m.setAny("default", np.Default)
+	return yaml.MapSlice(m)
+}
+
+func (w *marshaler) headers(hs, inherited map[HTTPHeader]Header) yaml.MapSlice {
+	params := make(map[string]NamedParameter, len(hs))
+	for name, h := range hs {
+		params[string(name)] = NamedParameter(h)
+	}
+	inheritedParams := make(map[string]NamedParameter, len(inherited))
+	for name, h := range inherited {
+		inheritedParams[string(name)] = NamedParameter(h)
+	}
+	return w.namedParameters(params, inheritedParams)
+}
+
+// responses writes the responses sorted by the status code,
+// the responses which are equal to the inherited responses are omitted.
+func (w *marshaler) responses(resps, inherited map[HTTPCode]Response) yaml.MapSlice {
+	codes := make([]string, 0, len(resps))
+	for code := range resps {
+		codes = append(codes, string(code))
+	}
+	sort.Strings(codes)
+
+	var m mapping
+	for _, code := range codes {
+		resp := resps[HTTPCode(code)]
+		if ir, ok := inherited[HTTPCode(code)]; ok && reflect.DeepEqual(resp, ir) {
+			continue
+		}
+		var key interface{} = code
+		if c, err := strconv.Atoi(code); err == nil {
+			key = c
+		}
+		m = append(m, yaml.MapItem{Key: key, Value: w.response(resp)})
+	}
+	return yaml.MapSlice(m)
+}
+
+func (w *marshaler) response(resp Response) yaml.MapSlice {
+	m := mapping{}
+	m.set("description", resp.Description)
+	m.append(w.annotations(resp.Annotations))
+	m.set("headers", w.headers(resp.Headers, nil))
+	m.set("body", w.bodies(resp.Bodies))
+	return yaml.MapSlice(m)
+}
+
+// bodies writes the bodies, which could be a single body of the default media type
+// or the bodies of the media types.
+func (w *marshaler) bodies(b Bodies) yaml.MapSlice {
+	var m mapping
+	m.set("type", w.typeExpr(b.Type))
+	m.set("schema", w.typeExpr(b.Schema))
+	m.set("description", b.Description)
+	m.set("example", b.Example)
+	m.set("properties", w.properties(b.Properties))
+	m.append(w.annotations(b.Annotations))
+	if b.ApplicationJSON != nil {
+		m = append(m, yaml.MapItem{Key: "application/json", Value: w.bodiesProperty(*b.ApplicationJSON)})
+	}
+	if b.ApplicationXML != nil {
+		m = append(m, yaml.MapItem{Key: "application/xml", Value: w.body(*b.ApplicationXML)})
+	}
+	for _, mediaType := range sortedKeys(b.ForMIMEType) {
+		m = append(m, yaml.MapItem{Key: mediaType, Value: w.body(b.ForMIMEType[mediaType])})
+	}
+	return yaml.MapSlice(m)
+}
+
+func (w *marshaler) bodiesProperty(bp BodiesProperty) yaml.MapSlice {
+	m := mapping{}
+	m.set("type", w.typeExpr(bp.Type))
+	m.set("properties", w.properties(bp.Properties))
+	m.setAny("example", bp.Example)
+	m.set("examples", sortedValues(bp.Examples))
+	m.append(w.annotations(bp.Annotations))
+	return yaml.MapSlice(m)
+}
+
+func (w *marshaler) body(b Body) yaml.MapSlice {
+	m := mapping{}
+	m.set("type", w.typeExpr(b.Type))
+	m.set("schema", w.typeExpr(b.Schema))
+	m.set("description", b.Description)
+	m.set("example", b.Example)
+	m.set("properties", w.properties(b.Properties))
+	m.set("headers", w.headers(b.Headers, nil))
+	m.append(w.annotations(b.Annotations))
+	return yaml.MapSlice(m)
+}
+
+func (w *marshaler) typeDecls(types map[string]Type) yaml.MapSlice {
+	var m mapping
+	for _, name := range sortedKeys(types) {
+		m = append(m, yaml.MapItem{Key: name, Value: w.typeDecl(types[name])})
+	}
+	return yaml.MapSlice(m)
+}
+
+// typeDecl writes a type declaration.
+// A declaration which only has the type expression, or only an XML schema,
+// is written as a string.
+func (w *marshaler) typeDecl(t Type) interface{} {
+	m := mapping{}
+	if s, ok := t.Type.(string); !ok || s != "" {
+		m.setAny("type", w.typeValue(t.Type))
+	}
+	m.setAny("schema", t.Schema)
+	m.set("displayName", t.DisplayName)
+	m.set("description", t.Description)
+	m.append(w.annotations(t.Annotations))
+	m.setAny("default", t.Default)
+	m.setAny("example", t.Example)
+	m.set("examples", sortedValues(t.Examples))
+	m.set("properties", w.properties(t.Properties))
+	m.set("minProperties", t.MinProperties)
+	m.set("maxProperties", t.MaxProperties)
+	m.set("additionalProperties", t.AdditionalProperties)
+	m.set("discriminator", t.Discriminator)
+	m.set("discriminatorValue", t.DiscriminatorValue)
+	m.setAny("items", w.typeValue(t.Items))
+	m.set("minItems", t.MinItems)
+	m.set("maxItems", t.MaxItems)
+	m.set("uniqueItems", t.UniqueItems)
+	m.setAny("enum", t.Enum)
+	m.set("pattern", t.Pattern)
+	m.set("minLength", t.MinLength)
+	m.set("maxLength", t.MaxLength)
+	m.set("minimum", t.Minimum)
+	m.set("maximum", t.Maximum)
+	m.set("format", t.Format)
+	m.set("multipleOf", t.MultipleOf)
+	m.set("fileTypes", t.FileTypes)
+	m.set("xml", t.XML)
+	m.set("facets", sortedValues(t.Facets))
+	m.append(mapping(sortedValues(t.FacetValues)))
+
+	if len(m) == 1 {
+		if s, ok := m[0].Value.(string); ok && (m[0].Key == "type" || m[0].Key == "schema") {
+			return s
+		}
+	}
+	return yaml.MapSlice(m)
+}
+
+// properties writes the properties of an object type sorted by name
+// queryString writes the type declaration of a query string
+func (w *marshaler) queryString(qs *Type) interface{} {
+	if qs == nil {
+		return nil
+	}
+	return w.typeDecl(*qs)
+}
+
+func (w *marshaler) properties(props map[string]interface{}) yaml.MapSlice {
+	var m mapping
+	for _, name := range sortedKeys(props) {
+		m = append(m, yaml.MapItem{Key: name, Value: w.typeValue(props[name])})
+	}
+	return yaml.MapSlice(m)
+}
+
+// typeValue renames the references of a type node,
+// which could be a type expression, a list of type expressions, or an inline declaration
+func (w *marshaler) typeValue(v interface{}) interface{} {
+	if w.rename == nil {
+		return v
+	}
+	switch val := v.(type) {
+	case string:
+		return w.typeExpr(val)
+	case []interface{}:
+		result := make([]interface{}, 0, len(val))
+		for _, elem := range val {
+			result = append(result, w.typeValue(elem))
+		}
+		return result
+	case map[interface{}]interface{}:
+		result := make(map[interface{}]interface{}, len(val))
+		for k, elem := range val {
+			key := fmt.Sprint(k)
+			switch {
+			case key == "type" || key == "items":
+				elem = w.typeValue(elem)
+			case key == "properties":
+				if props, ok := elem.(map[interface{}]interface{}); ok {
+					renamed := make(map[interface{}]interface{}, len(props))
+					for name, prop := range props {
+						renamed[name] = w.typeValue(prop)
+					}
+					elem = renamed
+				}
+			case isAnnotationKey(key):
+				k = "(" + w.name(annotationName(key)) + ")"
+			}
+			result[k] = elem
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// typeExpr renames the references of a type expression,
+// the expression is written as is if there is no renamed reference.
+func (w *marshaler) typeExpr(expr string) string {
+	if w.rename == nil {
+		return expr
+	}
+	te, err := ParseTypeExpr(expr)
+	if err != nil || !te.rename(w.rename) {
+		return expr
+	}
+	return te.String()
+}
+
+// name renames a reference to a type, annotation type, or security scheme
+func (w *marshaler) name(name string) string {
+	if w.rename == nil {
+		return name
+	}
+	return w.rename(name)
+}
+
+func (w *marshaler) annotationTypeDecls(ats map[string]AnnotationType) yaml.MapSlice {
+	var m mapping
+	for _, name := range sortedKeys(ats) {
+		m = append(m, yaml.MapItem{Key: name, Value: w.annotationType(ats[name])})
+	}
+	return yaml.MapSlice(m)
+}
+
+// annotationType writes an annotation type declaration,
+// a declaration which only has the type is written as the type name.
+func (w *marshaler) annotationType(at AnnotationType) interface{} {
+	var m mapping
+	m.setAny("type", w.typeValue(at.Type))
+	m.set("displayName", at.DisplayName)
+	m.set("description", at.Description)
+	m.set("properties", w.properties(at.Properties))
+	m.set("enum", at.Enum)
+	m.set("allowedTargets", at.AllowedTargets)
+
+	switch {
+	case len(m) == 0:
+		return nil
+	case len(m) == 1 && m[0].Key == "type":
+		return m[0].Value
+	}
+	return yaml.MapSlice(m)
+}
+
+// annotations writes the annotations sorted by name,
+// the key is the annotation name in parentheses.
+func (w *marshaler) annotations(annots Annotations) mapping {
+	var m mapping
+	for _, name := range sortedKeys(annots) {
+		m = append(m, yaml.MapItem{Key: "(" + w.name(annotationName(name)) + ")", Value: annots[name].Value})
+	}
+	return m
+}
+
+func (w *marshaler) securitySchemeDecls(schemes map[string]SecurityScheme) yaml.MapSlice {
+	var m mapping
+	for _, name := range sortedKeys(schemes) {
+		ss := schemes[name]
+
+		var sm mapping
+		sm.set("type", ss.Type)
+		sm.set("displayName", ss.DisplayName)
+		sm.set("description", ss.Description)
+		sm.append(w.annotations(ss.Annotations))
+
+		var dm mapping
+		dm.set("headers", w.headers(ss.DescribedBy.Headers, nil))
+		dm.set("queryParameters", w.namedParameters(ss.DescribedBy.QueryParameters, nil))
+		dm.set("queryString", w.queryString(ss.DescribedBy.QueryString))
+		dm.set("responses", w.responses(ss.DescribedBy.Responses, nil))
+		dm.append(w.annotations(ss.DescribedBy.Annotations))
+		sm.set("describedBy", yaml.MapSlice(dm))
+
+		settings := make(map[string]interface{}, len(ss.Settings))
+		for k, v := range ss.Settings {
+			settings[k] = v
+		}
+		sm.set("settings", sortedValues(settings))
+
+		m = append(m, yaml.MapItem{Key: name, Value: yaml.MapSlice(sm)})
+	}
+	return yaml.MapSlice(m)
+}
+
+func documentation(docs []Documentation) []yaml.MapSlice {
+	var result []yaml.MapSlice
+	for _, doc := range docs {
+		result = append(result, yaml.MapSlice{
+			{Key: "title", Value: doc.Title},
+			{Key: "content", Value: doc.Content},
+		})
+	}
+	return result
+}
+
+func schemas(schemas []map[string]string) []yaml.MapSlice {
+	var result []yaml.MapSlice
+	for _, s := range schemas {
+		var m mapping
+		for _, name := range sortedKeys(s) {
+			m = append(m, yaml.MapItem{Key: name, Value: s[name]})
+		}
+		result = append(result, yaml.MapSlice(m))
+	}
+	return result
+}
+
+// definitionChoice writes a reference to a resource type, trait, or security scheme.
+// The reference without parameters is written as the name,
+// and the empty name is written as null.
+func (w *marshaler) definitionChoice(dc *DefinitionChoice) interface{} {
+	if dc == nil || dc.Name == "" {
+		return nil
+	}
+	name := w.name(dc.Name)
+	if len(dc.Parameters) == 0 {
+		return name
+	}
+	return yaml.MapSlice{{Key: name, Value: sortedValues(dc.Parameters)}}
+}
+
+func (w *marshaler) definitionChoices(dcs []DefinitionChoice) []interface{} {
+	var result []interface{}
+	for i := range dcs {
+		result = append(result, w.definitionChoice(&dcs[i]))
+	}
+	return result
+}
+
+// omitInherited returns empty string if the value is inherited
+func omitInherited(val, inherited string) string {
+	if val == inherited {
+		return ""
+	}
+	return val
+}
+
+// hasMethod returns true if the methods has a method with the given name
+func hasMethod(methods []*Method, name string) bool {
+	for _, m := range methods {
+		if m.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func inStringSlice(str string, arr []string) bool {
+	for _, s := range arr {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedValues converts a map to YAML mapping sorted by the keys
+func sortedValues(vals map[string]interface{}) yaml.MapSlice {
+	var m mapping
+	for _, k := range sortedKeys(vals) {
+		m = append(m, yaml.MapItem{Key: k, Value: vals[k]})
+	}
+	return yaml.MapSlice(m)
+}
+
+// sortedStrings converts a map of strings to YAML mapping sorted by the keys
+func sortedStrings(vals map[string]string) yaml.MapSlice {
+	var m mapping
+	for _, k := range sortedKeys(vals) {
+		m = append(m, yaml.MapItem{Key: k, Value: vals[k]})
+	}
+	return yaml.MapSlice(m)
+}
+
+// sortedKeys returns the keys of a map with string keys, in sorted order
+func sortedKeys(m interface{}) []string {
+	v := reflect.ValueOf(m)
+	keys := make([]string, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		keys = append(keys, fmt.Sprint(k.Interface()))
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,123 @@
+package raml
+
+import (
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// samplesFS returns file system of the samples directory,
+// with the given file replaced by the data
+func samplesFS(file string, data []byte) (fstest.MapFS, error) {
+	fsys := fstest.MapFS{}
+	err := fs.WalkDir(os.DirFS("./samples"), ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		b, err := ioutil.ReadFile("./samples/" + p)
+		fsys[p] = &fstest.MapFile{Data: b}
+		return err
+	})
+	fsys[file] = &fstest.MapFile{Data: data}
+	return fsys, err
+}
+
+func TestMarshal(t *testing.T) {
+	Convey("marshal", t, func() {
+		Convey("round trip", func() {
+			files := []string{
+				"resource_type_chain.raml",
+				"resource_types.raml",
+				"simple_with_lib.raml",
+				"type_expressions.raml",
+				"annotations/api.raml",
+				"congo/api.raml",
+				"includes/api.raml",
+				"jsonschema/api.raml",
+				"xml/api.raml",
+				"facets/api.raml",
+				"query_string/api.raml",
+				"parameter_functions.raml",
+				"media_types/api.raml",
+				"libraries/files.raml",
+				"validate/valid.raml",
+			}
+			for _, file := range files {
+				formatted, err := FormatFile("./samples/" + file)
+				So(err, ShouldBeNil)
+
+				fsys, err := samplesFS(file, formatted)
+				So(err, ShouldBeNil)
+
+				// the canonical form doesn't change
+				reformatted, err := NewParser(fsys).Format(file)
+				So(err, ShouldBeNil)
+				So(string(reformatted), ShouldEqual, string(formatted))
+			}
+		})
+
+		Convey("inherited properties", func() {
+			apiDef := new(APIDefinition)
+			So(ParseFile("./samples/resource_type_chain.raml", apiDef), ShouldBeNil)
+
+			b, err := Marshal(apiDef)
+			So(err, ShouldBeNil)
+			doc := string(b)
+			So(strings.HasPrefix(doc, "#%RAML 1.0\n"), ShouldBeTrue)
+			So(doc, ShouldContainSubstring, "description: search the books")
+			So(doc, ShouldNotContainSubstring, "search book by name")
+			So(doc, ShouldNotContainSubstring, "page of books")
+
+			fsys, err := samplesFS("api.raml", b)
+			So(err, ShouldBeNil)
+			marshaled := new(APIDefinition)
+			So(ParseFS(fsys, "api.raml", marshaled), ShouldBeNil)
+
+			books := marshaled.Resources["/books"]
+			So(books.Get.Description, ShouldEqual, "search the books")
+			So(books.Get.QueryParameters["q"].Description, ShouldEqual, "search book by name")
+			So(books.Get.QueryParameters["page"].Description, ShouldEqual, "page of books")
+			So(books.Get.Headers["Authorization"].Description, ShouldEqual, "authorized by method")
+			So(books.Delete.Responses["204"].Description, ShouldEqual, "the book is deleted")
+			So(marshaled.Resources["/authors"].Post.Description, ShouldEqual, "create a author")
+		})
+
+		Convey("edited API definition", func() {
+			apiDef := new(APIDefinition)
+			So(ParseFile("./samples/resource_type_chain.raml", apiDef), ShouldBeNil)
+
+			apiDef.Title = "Edited"
+			apiDef.Resources["/books"].Get.QueryParameters["sort"] = NamedParameter{Type: "string", Required: true}
+
+			b, err := Marshal(apiDef)
+			So(err, ShouldBeNil)
+
+			fsys, err := samplesFS("api.raml", b)
+			So(err, ShouldBeNil)
+			marshaled := new(APIDefinition)
+			So(ParseFS(fsys, "api.raml", marshaled), ShouldBeNil)
+			So(marshaled.Title, ShouldEqual, "Edited")
+			So(marshaled.Resources["/books"].Get.QueryParameters["sort"].Required, ShouldBeTrue)
+		})
+
+		Convey("library", func() {
+			b, err := FormatFile("./samples/libraries/resource_types.raml")
+			So(err, ShouldBeNil)
+			So(strings.HasPrefix(string(b), "#%RAML 1.0 Library\n"), ShouldBeTrue)
+			So(string(b), ShouldContainSubstring, "resourceTypes:")
+		})
+
+		Convey("unsupported files", func() {
+			_, err := FormatFile("./samples/overlays/overlay.raml")
+			So(err, ShouldNotBeNil)
+
+			_, err = FormatFile("./samples/raml08/api.raml")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
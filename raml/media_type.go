@@ -0,0 +1,189 @@
+package raml
+
+import (
+	"strings"
+)
+
+// the media types which have a dedicated support
+const (
+	MediaTypeJSON           = "application/json"
+	MediaTypeXML            = "application/xml"
+	MediaTypeFormURLEncoded = "application/x-www-form-urlencoded"
+	MediaTypeMultipart      = "multipart/form-data"
+)
+
+// MediaTypes are the default media types of the bodies,
+// it is declared as a single media type or a sequence of media types.
+type MediaTypes []string
+
+// UnmarshalYAML unmarshals a single media type or a sequence of media types
+func (mt *MediaTypes) UnmarshalYAML(unmarshaler func(interface{}) error) error {
+	var single string
+	if err := unmarshaler(&single); err == nil {
+		*mt = nil
+		if single != "" {
+			*mt = MediaTypes{single}
+		}
+		return nil
+	}
+	var seq []string
+	if err := unmarshaler(&seq); err != nil {
+		return err
+	}
+	*mt = MediaTypes(seq)
+	return nil
+}
+
+// value returns the value to write in a RAML document,
+// a single media type is written as a string.
+func (mt MediaTypes) value() interface{} {
+	switch len(mt) {
+	case 0:
+		return nil
+	case 1:
+		return mt[0]
+	}
+	return []string(mt)
+}
+
+// IsFormMediaType returns true if it is the media type of an HTML form,
+// i.e. application/x-www-form-urlencoded or multipart/form-data
+func IsFormMediaType(mediaType string) bool {
+	mediaType = cleanMediaType(mediaType)
+	return mediaType == MediaTypeFormURLEncoded || mediaType == MediaTypeMultipart
+}
+
+// cleanMediaType returns the lower case media type without it's parameters
+func cleanMediaType(mediaType string) string {
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	if i := strings.Index(mediaType, ";"); i >= 0 {
+		mediaType = strings.TrimSpace(mediaType[:i])
+	}
+	return mediaType
+}
+
+// ByMediaType returns the body of each media type of the bodies,
+// including the application/json and application/xml bodies.
+func (b Bodies) ByMediaType() map[string]Body {
+	bodies := make(map[string]Body, len(b.ForMIMEType)+2)
+	for mediaType, body := range b.ForMIMEType {
+		bodies[mediaType] = body
+	}
+	if b.ApplicationJSON != nil {
+		body := Body{
+			Type:       b.ApplicationJSON.Type,
+			Properties: b.ApplicationJSON.Properties,
+		}
+		if example, ok := b.ApplicationJSON.Example.(string); ok {
+			body.Example = example
+		}
+		bodies[MediaTypeJSON] = body
+	}
+	if b.ApplicationXML != nil {
+		bodies[MediaTypeXML] = *b.ApplicationXML
+	}
+	return bodies
+}
+
+// FormBody returns the media type and the body of the form,
+// if the bodies are only exchanged as form, i.e. there is
+// no application/json nor application/xml body.
+// multipart/form-data takes precedence over application/x-www-form-urlencoded.
+func (b Bodies) FormBody() (string, *Body) {
+	if b.ApplicationJSON != nil || b.ApplicationXML != nil {
+		return "", nil
+	}
+	var urlEncoded *Body
+	for mediaType, body := range b.ForMIMEType {
+		body := body
+		switch cleanMediaType(mediaType) {
+		case MediaTypeMultipart:
+			return MediaTypeMultipart, &body
+		case MediaTypeFormURLEncoded:
+			urlEncoded = &body
+		}
+	}
+	if urlEncoded != nil {
+		return MediaTypeFormURLEncoded, urlEncoded
+	}
+	return "", nil
+}
+
+// IsForm returns true if the bodies are only exchanged as form
+func (b Bodies) IsForm() bool {
+	mediaType, _ := b.FormBody()
+	return mediaType != ""
+}
+
+// IsMultipart returns true if the bodies are only exchanged as multipart/form-data
+func (b Bodies) IsMultipart() bool {
+	mediaType, _ := b.FormBody()
+	return mediaType == MediaTypeMultipart
+}
+
+// hasMediaTypeBody returns true if the bodies declares a body of any media type
+func (b Bodies) hasMediaTypeBody() bool {
+	return b.ApplicationJSON != nil || b.ApplicationXML != nil || len(b.ForMIMEType) > 0
+}
+
+// applyMediaTypes declares the body of each of the default media types,
+// if the body is declared without media type, e.g.
+//
+//	body:
+//	  type: User
+func (b *Bodies) applyMediaTypes(mediaTypes MediaTypes) {
+	if len(mediaTypes) == 0 || b.hasMediaTypeBody() {
+		return
+	}
+	if b.Type == "" && b.Schema == "" && len(b.Properties) == 0 {
+		return
+	}
+	typ := b.Type
+	if typ == "" {
+		typ = b.Schema
+	}
+	for _, mediaType := range mediaTypes {
+		switch cleanMediaType(mediaType) {
+		case MediaTypeJSON:
+			b.ApplicationJSON = &BodiesProperty{
+				Type:       typ,
+				Properties: b.Properties,
+			}
+			if b.Example != "" {
+				b.ApplicationJSON.Example = b.Example
+			}
+		case MediaTypeXML:
+			b.ApplicationXML = &Body{
+				Type:        typ,
+				Properties:  b.Properties,
+				Description: b.Description,
+				Example:     b.Example,
+			}
+		default:
+			if b.ForMIMEType == nil {
+				b.ForMIMEType = map[string]Body{}
+			}
+			b.ForMIMEType[mediaType] = Body{
+				Type:        typ,
+				Properties:  b.Properties,
+				Description: b.Description,
+				Example:     b.Example,
+			}
+		}
+	}
+}
+
+// applyMediaTypes declares the bodies of the default media types
+// in the methods of the resource and of it's nested resources
+func (r *Resource) applyMediaTypes(mediaTypes MediaTypes) {
+	for _, m := range r.Methods {
+		m.Bodies.applyMediaTypes(mediaTypes)
+		for code, resp := range m.Responses {
+			resp.Bodies.applyMediaTypes(mediaTypes)
+			m.Responses[code] = resp
+		}
+	}
+	for _, n := range r.Nested {
+		n.applyMediaTypes(mediaTypes)
+	}
+}
@@ -0,0 +1,69 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMediaTypes(t *testing.T) {
+	Convey("media types", t, func() {
+		apiDef := new(APIDefinition)
+		So(ParseFile("./samples/media_types/api.raml", apiDef), ShouldBeNil)
+		So(Validate(apiDef), ShouldBeEmpty)
+
+		Convey("sequence of default media types", func() {
+			So(apiDef.MediaType, ShouldResemble, MediaTypes{MediaTypeJSON, MediaTypeXML})
+		})
+
+		Convey("body without media type is declared for each default media type", func() {
+			b := apiDef.Resources["/users"].Post.Bodies
+			So(b.ApplicationJSON, ShouldNotBeNil)
+			So(b.ApplicationJSON.Type, ShouldEqual, "User")
+			So(b.ApplicationXML, ShouldNotBeNil)
+			So(b.ApplicationXML.Type, ShouldEqual, "User")
+
+			bodies := apiDef.Resources["/users"].Get.Responses["200"].Bodies.ByMediaType()
+			So(bodies, ShouldHaveLength, 2)
+			So(bodies[MediaTypeJSON].Type, ShouldEqual, "User[]")
+			So(bodies[MediaTypeXML].Type, ShouldEqual, "User[]")
+		})
+
+		Convey("multipart/form-data", func() {
+			b := apiDef.Resources["/avatars"].Post.Bodies
+			So(b.IsForm(), ShouldBeTrue)
+			So(b.IsMultipart(), ShouldBeTrue)
+
+			mediaType, body := b.FormBody()
+			So(mediaType, ShouldEqual, MediaTypeMultipart)
+			So(body.Properties, ShouldHaveLength, 3)
+			So(ToProperty("image", body.Properties["image"]).Type, ShouldEqual, "file")
+		})
+
+		Convey("application/x-www-form-urlencoded", func() {
+			b := apiDef.Resources["/avatars"].Nested["/{id}"].Put.Bodies
+			So(b.IsForm(), ShouldBeTrue)
+			So(b.IsMultipart(), ShouldBeFalse)
+
+			mediaType, body := b.FormBody()
+			So(mediaType, ShouldEqual, MediaTypeFormURLEncoded)
+			So(ToProperty("tags?", body.Properties["tags?"]).Type, ShouldEqual, "string[]")
+		})
+
+		Convey("invalid form bodies", func() {
+			apiDef := new(APIDefinition)
+			So(ParseFile("./samples/media_types/invalid.raml", apiDef), ShouldBeNil)
+
+			messages := map[string]bool{}
+			for _, d := range Validate(apiDef) {
+				messages[d.Message] = true
+			}
+			So(messages, ShouldContainKey,
+				"file property `image` of request body is only allowed in a multipart/form-data body")
+			So(messages, ShouldContainKey,
+				"file property `images` of request body is only allowed in a multipart/form-data body")
+			So(messages, ShouldContainKey,
+				"request body of media type multipart/form-data can't be declared using a schema")
+		})
+	})
+}
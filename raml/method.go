@@ -7,6 +7,9 @@ import (
 
 // Method are operations that are performed on a resource
 type Method struct {
+	// position of the method in the RAML file
+	Position `yaml:"-"`
+
 	Name string
 
 	// An alternate, human-friendly method name in the context of the resource.
@@ -19,7 +22,8 @@ type Method struct {
 	// Its value is a string and MAY be formatted using markdown.
 	Description string `yaml:"description"`
 
-	// TODO : annotation
+	// Annotations to be applied to this method.
+	Annotations Annotations `yaml:",regexp:^\\(.*\\)$"`
 
 	// Detailed information about any query parameters needed by this method.
 	// Mutually exclusive with queryString.
@@ -31,9 +35,10 @@ type Method struct {
 	// Detailed information about any request headers needed by this method.
 	Headers map[HTTPHeader]Header `yaml:"headers"`
 
-	// The query string needed by this method.
+	// The query string needed by this method, it is a type declaration
+	// whose properties are the query parameters, e.g. a type name or an union.
 	// Mutually exclusive with queryParameters.
-	QueryString map[string]NamedParameter `yaml:"queryString"`
+	QueryString *Type `yaml:"queryString"`
 
 	// Information about the expected responses to a request.
 	// Responses MUST be a map of one or more HTTP status codes, where each
@@ -61,55 +66,72 @@ func newMethod(name string) *Method {
 	}
 }
 
-// doing post processing that can't be done by YAML parser
-func (m *Method) postProcess(r *Resource, name string, traitsMap map[string]Trait) {
-	m.Name = name
-	m.inheritFromTraits(r, append(r.Is, m.Is...), traitsMap)
-	r.Methods = append(r.Methods, m)
-}
-
-// inherit from resource type
+// inherit from resource type method
 // fields need to be inherited:
 // - description
 // - response
-func (m *Method) inheritFromResourceType(r *Resource, rtm *Method, rt *ResourceType) {
+// dicts is the resource type parameters
+func (m *Method) inheritFromResourceType(rtm *Method, dicts map[string]interface{}) error {
 	if rtm == nil {
-		return
+		return nil
 	}
-	dicts := initResourceTypeDicts(r, r.Type.Parameters)
+	dicts = copyDicts(dicts)
+	dicts["methodName"] = strings.ToLower(m.Name)
 
 	// inherit description
-	m.Description = substituteParams(m.Description, rtm.Description, dicts)
+	var err error
+	if m.Description, err = substituteParams(m.Description, rtm.Description, dicts); err != nil {
+		return err
+	}
 
 	// inherit bodies
-	m.Bodies.inherit(rtm.Bodies, dicts)
+	if err := m.Bodies.inherit(rtm.Bodies, dicts); err != nil {
+		return err
+	}
 
 	// inherit headers
-	m.inheritHeaders(rtm.Headers, dicts)
+	if err := m.inheritHeaders(rtm.Headers, dicts); err != nil {
+		return err
+	}
 
 	// inherit query params
-	m.inheritQueryParams(rtm.QueryParameters, dicts)
+	if err := m.inheritQueryParams(rtm.QueryParameters, dicts); err != nil {
+		return err
+	}
+
+	// inherit query string
+	if err := m.inheritQueryString(rtm.QueryString, dicts); err != nil {
+		return err
+	}
 
 	// inherit response
-	m.inheritResponses(rtm.Responses, dicts)
+	if err := m.inheritResponses(rtm.Responses, dicts); err != nil {
+		return err
+	}
 
 	// inherit protocols
 	m.inheritProtocols(rtm.Protocols)
+	return nil
 }
 
 // inherit from all traits, inherited traits are:
-// - resource level trait
 // - method trait
+// - resource level trait
+// the first trait takes precedence over the next traits.
 func (m *Method) inheritFromTraits(r *Resource, is []DefinitionChoice, traitsMap map[string]Trait) error {
 	for _, tDef := range is {
 		// acquire traits object
+		pos := m.Position
+		if !pos.IsValid() && r != nil {
+			pos = r.Position
+		}
 		t, ok := traitsMap[tDef.Name]
 		if !ok {
-			return fmt.Errorf("invalid traits name:%v", tDef.Name)
+			return newError(pos, "invalid traits name:%v", tDef.Name)
 		}
 
 		if err := m.inheritFromATrait(r, &t, tDef.Parameters); err != nil {
-			return err
+			return newError(pos, "can't apply trait %v: %v", tDef.Name, err)
 		}
 	}
 	return nil
@@ -120,15 +142,30 @@ func (m *Method) inheritFromTraits(r *Resource, is []DefinitionChoice, traitsMap
 func (m *Method) inheritFromATrait(r *Resource, t *Trait, dicts map[string]interface{}) error {
 	dicts = initTraitDicts(r, m, dicts)
 
-	m.Description = substituteParams(m.Description, t.Description, dicts)
+	var err error
+	if m.Description, err = substituteParams(m.Description, t.Description, dicts); err != nil {
+		return err
+	}
+
+	if err := m.Bodies.inherit(t.Bodies, dicts); err != nil {
+		return err
+	}
 
-	m.Bodies.inherit(t.Bodies, dicts)
+	if err := m.inheritHeaders(t.Headers, dicts); err != nil {
+		return err
+	}
 
-	m.inheritHeaders(t.Headers, dicts)
+	if err := m.inheritResponses(t.Responses, dicts); err != nil {
+		return err
+	}
 
-	m.inheritResponses(t.Responses, dicts)
+	if err := m.inheritQueryParams(t.QueryParameters, dicts); err != nil {
+		return err
+	}
 
-	m.inheritQueryParams(t.QueryParameters, dicts)
+	if err := m.inheritQueryString(t.QueryString, dicts); err != nil {
+		return err
+	}
 
 	m.inheritProtocols(t.Protocols)
 
@@ -139,53 +176,192 @@ func (m *Method) inheritFromATrait(r *Resource, t *Trait, dicts map[string]inter
 	return nil
 }
 
+// postProcessNamedParameters sets the name and the display name of the query parameters
+// and of the request and response headers
+func (m *Method) postProcessNamedParameters() {
+	m.QueryParameters = postProcessNamedParameters(m.QueryParameters)
+	m.Headers = postProcessHeaders(m.Headers)
+	for code, resp := range m.Responses {
+		resp.Headers = postProcessHeaders(resp.Headers)
+		m.Responses[code] = resp
+	}
+}
+
 // inheritHeaders inherit method's headers from parent headers.
 // parent headers could be from resource type or a trait
-func (m *Method) inheritHeaders(parents map[HTTPHeader]Header, dicts map[string]interface{}) {
-	m.Headers = inheritHeaders(m.Headers, parents, dicts)
+func (m *Method) inheritHeaders(parents map[HTTPHeader]Header, dicts map[string]interface{}) error {
+	headers, err := inheritHeaders(m.Headers, parents, dicts)
+	if err != nil {
+		return err
+	}
+	m.Headers = headers
+	return nil
 }
 
 // inheritHeaders inherits headers from parents to childs
-func inheritHeaders(childs, parents map[HTTPHeader]Header, dicts map[string]interface{}) map[HTTPHeader]Header {
+func inheritHeaders(childs, parents map[HTTPHeader]Header, dicts map[string]interface{}) (map[HTTPHeader]Header, error) {
 	if len(childs) == 0 {
 		childs = map[HTTPHeader]Header{}
 	}
 
-	for name, parent := range parents {
+	for rawName, parent := range parents {
+		// the name could be a parameter
+		s, err := substituteParams("", string(rawName), dicts)
+		if err != nil {
+			return nil, err
+		}
+		name := HTTPHeader(s)
 		h, ok := childs[name]
 		if !ok {
 			if optionalTraitProperty(string(name)) { // don't inherit optional property if not exist
 				continue
 			}
-			h = Header{}
+			h = Header{Required: parent.Required}
 		}
-		parent.Name = string(name)
+		parent.Name = string(rawName)
 		np := NamedParameter(h)
-		np.inherit(NamedParameter(parent), dicts)
+		if err := np.inherit(NamedParameter(parent), dicts); err != nil {
+			return nil, err
+		}
 		childs[name] = Header(np)
 	}
-	return childs
+	return childs, nil
 }
 
 // inheritQueryParams inherit method's query params from parent query params.
 // parent query params could be from resource type or a trait
-func (m *Method) inheritQueryParams(parents map[string]NamedParameter, dicts map[string]interface{}) {
+func (m *Method) inheritQueryParams(parents map[string]NamedParameter, dicts map[string]interface{}) error {
 	if len(m.QueryParameters) == 0 {
 		m.QueryParameters = map[string]NamedParameter{}
 	}
-	for name, parent := range parents {
+	for rawName, parent := range parents {
+		// the name could be a parameter
+		name, err := substituteParams("", rawName, dicts)
+		if err != nil {
+			return err
+		}
 		qp, ok := m.QueryParameters[name]
 		if !ok {
-			if optionalTraitProperty(string(name)) { // don't inherit optional property if not exist
+			if optionalTraitProperty(name) { // don't inherit optional property if not exist
 				continue
 			}
-			qp = NamedParameter{Name: name}
+			qp = NamedParameter{Name: name, Required: parent.Required}
 		}
-		parent.Name = name // parent name is not initialized by the parser
-		qp.inherit(parent, dicts)
-		m.QueryParameters[qp.Name] = qp
+		parent.Name = rawName // parent name is not initialized by the parser
+		if err := qp.inherit(parent, dicts); err != nil {
+			return err
+		}
+		m.QueryParameters[name] = qp
+	}
+	return nil
+}
+
+// inheritQueryString inherit method's query string from parent query string,
+// the query string of the method takes precedence.
+// parent query string could be from resource type or a trait
+func (m *Method) inheritQueryString(parent *Type, dicts map[string]interface{}) error {
+	if m.QueryString != nil || parent == nil {
+		return nil
+	}
+	v, err := substituteValue(*parent, dicts)
+	if err != nil {
+		return err
+	}
+	qs := v.(Type)
+	m.QueryString = &qs
+	return nil
+}
+
+// QueryStringType returns the type of the query string of the method,
+// which is the queryString type, or an object type of the query parameters.
+// It returns nil if the method has neither queryString nor query parameters.
+func (m Method) QueryStringType() *Type {
+	if m.QueryString != nil || len(m.QueryParameters) == 0 {
+		return m.QueryString
 	}
+	props := make(map[string]interface{}, len(m.QueryParameters))
+	for name, qp := range m.QueryParameters {
+		prop := qp.ToProperty()
+		prop.Name = name
+		props[name] = prop
+	}
+	return &Type{
+		Type:       "object",
+		Properties: props,
+	}
+}
+
+// HeadersProperties returns the request headers of the method as properties,
+// the values of the returned map are Property.
+func (m Method) HeadersProperties() map[string]interface{} {
+	if len(m.Headers) == 0 {
+		return nil
+	}
+	props := make(map[string]interface{}, len(m.Headers))
+	for name, h := range m.Headers {
+		prop := NamedParameter(h).ToProperty()
+		prop.Name = string(name)
+		props[string(name)] = prop
+	}
+	return props
+}
 
+// QueryStringProperties returns the properties of the query string of the method,
+// they are the properties of the queryString type and of it's parent types declared in types,
+// or the query parameters.
+// The properties of the members of an union are optional,
+// because a query string only needs to match one of the members.
+// The values of the returned map are Property.
+func (m Method) QueryStringProperties(types map[string]Type) map[string]interface{} {
+	qs := m.QueryStringType()
+	if qs == nil {
+		return nil
+	}
+	props := map[string]interface{}{}
+	queryStringProperties(props, *qs, types, false, 0)
+	return props
+}
+
+// queryStringProperties adds the properties of a query string type t to props
+func queryStringProperties(props map[string]interface{}, t Type, types map[string]Type, optional bool, depth int) {
+	for name, p := range t.Properties {
+		prop := ToProperty(name, p)
+		if _, ok := props[prop.Name]; ok {
+			continue
+		}
+		if optional {
+			prop.Required = false
+		}
+		props[prop.Name] = prop
+	}
+	if depth >= maxTypeDepth {
+		return
+	}
+
+	te, err := t.TypeExpr()
+	if err != nil {
+		return
+	}
+	var addExpr func(te *TypeExpr, optional bool)
+	addExpr = func(te *TypeExpr, optional bool) {
+		switch te.Kind {
+		case TypeExprName:
+			if parent, ok := types[te.Name]; ok {
+				queryStringProperties(props, parent, types, optional, depth+1)
+			}
+		case TypeExprInline:
+			queryStringProperties(props, *te.Decl, types, optional, depth+1)
+		case TypeExprInheritance:
+			for _, m := range te.Members {
+				addExpr(m, optional)
+			}
+		case TypeExprUnion:
+			for _, m := range te.Members {
+				addExpr(m, true)
+			}
+		}
+	}
+	addExpr(te, optional)
 }
 
 // inheritProtocols inherit method's protocols from parent protocols
@@ -198,7 +374,7 @@ func (m *Method) inheritProtocols(parent []string) {
 
 // inheritResponses inherit method's responses from parent responses
 // parent responses could be from resource type or a trait
-func (m *Method) inheritResponses(parent map[HTTPCode]Response, dicts map[string]interface{}) {
+func (m *Method) inheritResponses(parent map[HTTPCode]Response, dicts map[string]interface{}) error {
 	if len(m.Responses) == 0 { // allocate if needed
 		m.Responses = map[HTTPCode]Response{}
 	}
@@ -210,10 +386,12 @@ func (m *Method) inheritResponses(parent map[HTTPCode]Response, dicts map[string
 			}
 			resp = Response{HTTPCode: code}
 		}
-		resp.inherit(rParent, dicts)
+		if err := resp.inherit(rParent, dicts); err != nil {
+			return err
+		}
 		m.Responses[code] = resp
 	}
-
+	return nil
 }
 
 // Response property of a method on a resource describes
@@ -223,6 +401,8 @@ func (m *Method) inheritResponses(parent map[HTTPCode]Response, dicts map[string
 // The property values describe the corresponding responses.
 // Each value is a response declaration.
 type Response struct {
+	// position of the response in the RAML file
+	Position `yaml:"-"`
 
 	// HTTP status code of the response
 	HTTPCode HTTPCode
@@ -232,7 +412,8 @@ type Response struct {
 	// Its value is a string and MAY be formatted using markdown.
 	Description string
 
-	// TODO : annotation
+	// Annotations to be applied to this response.
+	Annotations Annotations `yaml:",regexp:^\\(.*\\)$"`
 
 	// An API's methods may support custom header values in responses
 	// Detailed information about any response headers returned by this method
@@ -243,10 +424,16 @@ type Response struct {
 }
 
 // inherit from parent response
-func (resp *Response) inherit(parent Response, dicts map[string]interface{}) {
-	resp.Description = substituteParams(resp.Description, parent.Description, dicts)
-	resp.Bodies.inherit(parent.Bodies, dicts)
-	resp.Headers = inheritHeaders(resp.Headers, parent.Headers, dicts)
+func (resp *Response) inherit(parent Response, dicts map[string]interface{}) error {
+	var err error
+	if resp.Description, err = substituteParams(resp.Description, parent.Description, dicts); err != nil {
+		return err
+	}
+	if err := resp.Bodies.inherit(parent.Bodies, dicts); err != nil {
+		return err
+	}
+	resp.Headers, err = inheritHeaders(resp.Headers, parent.Headers, dicts)
+	return err
 }
 
 // Body is the request/response body
@@ -259,6 +446,9 @@ type Body struct {
 	mediaType string `yaml:"mediaType"`
 	// TODO: Fill this during the post-processing phase
 
+	// positions of the examples, which are reported by the validator
+	examplePositions `yaml:"-" json:"-"`
+
 	// The structure of a request or response body MAY be further specified
 	// by the schema property under the appropriate media type.
 	// The schema key CANNOT be specified if a body's media type is
@@ -269,6 +459,13 @@ type Body struct {
 	// specified in the root-level schemas property
 	Schema string `yaml:"schema"`
 
+	// The type of the body, the schema is an alias of it in RAML 1.0
+	Type string `yaml:"type"`
+
+	// Properties of an inline object type, e.g. the form parameters
+	// of application/x-www-form-urlencoded body
+	Properties map[string]interface{} `yaml:"properties"`
+
 	// Brief description
 	Description string `yaml:"description"`
 
@@ -276,6 +473,9 @@ type Body struct {
 	Example string `yaml:"example"`
 
 	Headers map[HTTPHeader]Header `yaml:"headers"`
+
+	// Annotations to be applied to this body.
+	Annotations Annotations `yaml:",regexp:^\\(.*\\)$"`
 }
 
 // Bodies is Container of Body types, necessary because of technical reasons.
@@ -312,10 +512,20 @@ type Bodies struct {
 	// As in the Body type.
 	Example string `yaml:"example"`
 
+	// positions of the examples, which are reported by the validator
+	examplePositions `yaml:"-" json:"-"`
+
+	// Annotations to be applied to this body.
+	// It must be declared before ForMIMEType, otherwise the annotations
+	// will be parsed as media types.
+	Annotations Annotations `yaml:",regexp:^\\(.*\\)$"`
+
 	// Resources CAN have alternate representations. For example, an API
 	// might support both JSON and XML representations. This is the map
 	// between MIME-type and the body definition related to it.
-	ForMIMEType map[string]Body `yaml:",regexp:.*"`
+	// The keys are the media types, e.g. multipart/form-data,
+	// the application/json and application/xml bodies have their own field.
+	ForMIMEType map[string]Body `yaml:",regexp:^[^/]+/.+$"`
 
 	// TODO: For APIs without a priori knowledge of the response types for
 	// their responses, "*/*" MAY be used to indicate that responses that do
@@ -324,18 +534,40 @@ type Bodies struct {
 	// "*/*" is used.
 	ApplicationJSON *BodiesProperty `yaml:"application/json"`
 
+	// The body of application/xml media type.
+	// The type could be a RAML type, serialized according to it's `xml` facet,
+	// or an XML schema.
+	ApplicationXML *Body `yaml:"application/xml"`
+
 	// Request/response body type
 	Type string `yaml:"type"`
+
+	// Properties of an inline object type of a body declared without media type
+	Properties map[string]interface{} `yaml:"properties"`
 }
 
 // inherit inherits bodies properties from a parent bodies
 // parent object could be from trait or response type
-func (b *Bodies) inherit(parent Bodies, dicts map[string]interface{}) {
-	b.Schema = substituteParams(b.Schema, parent.Schema, dicts)
-	b.Description = substituteParams(b.Description, parent.Description, dicts)
-	b.Example = substituteParams(b.Example, parent.Example, dicts)
-
-	b.Type = substituteParams(b.Type, parent.Type, dicts)
+func (b *Bodies) inherit(parent Bodies, dicts map[string]interface{}) error {
+	var err error
+	for _, f := range []struct {
+		val    *string
+		parent string
+	}{
+		{&b.Schema, parent.Schema},
+		{&b.Description, parent.Description},
+		{&b.Example, parent.Example},
+		{&b.Type, parent.Type},
+	} {
+		if *f.val, err = substituteParams(*f.val, f.parent, dicts); err != nil {
+			return err
+		}
+	}
+	props, err := substituteProperties(parent.Properties, dicts)
+	if err != nil {
+		return err
+	}
+	b.Properties = inheritBodyProperties(b.Properties, props)
 
 	// request body
 	if parent.ApplicationJSON != nil {
@@ -343,23 +575,95 @@ func (b *Bodies) inherit(parent Bodies, dicts map[string]interface{}) {
 			b.ApplicationJSON = &BodiesProperty{Properties: map[string]interface{}{}}
 		}
 
-		b.ApplicationJSON.Type = substituteParams(b.ApplicationJSON.Type, parent.ApplicationJSON.Type, dicts)
+		if b.ApplicationJSON.Type, err = substituteParams(b.ApplicationJSON.Type, parent.ApplicationJSON.Type, dicts); err != nil {
+			return err
+		}
+		props, err := substituteProperties(parent.ApplicationJSON.Properties, dicts)
+		if err != nil {
+			return err
+		}
+		b.ApplicationJSON.Properties = inheritBodyProperties(b.ApplicationJSON.Properties, props)
+		if b.ApplicationJSON.Example == nil {
+			if b.ApplicationJSON.Example, err = substituteValue(parent.ApplicationJSON.Example, dicts); err != nil {
+				return err
+			}
+		}
+		if b.ApplicationJSON.Examples == nil {
+			if b.ApplicationJSON.Examples, err = substituteProperties(parent.ApplicationJSON.Examples, dicts); err != nil {
+				return err
+			}
+		}
+	}
+
+	if parent.ApplicationXML != nil {
+		if b.ApplicationXML == nil { // allocate if needed
+			b.ApplicationXML = &Body{}
+		}
+		if err := b.ApplicationXML.inherit(*parent.ApplicationXML, dicts); err != nil {
+			return err
+		}
+	}
 
-		for k, p := range parent.ApplicationJSON.Properties {
-			if _, ok := b.ApplicationJSON.Properties[k]; !ok {
+	for mediaType, parentBody := range parent.ForMIMEType {
+		if b.ForMIMEType == nil { // allocate if needed
+			b.ForMIMEType = map[string]Body{}
+		}
+		body := b.ForMIMEType[mediaType]
+		if err := body.inherit(parentBody, dicts); err != nil {
+			return err
+		}
+		b.ForMIMEType[mediaType] = body
+	}
+	return nil
+}
 
-				// handle optional properties as described in
-				// https://github.com/raml-org/raml-spec/blob/raml-10/versions/raml-10/raml-10.md#optional-properties
-				switch {
-				case strings.HasSuffix(k, `\?`): // if ended with `\?` we make it optional property
-					k = k[:len(k)-2] + "?"
-				case strings.HasSuffix(k, "?"): // if only ended with `?`, we can ignore it
-					continue
-				}
-				b.ApplicationJSON.Properties[k] = p
-			}
+// inherit inherits the type, schema, description, example
+// and properties of a body from the parent body
+func (b *Body) inherit(parent Body, dicts map[string]interface{}) error {
+	var err error
+	for _, f := range []struct {
+		val    *string
+		parent string
+	}{
+		{&b.Type, parent.Type},
+		{&b.Schema, parent.Schema},
+		{&b.Description, parent.Description},
+		{&b.Example, parent.Example},
+	} {
+		if *f.val, err = substituteParams(*f.val, f.parent, dicts); err != nil {
+			return err
 		}
 	}
+	props, err := substituteProperties(parent.Properties, dicts)
+	if err != nil {
+		return err
+	}
+	b.Properties = inheritBodyProperties(b.Properties, props)
+	return nil
+}
 
-	// TODO : formimeytype
+// inheritBodyProperties inherits the properties of an object body from the parent properties
+func inheritBodyProperties(childs, parents map[string]interface{}) map[string]interface{} {
+	if len(parents) == 0 {
+		return childs
+	}
+	if childs == nil {
+		childs = map[string]interface{}{}
+	}
+	for k, p := range parents {
+		if _, ok := childs[k]; ok {
+			continue
+		}
+
+		// handle optional properties as described in
+		// https://github.com/raml-org/raml-spec/blob/raml-10/versions/raml-10/raml-10.md#optional-properties
+		switch {
+		case strings.HasSuffix(k, `\?`): // if ended with `\?` we make it optional property
+			k = k[:len(k)-2] + "?"
+		case strings.HasSuffix(k, "?"): // if only ended with `?`, we can ignore it
+			continue
+		}
+		childs[k] = p
+	}
+	return childs
 }
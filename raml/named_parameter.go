@@ -1,46 +1,51 @@
 package raml
 
-// NamedParameter is collection of named parameters
-// The RAML Specification uses collections of named parameters for the
-// following properties: URI parameters, query string parameters, form
-// parameters, request bodies (depending on the media type), and request
-// and response headers.
+import (
+	"strings"
+)
+
+// NamedParameter is the type declaration of a named parameter.
+// The RAML Specification uses named parameters for the following properties:
+// URI parameters, query string parameters, and request and response headers.
+//
+// A named parameter is declared as a map of it's facets,
+// or only as a type expression, e.g. `ids: integer[]`.
+// The multiple types of a RAML 0.8 named parameter are upgraded to an union type.
 //
 // Some fields are pointers to distinguish Zero values and no values
 type NamedParameter struct {
+	// position of the parameter in the RAML file
+	Position `yaml:"-"`
+	// positions of the examples, which are reported by the validator
+	examplePositions `yaml:"-"`
 
-	// NOTE: We currently do not support Named Parameters With Multiple Types.
-	// TODO: Add support for Named Parameters With Multiple Types. Should be
-	// done sort of like the DefinitionChoice type.
-
-	// The name of the Parameter, as defined by the type containing it.
+	// The name of the Parameter, as defined by the type containing it,
+	// without the `?` suffix of an optional parameter.
+	// It is filled during the post-processing phase.
 	Name string
-	// TODO: Fill this during the post-processing phase
 
 	// A friendly name used only for display or documentation purposes.
 	// If displayName is not specified, it defaults to the property's key
-	DisplayName string `yaml:"displayName"` // TODO: Auto-fill this
+	DisplayName string `yaml:"displayName"`
 
 	// The intended use or meaning of the parameter
 	Description string `yaml:"description"`
 
-	// The primitive type of the parameter's resolved value. Can be:
+	// The type expression of the parameter's value, e.g.:
+	//
+	//	string, number, integer, boolean, date-only, datetime, ...
+	//	integer[]		- an array, the parameter is repeated
+	//	string | integer	- an union
+	//	Status			- a user defined type
 	//
-	// Type	Description
-	// string	- Value MUST be a string.
-	// number	- Value MUST be a number. Indicate floating point numbers as defined by YAML.
-	// integer	- Value MUST be an integer. Floating point numbers are not allowed. The integer type is a subset of the number type.
-	// date		- Value MUST be a string representation of a date as defined in RFC2616 Section 3.3 [RFC2616]. See Date Representations.
-	// boolean	- Value MUST be either the string "true" or "false" (without the quotes).
-	// file		- (Applicable only to Form properties) Value is a file. Client generators SHOULD use this type to handle file uploads correctly.
+	// The default type is string.
 	Type string
-	// TODO: Verify the enum options
 
 	// If the enum attribute is defined, API clients and servers MUST verify
-	// that a parameter's value matches a value in the enum array
-	// Enum parsing is currently disabled because of:
-	// https://github.com/Jumpscale/go-raml/issues/99
-	//Enum []Any `yaml:",flow"`
+	// that a parameter's value matches a value in the enum array.
+	// It could be a parameter of a trait or a resource type, e.g. `<<values>>`,
+	// see EnumValues.
+	Enum interface{} `yaml:"enum"`
 
 	// The pattern attribute is a regular expression that a parameter of type
 	// string MUST match. Regular expressions MUST follow the regular
@@ -65,51 +70,184 @@ type NamedParameter struct {
 	// only)
 	Maximum *float64
 
+	// The value of the parameter MUST be a multiple of multipleOf. (numbers only)
+	MultipleOf *float64 `yaml:"multipleOf"`
+
+	// The format of the value. (numbers and datetime only)
+	Format string `yaml:"format"`
+
+	// The type expression of the items of an array, if the type is `array`
+	Items string `yaml:"items"`
+
+	// The minimum and maximum number of items of an array,
+	// and whether the items must be unique. (arrays only)
+	MinItems    *int `yaml:"minItems"`
+	MaxItems    *int `yaml:"maxItems"`
+	UniqueItems bool `yaml:"uniqueItems"`
+
 	// An example value for the property. This can be used, e.g., by
 	// documentation generators to generate sample values for the property.
 	Example interface{}
 
+	// Named examples of the property, each of them is an example value
+	// or a map with the `value` and `strict` facets
+	Examples map[string]interface{}
+
 	// The repeat attribute specifies that the parameter can be repeated,
 	// i.e. the parameter can be used multiple times
 	Repeat *bool // TODO: What does this mean?
 
 	// Whether the parameter and its value MUST be present when a call is made.
-	// In general, parameters are optional unless the required attribute is
-	// included and its value set to 'true'.
-	// For a URI parameter, its default value is 'true'.
+	// The parameters are required unless the required attribute is false
+	// or the name of the parameter has the `?` suffix.
 	Required bool
 
 	// The default value to use for the property if the property is omitted or
 	// its value is not specified
 	Default Any
+}
+
+// UnmarshalYAML unmarshals a named parameter declared as a type expression
+// or as a map of it's facets
+func (np *NamedParameter) UnmarshalYAML(unmarshaler func(interface{}) error) error {
+	type rawNamedParameter NamedParameter // to avoid recursion
+
+	var expr string
+	if err := unmarshaler(&expr); err == nil {
+		*np = NamedParameter{Type: expr, Required: true}
+		return nil
+	}
+
+	raw := rawNamedParameter{Required: true}
+	if err := unmarshaler(&raw); err != nil {
+		return err
+	}
+	*np = NamedParameter(raw)
+	return nil
+}
 
-	format Any `ramlFormat:"Named parameters must be mappings. Example: userId: {displayName: 'User ID', description: 'Used to identify the user.', type: 'integer', minimum: 1, example: 5}"`
+// UnmarshalYAML unmarshals a header, which is a named parameter
+func (h *Header) UnmarshalYAML(unmarshaler func(interface{}) error) error {
+	return (*NamedParameter)(h).UnmarshalYAML(unmarshaler)
 }
 
-// check if an element exist in enum field
-/*func (np *NamedParameter) existInEnum(elem Any) bool {
-	for _, e := range np.Enum {
-		if reflect.DeepEqual(e, elem) {
-			return true
+// TypeExpr returns the type expression of the parameter,
+// the `items` facet of an array is included, e.g. `integer[]`
+func (np NamedParameter) TypeExpr() string {
+	typ := strings.TrimSpace(np.Type)
+	switch {
+	case typ == "":
+		return "string"
+	case typ == "array" && np.Items != "":
+		items := strings.TrimSpace(np.Items)
+		if strings.ContainsAny(items, "|") {
+			items = "(" + items + ")"
 		}
+		return items + "[]"
 	}
-	return false
+	return typ
+}
+
+// ToProperty converts the named parameter into a property of an object type,
+// e.g. to generate the type of the query parameters of a method
+func (np NamedParameter) ToProperty() Property {
+	prop := Property{
+		Name:        np.Name,
+		Type:        np.TypeExpr(),
+		Required:    np.Required,
+		Description: np.Description,
+		Pattern:     np.Pattern,
+		MinLength:   np.MinLength,
+		MaxLength:   np.MaxLength,
+		Minimum:     np.Minimum,
+		Maximum:     np.Maximum,
+		MultipleOf:  np.MultipleOf,
+		MinItems:    np.MinItems,
+		MaxItems:    np.MaxItems,
+		UniqueItems: np.UniqueItems,
+		Example:     np.Example,
+		Examples:    np.Examples,
+		Default:     np.Default,
+	}
+	if enum := np.EnumValues(); len(enum) > 0 {
+		prop.Enum = enum
+	}
+	return prop
+}
+
+// EnumValues returns the values of the enum facet
+func (np NamedParameter) EnumValues() []interface{} {
+	enum, _ := np.Enum.([]interface{})
+	return enum
 }
-*/
-
-func (np *NamedParameter) inherit(parent NamedParameter, dicts map[string]interface{}) {
-	np.Name = substituteParams(np.Name, parent.Name, dicts)
-	np.DisplayName = substituteParams(np.DisplayName, parent.DisplayName, dicts)
-	np.Description = substituteParams(np.Description, parent.Description, dicts)
-
-	/*
-		for _, elem := range parent.Enum {
-			if !np.existInEnum(elem) {
-				np.Enum = append(np.Enum, elem)
-			}
+
+// postProcessNamedParameters sets the name and the display name of the parameters,
+// the `?` suffix of the name of an optional parameter is removed.
+func postProcessNamedParameters(params map[string]NamedParameter) map[string]NamedParameter {
+	if len(params) == 0 {
+		return params
+	}
+	processed := make(map[string]NamedParameter, len(params))
+	for key, np := range params {
+		np.Name = key
+		if strings.HasSuffix(key, "?") {
+			np.Name = key[:len(key)-1]
+			np.Required = false
+		}
+		if np.DisplayName == "" {
+			np.DisplayName = np.Name
 		}
-	*/
-	np.Pattern = inheritStringPointer(np.Pattern, parent.Pattern, dicts)
+		processed[np.Name] = np
+	}
+	return processed
+}
+
+// postProcessHeaders sets the name and the display name of the headers,
+// see postProcessNamedParameters
+func postProcessHeaders(headers map[HTTPHeader]Header) map[HTTPHeader]Header {
+	if len(headers) == 0 {
+		return headers
+	}
+	params := make(map[string]NamedParameter, len(headers))
+	for name, h := range headers {
+		params[string(name)] = NamedParameter(h)
+	}
+	processed := make(map[HTTPHeader]Header, len(headers))
+	for name, np := range postProcessNamedParameters(params) {
+		processed[HTTPHeader(name)] = Header(np)
+	}
+	return processed
+}
+
+func (np *NamedParameter) inherit(parent NamedParameter, dicts map[string]interface{}) error {
+	var err error
+	for _, f := range []struct {
+		val    *string
+		parent string
+	}{
+		{&np.Name, parent.Name},
+		{&np.DisplayName, parent.DisplayName},
+		{&np.Description, parent.Description},
+		{&np.Type, parent.Type},
+		{&np.Format, parent.Format},
+		{&np.Items, parent.Items},
+	} {
+		if *f.val, err = substituteParams(*f.val, f.parent, dicts); err != nil {
+			return err
+		}
+	}
+	if !np.Position.IsValid() {
+		np.Position = parent.Position
+	}
+
+	if np.Enum == nil {
+		if np.Enum, err = substituteValue(parent.Enum, dicts); err != nil {
+			return err
+		}
+	}
+	if np.Pattern, err = inheritStringPointer(np.Pattern, parent.Pattern, dicts); err != nil {
+		return err
+	}
 	np.MinLength = inheritIntPointer(np.MinLength, parent.MinLength)
 	np.MaxLength = inheritIntPointer(np.MaxLength, parent.MaxLength)
 	if parent.Maximum != nil {
@@ -118,23 +256,67 @@ func (np *NamedParameter) inherit(parent NamedParameter, dicts map[string]interf
 	if parent.Minimum != nil {
 		np.Minimum = parent.Minimum
 	}
+	if parent.MultipleOf != nil {
+		np.MultipleOf = parent.MultipleOf
+	}
+	np.MinItems = inheritIntPointer(np.MinItems, parent.MinItems)
+	np.MaxItems = inheritIntPointer(np.MaxItems, parent.MaxItems)
+	if parent.UniqueItems {
+		np.UniqueItems = true
+	}
 	if parent.Repeat != nil {
 		np.Repeat = parent.Repeat
 	}
-	if parent.Required {
-		np.Required = true
+	if np.Example == nil {
+		if np.Example, err = substituteValue(parent.Example, dicts); err != nil {
+			return err
+		}
+	}
+	if np.Examples == nil {
+		if np.Examples, err = substituteProperties(parent.Examples, dicts); err != nil {
+			return err
+		}
 	}
+	if np.Default == nil {
+		if np.Default, err = substituteValue(parent.Default, dicts); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func inheritStringPointer(val, parent *string, dicts map[string]interface{}) *string {
+// inheritNamedParameters inherits named parameters from the parent parameters,
+// the parent parameters which don't exist are added.
+func inheritNamedParameters(params, parents map[string]NamedParameter, dicts map[string]interface{}) (map[string]NamedParameter, error) {
+	if len(parents) == 0 {
+		return params, nil
+	}
+	if len(params) == 0 {
+		params = map[string]NamedParameter{}
+	}
+	for name, parent := range parents {
+		p, ok := params[name]
+		if !ok {
+			p = NamedParameter{Required: parent.Required}
+		}
+		if err := p.inherit(parent, dicts); err != nil {
+			return nil, err
+		}
+		params[name] = p
+	}
+	return params, nil
+}
+
+func inheritStringPointer(val, parent *string, dicts map[string]interface{}) (*string, error) {
 	if parent == nil {
-		return val
+		return val, nil
 	}
 	if val == nil {
 		val = new(string)
 	}
-	*val = substituteParams(*val, *parent, dicts)
-	return val
+	var err error
+	*val, err = substituteParams(*val, *parent, dicts)
+	return val, err
 }
 
 func inheritIntPointer(val, parent *int) *int {
@@ -0,0 +1,90 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNamedParameters(t *testing.T) {
+	Convey("named parameters", t, func() {
+		apiDef := new(APIDefinition)
+		So(ParseFile("./samples/named_parameters/api.raml", apiDef), ShouldBeNil)
+		So(Validate(apiDef), ShouldBeEmpty)
+
+		get := apiDef.Resources["/users"].Get
+
+		Convey("type expression shorthand", func() {
+			ids := get.QueryParameters["ids"]
+			So(ids.Name, ShouldEqual, "ids")
+			So(ids.DisplayName, ShouldEqual, "ids")
+			So(ids.Required, ShouldBeTrue)
+			So(ids.TypeExpr(), ShouldEqual, "integer[]")
+		})
+
+		Convey("optional parameters", func() {
+			So(get.QueryParameters, ShouldNotContainKey, "page?")
+			page := get.QueryParameters["page"]
+			So(page.Name, ShouldEqual, "page")
+			So(page.Required, ShouldBeFalse)
+			So(page.Type, ShouldEqual, "integer")
+
+			So(get.QueryParameters["status"].Type, ShouldEqual, "Status")
+
+			h := get.Headers["If-Modified-Since"]
+			So(h.Required, ShouldBeFalse)
+			So(h.Type, ShouldEqual, "date-only")
+			So(get.Responses["200"].Headers["X-Total"].Required, ShouldBeTrue)
+		})
+
+		Convey("array and union facets", func() {
+			tags := get.QueryParameters["tags"]
+			So(tags.TypeExpr(), ShouldEqual, "string[]")
+			So(*tags.MinItems, ShouldEqual, 1)
+			So(tags.UniqueItems, ShouldBeTrue)
+			So(tags.Required, ShouldBeFalse)
+
+			limit := get.QueryParameters["limit"]
+			So(limit.TypeExpr(), ShouldEqual, "integer | nil")
+			So(*limit.MultipleOf, ShouldEqual, 10)
+		})
+
+		Convey("URI parameters", func() {
+			id := apiDef.Resources["/users"].Nested["/{id}"].URIParameters["id"]
+			So(id.DisplayName, ShouldEqual, "User ID")
+			So(id.Required, ShouldBeTrue)
+
+			region := apiDef.BaseURIParameters["region"]
+			So(region.Name, ShouldEqual, "region")
+			So(region.EnumValues(), ShouldResemble, []interface{}{"eu", "us"})
+		})
+
+		Convey("query string type", func() {
+			qs := get.QueryStringType()
+			So(qs, ShouldNotBeNil)
+			So(qs.Properties, ShouldHaveLength, 5)
+
+			ids := ToProperty("ids", qs.Properties["ids"])
+			So(ids.Type, ShouldEqual, "integer[]")
+			So(ids.Required, ShouldBeTrue)
+			So(ToProperty("page", qs.Properties["page"]).Required, ShouldBeFalse)
+
+			So(apiDef.Resources["/users"].Nested["/{id}"].Get.QueryStringType(), ShouldBeNil)
+		})
+
+		Convey("invalid named parameters", func() {
+			apiDef := new(APIDefinition)
+			So(ParseFile("./samples/named_parameters/invalid.raml", apiDef), ShouldBeNil)
+
+			messages := map[string]bool{}
+			for _, d := range Validate(apiDef) {
+				messages[d.Message] = true
+			}
+			So(messages, ShouldHaveLength, 4)
+			So(messages, ShouldContainKey, "invalid example of query parameter `status`: value removed is not one of [active disabled]")
+			So(messages, ShouldContainKey, "invalid enum value of query parameter `page`: value two is not of type integer")
+			So(messages, ShouldContainKey, "undefined type `Unknown`")
+			So(messages, ShouldContainKey, "facet `minItems` of query parameter `tags` is not applicable to string type")
+		})
+	})
+}
@@ -0,0 +1,242 @@
+package raml
+
+// This file contains the overlays and extensions support.
+// See http://docs.raml.org/specs/1.0/#raml-10-spec-overlays-and-extensions
+
+import (
+	"reflect"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+const (
+	fragmentOverlay   = "Overlay"
+	fragmentExtension = "Extension"
+)
+
+var (
+	// properties that an overlay is allowed to add or change.
+	// Besides these properties, an overlay can only add or change annotations.
+	overlayAllowedProperties = map[string]bool{
+		"title":           true,
+		"displayName":     true,
+		"description":     true,
+		"documentation":   true,
+		"usage":           true,
+		"example":         true,
+		"examples":        true,
+		"annotationTypes": true,
+	}
+
+	// root properties that are not merged from an overlay/extension to the master
+	mergeIgnoredProperties = map[string]bool{
+		"masterRef": true,
+		"usage":     true,
+	}
+)
+
+// isExtensionFragment returns true if the RAML fragment identifier
+// is an overlay or an extension
+func isExtensionFragment(fragment string) bool {
+	return fragment == fragmentOverlay || fragment == fragmentExtension
+}
+
+// applyExtension merges an overlay or extension document onto the
+// master API definition it refers to via the `masterRef` property.
+// The master could be an overlay or extension too, in which case it is
+// resolved first.
+// It returns the merged document, the merged nodes keep their original position.
+func (p *Parser) applyExtension(filePath, fragment string, ext *document) (*document, error) {
+	filePos := Position{File: filePath}
+
+	masterRef := mappingValue(ext.root, "masterRef")
+	if masterRef == nil || masterRef.Kind != yamlv3.ScalarNode || masterRef.Value == "" {
+		return nil, newError(filePos, "%v %v doesn't have masterRef", strings.ToLower(fragment), filePath)
+	}
+	refPos := ext.position(masterRef)
+
+	// read the master file, relative to this file
+	extDir := p.dir(filePath)
+	masterPath := p.join(extDir, masterRef.Value)
+	masterDir := p.dir(masterPath)
+
+	masterVersion, masterFragment, masterContents, err := p.readRAMLFile(masterPath)
+	if err != nil {
+		return nil, newError(refPos, "failed to read masterRef of %v: %v", filePath, err)
+	}
+	if masterVersion == ramlVersion08 {
+		return nil, newError(refPos, "masterRef of %v must be a RAML 1.0 document", filePath)
+	}
+	master, err := p.loadDocument(masterPath, masterContents)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case isExtensionFragment(masterFragment):
+		master, err = p.applyExtension(masterPath, masterFragment, master)
+		if err != nil {
+			return nil, err
+		}
+	case masterFragment != "":
+		return nil, newError(refPos, "masterRef of %v must be an API definition, overlay, or extension, got %v",
+			filePath, masterFragment)
+	}
+
+	if master.root == nil {
+		master.root = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+		master.files[master.root] = masterPath
+	}
+	if master.root.Kind != yamlv3.MappingNode {
+		return nil, newError(master.position(master.root), "master %v must be a map", masterPath)
+	}
+
+	// libraries of the master are relative to the master file,
+	// make them relative to this file
+	if err := p.rebaseUses(master, masterDir, extDir); err != nil {
+		return nil, err
+	}
+
+	// the merged document has the nodes of the master and of the extension
+	for n, file := range ext.files {
+		master.files[n] = file
+	}
+	if err := master.mergeMapping(master.root, ext.root, fragment == fragmentOverlay, ""); err != nil {
+		return nil, err
+	}
+	return master, nil
+}
+
+// mergeMapping merges extension node to the master node
+// following the RAML merging rules:
+// - property which not exist in master is added to master
+// - object property is merged recursively
+// - array property is appended to the master array
+// - scalar property replace the master value
+// An overlay is only allowed to add or change the
+// overlayAllowedProperties and annotations.
+func (doc *document) mergeMapping(master, ext *yamlv3.Node, overlay bool, path string) error {
+	for i := 0; i+1 < len(ext.Content); i += 2 {
+		keyNode, valNode := ext.Content[i], ext.Content[i+1]
+		key := keyNode.Value
+		if path == "" && mergeIgnoredProperties[key] {
+			continue
+		}
+		itemPath := path + "/" + key
+
+		// once an overlay reach an allowed property,
+		// the whole property is free to change
+		itemOverlay := overlay && !overlayAllowedProperty(key)
+
+		idx := mappingIndex(master, key)
+		if idx < 0 {
+			if itemOverlay {
+				return newError(doc.position(keyNode), "overlay can't add `%v`", itemPath)
+			}
+			master.Content = append(master.Content, keyNode, valNode)
+			continue
+		}
+
+		val, err := doc.mergeNode(master.Content[idx], valNode, itemOverlay, itemPath)
+		if err != nil {
+			return err
+		}
+		master.Content[idx] = val
+	}
+	return nil
+}
+
+// mergeNode merges extension value to the master value
+func (doc *document) mergeNode(master, ext *yamlv3.Node, overlay bool, path string) (*yamlv3.Node, error) {
+	// a property declared without value, e.g. `get:`, is an empty object
+	if isNullNode(master) && ext.Kind == yamlv3.MappingNode {
+		master = doc.newNode(master, yamlv3.MappingNode, "!!map", "")
+	}
+
+	if master.Kind == yamlv3.MappingNode && ext.Kind == yamlv3.MappingNode {
+		return master, doc.mergeMapping(master, ext, overlay, path)
+	}
+
+	if overlay {
+		if !nodesEqual(master, ext) {
+			return nil, newError(doc.position(ext), "overlay can't change `%v`", path)
+		}
+		return master, nil
+	}
+
+	if master.Kind == yamlv3.SequenceNode && ext.Kind == yamlv3.SequenceNode {
+		for _, elem := range ext.Content {
+			if !inNodes(elem, master.Content) {
+				master.Content = append(master.Content, elem)
+			}
+		}
+		return master, nil
+	}
+	return ext, nil
+}
+
+// rebaseUses changes the library paths in `uses` property
+// from relative to `fromDir` to relative to `toDir`
+func (p *Parser) rebaseUses(doc *document, fromDir, toDir string) error {
+	uses := mappingValue(doc.root, "uses")
+	if uses == nil || uses.Kind != yamlv3.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(uses.Content); i += 2 {
+		name, path := uses.Content[i], uses.Content[i+1]
+		if path.Kind != yamlv3.ScalarNode || path.ShortTag() != "!!str" {
+			continue
+		}
+		rel, err := p.rel(toDir, p.join(fromDir, path.Value))
+		if err != nil {
+			return newError(doc.position(path), "can't resolve library %v: %v", name.Value, err)
+		}
+		path.Value = rel
+	}
+	return nil
+}
+
+// overlayAllowedProperty returns true if an overlay
+// is allowed to add or change a property.
+func overlayAllowedProperty(key string) bool {
+	return overlayAllowedProperties[key] || isAnnotationKey(key)
+}
+
+// isAnnotationKey returns true if the key is an annotation name,
+// which is enclosed in parentheses
+func isAnnotationKey(key string) bool {
+	return strings.HasPrefix(key, "(") && strings.HasSuffix(key, ")")
+}
+
+// nodesEqual returns true if two nodes have the same value
+func nodesEqual(a, b *yamlv3.Node) bool {
+	var aVal, bVal interface{}
+	if err := a.Decode(&aVal); err != nil {
+		return false
+	}
+	if err := b.Decode(&bVal); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aVal, bVal)
+}
+
+// inNodes returns true if a node has the same value as one of the nodes
+func inNodes(n *yamlv3.Node, nodes []*yamlv3.Node) bool {
+	for _, elem := range nodes {
+		if nodesEqual(n, elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// check if an element exist in a slice
+func inInterfaceSlice(elem interface{}, arr []interface{}) bool {
+	for _, v := range arr {
+		if reflect.DeepEqual(v, elem) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOverlayExtension(t *testing.T) {
+	Convey("overlays and extensions", t, func() {
+		apiDef := new(APIDefinition)
+
+		Convey("overlay", func() {
+			err := ParseFile("./samples/overlays/overlay.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			So(apiDef.Title, ShouldEqual, "API Buku")
+			So(apiDef.BaseURI, ShouldEqual, "http://api.example.com/{version}")
+
+			books := apiDef.Resources["/books"]
+			So(books.Description, ShouldEqual, "Koleksi buku")
+			So(books.Get.Description, ShouldEqual, "Ambil semua buku")
+			So(books.Get.QueryParameters, ShouldContainKey, "author")
+			So(books.Nested, ShouldContainKey, "/{isbn}")
+
+			// library of the master
+			So(apiDef.Libraries, ShouldContainKey, "books")
+			So(apiDef.Libraries["books"].Types, ShouldContainKey, "Book")
+		})
+
+		Convey("extension of an overlay", func() {
+			err := ParseFile("./samples/overlays/extensions/customer.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			So(apiDef.Title, ShouldEqual, "API Buku")
+			So(apiDef.BaseURI, ShouldEqual, "http://customer.example.com/{version}")
+			So(apiDef.Protocols, ShouldResemble, []string{"HTTP", "HTTPS"})
+
+			books := apiDef.Resources["/books"]
+			So(books.Get.Description, ShouldEqual, "Ambil semua buku")
+			So(books.Get.QueryParameters, ShouldContainKey, "author")
+			So(books.Get.QueryParameters, ShouldContainKey, "publisher")
+			So(books.Post, ShouldNotBeNil)
+			So(books.Post.Description, ShouldEqual, "Add a book")
+
+			So(apiDef.Resources, ShouldContainKey, "/authors")
+
+			// library path must be relative to the extension
+			So(apiDef.Uses["books"], ShouldEqual, "../libraries/books.raml")
+			So(apiDef.Libraries["books"].Types, ShouldContainKey, "Book")
+		})
+
+		Convey("overlay can't add behavior", func() {
+			err := ParseFile("./samples/overlays/bad_overlay.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "/books/post")
+		})
+
+		Convey("overlay can't change behavior", func() {
+			err := ParseFile("./samples/overlays/bad_overlay_change.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "/baseUri")
+		})
+	})
+}
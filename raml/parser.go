@@ -30,128 +30,214 @@ package raml
 // This file contains all of the RAML parser related code.
 
 import (
-	"bufio"
 	"bytes"
 	"errors"
 	"fmt"
-	"io"
+	"io/fs"
 	"io/ioutil"
+	"path"
 	"path/filepath"
-	"reflect"
 	"strings"
 
 	log "github.com/Sirupsen/logrus"
-	"github.com/gigforks/yaml"
 	"github.com/kr/pretty"
 )
 
-var (
-	// directory of raml file
-	// we need to save it as global variable because
-	// library inside library file path is not relative to
-	// the library that include it.
-	// But relative to RAML file
-	ramlFileDir string
-)
+// Parser parses RAML documents from a file system.
+// All the state of a parsing is kept by the parser,
+// so it is safe to parse many documents concurrently.
+type Parser struct {
+	// file system of the RAML files,
+	// nil means the operating system file system
+	fsys fs.FS
+}
+
+// NewParser creates a parser which reads the RAML files from fsys,
+// e.g. os.DirFS, embed.FS, zip.Reader or fstest.MapFS.
+// The file paths are slash-separated and relative to the root of fsys.
+// If fsys is nil, the files are read from the operating system file system.
+func NewParser(fsys fs.FS) *Parser {
+	return &Parser{fsys: fsys}
+}
 
 // ParseFile parses an RAML file.
 // Returns a raml.APIDefinition value or an error if
 // something went wrong.
 func ParseFile(filePath string, root Root) error {
-	_, err := ParseReadFile(filePath, root)
-	return err
+	return NewParser(nil).ParseFile(filePath, root)
 }
 
 // ParseReadFile parse an .raml file.
 // It returns API definition and the concatenated .raml file.
 func ParseReadFile(filePath string, root Root) ([]byte, error) {
+	return NewParser(nil).ParseReadFile(filePath, root)
+}
 
-	// Get the working directory
-	workingDirectory, fileName := filepath.Split(filePath)
-	if strings.HasSuffix(fmt.Sprint(reflect.TypeOf(root)), "APIDefinition") { // when we parse for APIDefinition, we reset ramlFileDir
-		ramlFileDir = workingDirectory
-	}
+// ParseFS parses an RAML file from a file system.
+func ParseFS(fsys fs.FS, filePath string, root Root) error {
+	return NewParser(fsys).ParseFile(filePath, root)
+}
 
-	// Read original file contents into a byte array
-	mainFileBytes, err := readFileContents(workingDirectory, fileName)
+// ParseFile parses an RAML file.
+// Returns a raml.APIDefinition value or an error if
+// something went wrong.
+func (p *Parser) ParseFile(filePath string, root Root) error {
+	_, err := p.ParseReadFile(filePath, root)
+	return err
+}
+
+// ParseReadFile parse an .raml file.
+// It returns API definition and the concatenated .raml file.
+func (p *Parser) ParseReadFile(filePath string, root Root) ([]byte, error) {
+	filePos := Position{File: filePath}
 
+	// Read the file
+	version, fragment, contents, err := p.readRAMLFile(filePath)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, toRAMLError(err, filePos)
 	}
 
-	// Get the contents of the main file
-	mainFileBuffer := bytes.NewBuffer(mainFileBytes)
+	// RAML 0.8 document is converted to RAML 1.0
+	if version == ramlVersion08 {
+		return p.parseRAML08(filePath, contents, root)
+	}
 
-	// Verify the YAML version
-	var ramlVersion string
-	firstLine, err := mainFileBuffer.ReadString('\n')
+	// Load the original file, following !include directive
+	doc, err := p.loadDocument(filePath, contents)
 	if err != nil {
-		return []byte{}, fmt.Errorf("Problem reading RAML file (Error: %s)", err.Error())
+		return []byte{}, err
 	}
 
-	// We read some data...
-	if len(firstLine) >= 10 {
-		ramlVersion = firstLine[:10]
-	}
-	if ramlVersion != "#%RAML 1.0" {
-		return []byte{}, errors.New("Input file is not a RAML 1.0 file. Make " +
-			"sure the file starts with #%RAML 1.0")
+	// overlays and extensions are merged onto their master API definition
+	if isExtensionFragment(fragment) {
+		doc, err = p.applyExtension(filePath, fragment, doc)
+		if err != nil {
+			return []byte{}, toRAMLError(err, filePos)
+		}
 	}
 
-	// Pre-process the original file, following !include directive
-	preprocessedContentsBytes, err :=
-		preProcess(mainFileBuffer, workingDirectory)
-
+	// Unmarshal into an APIDefinition value
+	preprocessedContentsBytes, err := doc.decode(root)
 	if err != nil {
-		return []byte{},
-			fmt.Errorf("Error preprocessing RAML file (Error: %s)", err.Error())
+		return []byte{}, err
 	}
 
 	if log.GetLevel() == log.DebugLevel {
 		pretty.Println(string(preprocessedContentsBytes))
 	}
+	setRAMLVersion(root, version)
 
-	// Unmarshal into an APIDefinition value
+	if err := p.parseLibraries(filePath, root); err != nil {
+		return preprocessedContentsBytes, err
+	}
 
-	// Go!
-	err = yaml.Unmarshal(preprocessedContentsBytes, root)
+	if err := root.PostProcess(filePath); err != nil {
+		return preprocessedContentsBytes, toRAMLError(err, filePos)
+	}
 
-	// Any errors?
+	// Good.
+	return preprocessedContentsBytes, nil
+}
+
+// parseRAML08 parses RAML 0.8 document,
+// the document is converted to RAML 1.0 before being unmarshaled.
+func (p *Parser) parseRAML08(filePath string, contents []byte, root Root) ([]byte, error) {
+	filePos := Position{File: filePath}
+
+	doc, err := p.upgrade08(filePath, contents)
+	if err != nil {
+		return []byte{}, toRAMLError(err, filePos)
+	}
+	upgraded, err := doc.decode(root)
 	if err != nil {
+		return []byte{}, err
+	}
+	setRAMLVersion(root, ramlVersion08)
+
+	if err := root.PostProcess(filePath); err != nil {
+		return upgraded, toRAMLError(err, filePos)
+	}
+	return upgraded, nil
+}
 
-		// Create a RAML error value
-		ramlError := new(Error)
+// setRAMLVersion sets the RAML version of the original API definition document
+func setRAMLVersion(root Root, version string) {
+	if apiDef, ok := root.(*APIDefinition); ok {
+		apiDef.RAMLVersion = version
+	}
+}
 
-		// Copy the YAML errors into it..
-		if yamlErrors, ok := err.(*yaml.TypeError); ok {
-			populateRAMLError(ramlError, yamlErrors)
-		} else {
-			// Or just any other error, though this shouldn't happen.
-			ramlError.Errors = append(ramlError.Errors, err.Error())
+// parseLibraries parses all libraries used by a RAML document.
+// The library paths are relative to the document.
+func (p *Parser) parseLibraries(filePath string, root Root) error {
+	doc, ok := root.(libraryUser)
+	if !ok {
+		return nil
+	}
+
+	libs := map[string]*Library{}
+	for name, libFile := range doc.libraryFiles() {
+		lib := &Library{Filename: libFile}
+		libPath := p.join(p.dir(filePath), libFile)
+		if err := p.ParseFile(libPath, lib); err != nil {
+			// library error already has the position in the library file
+			return toRAMLError(err, Position{File: libPath})
 		}
+		libs[name] = lib
+	}
+	doc.setLibraries(libs)
+	return nil
+}
 
-		return []byte{}, ramlError
+// readRAMLFile reads a RAML file and verifies the RAML version.
+// It returns the RAML version line, the fragment identifier found
+// after the RAML version (e.g. "Overlay") and the file contents.
+func (p *Parser) readRAMLFile(filePath string) (string, string, []byte, error) {
+	// Read original file contents into a byte array
+	mainFileBytes, err := p.readFileContents(filePath)
+	if err != nil {
+		return "", "", nil, err
 	}
 
-	if err := root.PostProcess(filePath); err != nil {
-		return preprocessedContentsBytes, err
+	// Get the contents of the main file
+	mainFileBuffer := bytes.NewBuffer(mainFileBytes)
+
+	// Verify the YAML version
+	var ramlVersion string
+	firstLine, err := mainFileBuffer.ReadString('\n')
+	if err != nil {
+		return "", "", nil, fmt.Errorf("Problem reading RAML file (Error: %s)", err.Error())
 	}
 
-	// Good.
-	return preprocessedContentsBytes, nil
+	// We read some data...
+	if len(firstLine) >= 10 {
+		ramlVersion = firstLine[:10]
+	}
+	switch ramlVersion {
+	case ramlVersion10:
+	case ramlVersion08: // RAML 0.8 doesn't have fragments
+		return ramlVersion, "", mainFileBytes, nil
+	default:
+		return "", "", nil, errors.New("Input file is not a RAML 1.0 or 0.8 file. Make " +
+			"sure the file starts with #%RAML 1.0 or #%RAML 0.8")
+	}
+	return ramlVersion, strings.TrimSpace(firstLine[10:]), mainFileBytes, nil
 }
 
 // Reads the contents of a file, returns a bytes buffer
-func readFileContents(workingDirectory string, fileName string) ([]byte, error) {
-
-	filePath := filepath.Join(workingDirectory, fileName)
-
-	if fileName == "" {
+func (p *Parser) readFileContents(filePath string) ([]byte, error) {
+	if filePath == "" || strings.HasSuffix(filePath, "/") {
 		return nil, fmt.Errorf("File name cannot be nil: %s", filePath)
 	}
 
 	// Read the file
-	fileContentsArray, err := ioutil.ReadFile(filePath)
+	var fileContentsArray []byte
+	var err error
+	if p.fsys == nil {
+		fileContentsArray, err = ioutil.ReadFile(filePath)
+	} else {
+		fileContentsArray, err = fs.ReadFile(p.fsys, filePath)
+	}
 	if err != nil {
 		return nil,
 			fmt.Errorf("Could not read file %s (Error: %s)",
@@ -161,95 +247,28 @@ func readFileContents(workingDirectory string, fileName string) ([]byte, error)
 	return fileContentsArray, nil
 }
 
-// preProcess acts as a preprocessor for a RAML document in YAML format,
-// including files referenced via !include. It returns a pre-processed document.
-func preProcess(originalContents io.Reader, workingDirectory string) ([]byte, error) {
-
-	// NOTE: Since YAML doesn't support !include directives, and since go-yaml
-	// does NOT play nice with !include tags, this has to be done like this.
-	// I am considering modifying go-yaml to add custom handlers for specific
-	// tags, to add support for !include, but for now - this method is
-	// GoodEnough(TM) and since it will only happen once, I am not prematurely
-	// optimizing it.
-
-	var preprocessedContents bytes.Buffer
-
-	// Go over each line, looking for !include tags
-	scanner := bufio.NewScanner(originalContents)
-	var line string
-
-	// Scan the file until we reach EOF or error out
-	for scanner.Scan() {
-		line = scanner.Text()
-
-		// Did we find an !include directive to handle?
-		if idx := strings.Index(line, "!include"); idx != -1 {
-
-			// TODO: Do this better
-			includeLength := len("!include ")
-
-			includedFile := line[idx+includeLength:]
-
-			preprocessedContents.Write([]byte(line[:idx]))
-
-			// Get the included file contents
-			includedContents, err :=
-				readFileContents(workingDirectory, includedFile)
-
-			if err != nil {
-				return nil,
-					fmt.Errorf("Error including file %s:\n    %s",
-						includedFile, err.Error())
-			}
-
-			// add newline to included content
-			prepender := []byte("\n")
-
-			// if it is in response body, we prepend "|" to make it as string
-			if strings.HasPrefix(strings.TrimSpace(line), "type") { // in body
-				prepender = []byte("|\n")
-			}
-			includedContents = append(prepender, includedContents...)
-
-			// TODO: Check that you only insert .yaml, .raml, .txt and .md files
-			// In case of .raml or .yaml, remove the comments
-			// In case of other files, Base64 them first.
-
-			// TODO: Better, step by step checks .. though prolly it'll panic
-			// Write text files in the same indentation as the first line
-			internalScanner :=
-				bufio.NewScanner(bytes.NewBuffer(includedContents))
-
-			// Indent by this much
-			firstLine := true
-			indentationString := ""
-
-			// Go over each line, write it
-			for internalScanner.Scan() {
-				internalLine := internalScanner.Text()
-
-				preprocessedContents.WriteString(indentationString)
-				if firstLine {
-					indentationString = strings.Repeat(" ", idx)
-					firstLine = false
-				}
-
-				preprocessedContents.WriteString(internalLine)
-				preprocessedContents.WriteByte('\n')
-			}
-
-		} else {
+// join joins path elements of the parser file system
+func (p *Parser) join(elem ...string) string {
+	if p.fsys == nil {
+		return filepath.Join(elem...)
+	}
+	return path.Join(elem...)
+}
 
-			// No, just a simple line.. write it
-			preprocessedContents.WriteString(line)
-			preprocessedContents.WriteByte('\n')
-		}
+// dir returns the directory of a file in the parser file system
+func (p *Parser) dir(filePath string) string {
+	if p.fsys == nil {
+		return filepath.Dir(filePath)
 	}
+	return path.Dir(filePath)
+}
 
-	// Any errors encountered?
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("Error reading YAML file: %s", err.Error())
+// rel returns path of target relative to base directory,
+// both are paths in the parser file system
+func (p *Parser) rel(base, target string) (string, error) {
+	if p.fsys == nil {
+		return filepath.Rel(base, target)
 	}
-	// Return the preprocessed contents
-	return preprocessedContents.Bytes(), nil
+	rel, err := filepath.Rel(filepath.FromSlash(base), filepath.FromSlash(target))
+	return filepath.ToSlash(rel), err
 }
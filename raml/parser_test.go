@@ -0,0 +1,162 @@
+package raml
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestInclude(t *testing.T) {
+	Convey("!include", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/includes/api.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		Convey("raml fragments in flow sequence", func() {
+			So(len(apiDef.Documentation), ShouldEqual, 2)
+			So(apiDef.Documentation[0].Title, ShouldEqual, "Introduction")
+			So(apiDef.Documentation[1].Title, ShouldEqual, "Usage")
+		})
+
+		Convey("nested include relative to the included file", func() {
+			So(apiDef.Documentation[0].Content, ShouldEqual, "Welcome to the includes API\n")
+
+			user := apiDef.Types["User"]
+			So(user.Type, ShouldEqual, "object")
+			address := ToProperty("address", user.Properties["address"])
+			So(address.Type, ShouldEqual, "object")
+		})
+
+		Convey("text files as string", func() {
+			users := apiDef.Resources["/users"]
+			So(users.Description, ShouldEqual, "# Users\n\nThe users collection\n")
+
+			body := users.Post.Bodies.ApplicationXML
+			So(body, ShouldNotBeNil)
+			So(body.Schema, ShouldStartWith, "<?xml")
+			So(body.Schema, ShouldContainSubstring, `<xs:element name="user">`)
+			So(body.Example, ShouldEqual, "<user><name>john</name></user>\n")
+		})
+
+		Convey("JSON schema as string", func() {
+			So(apiDef.Schemas[0]["PersonSchema"], ShouldStartWith, "{")
+			So(apiDef.Schemas[0]["PersonSchema"], ShouldContainSubstring, `"$schema"`)
+		})
+
+		Convey("JSON schema type", func() {
+			schema, ok := apiDef.Types["Person"].JSONSchema()
+			So(ok, ShouldBeTrue)
+			So(schema, ShouldContainSubstring, `"$schema"`)
+
+			types, err := JSONSchemaTypes("Person", schema)
+			So(err, ShouldBeNil)
+			So(types["Person"].Type, ShouldEqual, "object")
+			So(types["Person"].Properties, ShouldContainKey, "name")
+		})
+
+		Convey("binary file", func() {
+			avatar := apiDef.Resources["/users"].Nested["/{id}/avatar"]
+			body := avatar.Get.Responses["200"].Bodies.ForMIMEType["image/png"]
+			So(body.Example, ShouldEqual, "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+		})
+	})
+}
+
+func TestParseFS(t *testing.T) {
+	Convey("parse from fs.FS", t, func() {
+		Convey("directory file system", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFS(os.DirFS("./samples"), "includes/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			So(apiDef.Documentation, ShouldHaveLength, 2)
+			So(apiDef.Types["User"].File, ShouldEqual, "includes/types/user.raml")
+			So(apiDef.Resources["/users"].File, ShouldEqual, "includes/api.raml")
+		})
+
+		Convey("libraries and overlays", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFS(os.DirFS("./samples"), "simple_with_lib.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(apiDef.Libraries, ShouldContainKey, "files")
+			So(apiDef.Libraries["files"].Libraries, ShouldContainKey, "file-type")
+
+			apiDef = new(APIDefinition)
+			err = ParseFS(os.DirFS("./samples/overlays"), "overlay.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(apiDef.Title, ShouldEqual, "API Buku")
+		})
+
+		Convey("in-memory file system", func() {
+			fsys := fstest.MapFS{
+				"specs/api.raml": {Data: []byte(`#%RAML 1.0
+title: In Memory
+uses:
+  common: ../common/types.raml
+types:
+  User: !include types/user.raml
+/users:
+  get:
+    responses:
+      200:
+        body:
+          application/json:
+            type: common.Users
+`)},
+				"specs/types/user.raml": {Data: []byte(`#%RAML 1.0 DataType
+properties:
+  name: string
+`)},
+				"common/types.raml": {Data: []byte(`#%RAML 1.0 Library
+types:
+  Users: string[]
+`)},
+			}
+			apiDef := new(APIDefinition)
+			err := ParseFS(fsys, "specs/api.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(apiDef.Types["User"].Properties, ShouldContainKey, "name")
+			So(apiDef.Types["User"].File, ShouldEqual, "specs/types/user.raml")
+			So(apiDef.Libraries["common"].Types, ShouldContainKey, "Users")
+		})
+
+		Convey("file outside of the file system", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFS(os.DirFS("./samples/overlays"), "../includes/api.raml", apiDef)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestParseConcurrently(t *testing.T) {
+	Convey("parse many files concurrently", t, func() {
+		files := []string{
+			"./samples/includes/api.raml",
+			"./samples/simple_with_lib.raml",
+			"./samples/resource_type_chain.raml",
+			"./samples/overlays/overlay.raml",
+		}
+		parser := NewParser(nil)
+
+		var wg sync.WaitGroup
+		errs := make([]error, len(files)*4)
+		apiDefs := make([]*APIDefinition, len(files)*4)
+		for i := range apiDefs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				apiDefs[i] = new(APIDefinition)
+				errs[i] = parser.ParseFile(files[i%len(files)], apiDefs[i])
+			}(i)
+		}
+		wg.Wait()
+
+		for i, apiDef := range apiDefs {
+			So(errs[i], ShouldBeNil)
+			So(apiDef.Filename, ShouldEqual, files[i%len(files)])
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package raml
+
+import (
+	"fmt"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// Position is the location of a node in the RAML file it is defined.
+// For node in an included file, File is the included file.
+type Position struct {
+	File   string `yaml:"-" json:"-"`
+	Line   int    `yaml:"-" json:"-"` // 1-based line number, 0 if unknown
+	Column int    `yaml:"-" json:"-"` // 1-based column number, 0 if unknown
+}
+
+// setPosition implements positioner,
+// it is called by the parser after decoding a node.
+func (p *Position) setPosition(pos Position) {
+	*p = pos
+}
+
+// IsValid returns true if the position is known
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+func (p Position) String() string {
+	switch {
+	case p.IsValid() && p.Column == 0: // e.g. YAML syntax error
+		return strings.TrimPrefix(fmt.Sprintf("%v:%v", p.File, p.Line), ":")
+	case p.IsValid() && p.File != "":
+		return fmt.Sprintf("%v:%v:%v", p.File, p.Line, p.Column)
+	case p.IsValid():
+		return fmt.Sprintf("line %v:%v", p.Line, p.Column)
+	default:
+		return p.File
+	}
+}
+
+// examplePositions are the positions of the example, the named examples and the default value
+// of a type, a parameter or a body, and of it's inline properties.
+// The values are decoded as interfaces, which don't keep their position.
+type examplePositions struct {
+	examplePos  Position
+	defaultPos  Position
+	examplesPos map[string]Position         // by name of the example
+	propsPos    map[string]examplePositions // by name of the property
+}
+
+// setExamplePositions sets the positions of the `example`, `default` and named examples keys
+// of the mapping node the value is decoded from, position returns the position of a node.
+func (ep *examplePositions) setExamplePositions(n *yamlv3.Node, position func(*yamlv3.Node) Position) {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key, val := n.Content[i], n.Content[i+1]
+		switch key.Value {
+		case "example":
+			ep.examplePos = position(key)
+		case "default":
+			ep.defaultPos = position(key)
+		case "examples":
+			ep.examplesPos = map[string]Position{}
+			for j := 0; val.Kind == yamlv3.MappingNode && j+1 < len(val.Content); j += 2 {
+				ep.examplesPos[val.Content[j].Value] = position(val.Content[j])
+			}
+		case "properties":
+			ep.propsPos = map[string]examplePositions{}
+			for j := 0; val.Kind == yamlv3.MappingNode && j+1 < len(val.Content); j += 2 {
+				var prop examplePositions
+				if val.Content[j+1].Kind == yamlv3.MappingNode {
+					prop.setExamplePositions(val.Content[j+1], position)
+				}
+				ep.propsPos[strings.TrimSuffix(val.Content[j].Value, "?")] = prop
+			}
+		}
+	}
+}
+
+// example returns the position of the example, pos if unknown
+func (ep examplePositions) example(pos Position) Position {
+	return knownPosition(ep.examplePos, pos)
+}
+
+// namedExample returns the position of a named example, pos if unknown
+func (ep examplePositions) namedExample(name string, pos Position) Position {
+	return knownPosition(ep.examplesPos[name], pos)
+}
+
+// defaultValue returns the position of the default value, pos if unknown
+func (ep examplePositions) defaultValue(pos Position) Position {
+	return knownPosition(ep.defaultPos, pos)
+}
+
+// property returns the example positions of an inline property
+func (ep examplePositions) property(name string) examplePositions {
+	return ep.propsPos[name]
+}
+
+func knownPosition(pos, fallback Position) Position {
+	if pos.IsValid() {
+		return pos
+	}
+	return fallback
+}
@@ -0,0 +1,122 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPosition(t *testing.T) {
+	Convey("nodes position", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/includes/api.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		const apiFile = "./samples/includes/api.raml"
+
+		Convey("resource, method, response", func() {
+			users := apiDef.Resources["/users"]
+			So(users.Position, ShouldResemble, Position{File: apiFile, Line: 10, Column: 3})
+			So(users.Post.Position, ShouldResemble, Position{File: apiFile, Line: 12, Column: 5})
+
+			avatar := users.Nested["/{id}/avatar"]
+			resp := avatar.Get.Responses["200"]
+			So(resp.Position, ShouldResemble, Position{File: apiFile, Line: 23, Column: 11})
+		})
+
+		Convey("named parameter", func() {
+			avatar := apiDef.Resources["/users"].Nested["/{id}/avatar"]
+			So(avatar.URIParameters["id"].Position, ShouldResemble, Position{File: apiFile, Line: 19, Column: 9})
+		})
+
+		Convey("type in included file", func() {
+			user := apiDef.Types["User"]
+			So(user.Position, ShouldResemble, Position{File: "samples/includes/types/user.raml", Line: 2, Column: 1})
+		})
+	})
+
+	Convey("nodes position of an extension", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/overlays/extensions/customer.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		const extFile = "./samples/overlays/extensions/customer.raml"
+		const masterFile = "samples/overlays/api.raml"
+
+		books := apiDef.Resources["/books"]
+		So(books.Position, ShouldResemble, Position{File: masterFile, Line: 10, Column: 3})
+		So(books.Get.Position, ShouldResemble, Position{File: masterFile, Line: 12, Column: 5})
+		So(books.Post.Position, ShouldResemble, Position{File: extFile, Line: 12, Column: 5})
+		So(books.Get.QueryParameters["author"].Position, ShouldResemble, Position{File: masterFile, Line: 15, Column: 9})
+		So(books.Get.QueryParameters["publisher"].Position, ShouldResemble, Position{File: extFile, Line: 10, Column: 9})
+		So(apiDef.Resources["/authors"].Position, ShouldResemble, Position{File: extFile, Line: 14, Column: 3})
+	})
+
+	Convey("nodes position of RAML 0.8 document", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/raml08/api.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		const apiFile = "./samples/raml08/api.raml"
+
+		users := apiDef.Resources["/users"]
+		So(users.Position, ShouldResemble, Position{File: apiFile, Line: 40, Column: 3})
+		So(users.Get.QueryParameters["since"].Position, ShouldResemble, Position{File: apiFile, Line: 45, Column: 9})
+		So(apiDef.Types["Address"].Position, ShouldResemble, Position{File: apiFile, Line: 8, Column: 14})
+	})
+
+	Convey("errors position", t, func() {
+		Convey("YAML error", func() {
+			err := ParseFile("./samples/bad_raml.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(len(ramlErr.Errors), ShouldEqual, 1)
+			So(ramlErr.Errors[0].File, ShouldEqual, "./samples/bad_raml.raml")
+			So(ramlErr.Errors[0].Line, ShouldEqual, 44)
+			So(ramlErr.Errors[0].Message, ShouldContainSubstring, "must be integer")
+		})
+
+		Convey("error in an extension", func() {
+			err := ParseFile("./samples/overlays/bad_extension.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(len(ramlErr.Errors), ShouldEqual, 1)
+			So(ramlErr.Errors[0].Position, ShouldResemble, Position{File: "./samples/overlays/bad_extension.raml", Line: 9, Column: 20})
+			So(ramlErr.Errors[0].Message, ShouldContainSubstring, "must be integer")
+		})
+
+		Convey("error in RAML 0.8 document", func() {
+			err := ParseFile("./samples/raml08/bad_api.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(len(ramlErr.Errors), ShouldEqual, 1)
+			So(ramlErr.Errors[0].Position, ShouldResemble, Position{File: "./samples/raml08/bad_api.raml", Line: 8, Column: 18})
+			So(ramlErr.Errors[0].Message, ShouldContainSubstring, "`first`")
+		})
+
+		Convey("overlay error", func() {
+			err := ParseFile("./samples/overlays/bad_overlay_change.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(ramlErr.Errors[0].Position, ShouldResemble, Position{File: "./samples/overlays/bad_overlay_change.raml", Line: 3, Column: 10})
+		})
+
+		Convey("post processing error", func() {
+			err := ParseFile("./samples/bad_trait.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(ramlErr.Errors[0].Position, ShouldResemble, Position{File: "./samples/bad_trait.raml", Line: 12, Column: 5})
+			So(ramlErr.Errors[0].Message, ShouldEqual, "invalid traits name:secured")
+		})
+	})
+}
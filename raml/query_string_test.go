@@ -0,0 +1,59 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestQueryString(t *testing.T) {
+	Convey("query string", t, func() {
+		apiDef := new(APIDefinition)
+		So(ParseFile("./samples/query_string/api.raml", apiDef), ShouldBeNil)
+		So(Validate(apiDef), ShouldBeEmpty)
+
+		Convey("union", func() {
+			m := apiDef.Resources["/places"].Get
+			So(m.QueryString.Type, ShouldEqual, "LatLong | Location")
+
+			props := m.QueryStringProperties(apiDef.Types)
+			So(props, ShouldHaveLength, 3)
+			for _, name := range []string{"lat", "long", "location"} {
+				So(ToProperty(name, props[name]).Required, ShouldBeFalse)
+			}
+		})
+
+		Convey("inherited from a trait", func() {
+			m := apiDef.Resources["/places"].Nested["/{id}/photos"].Get
+			So(m.QueryString, ShouldNotBeNil)
+			So(m.QueryString.Type, ShouldEqual, "Paging")
+
+			props := m.QueryStringProperties(apiDef.Types)
+			So(props, ShouldHaveLength, 2)
+			So(ToProperty("page-size", props["page-size"]).Type, ShouldEqual, "integer")
+			So(*ToProperty("page-size", props["page-size"]).Maximum, ShouldEqual, 100)
+		})
+
+		Convey("properties and parent type", func() {
+			props := apiDef.Resources["/events"].Get.QueryStringProperties(apiDef.Types)
+			So(props, ShouldHaveLength, 4)
+
+			from := ToProperty("from", props["from"])
+			So(from.Type, ShouldEqual, "date-only")
+			So(from.Required, ShouldBeTrue)
+			So(ToProperty("tags", props["tags"]).Required, ShouldBeFalse)
+		})
+
+		Convey("invalid query strings", func() {
+			apiDef := new(APIDefinition)
+			So(ParseFile("./samples/query_string/invalid.raml", apiDef), ShouldBeNil)
+
+			messages := map[string]bool{}
+			for _, d := range Validate(apiDef) {
+				messages[d.Message] = true
+			}
+			So(messages, ShouldContainKey, "queryParameters and queryString are mutually exclusive")
+			So(messages, ShouldContainKey, "queryString must be an object type, not `string`")
+		})
+	})
+}
@@ -0,0 +1,358 @@
+package raml
+
+import (
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// This file contains the conversion of RAML 0.8 document to RAML 1.0.
+// The conversion is done on the YAML nodes, so the converted document
+// could be parsed as RAML 1.0 document or written as RAML 1.0 file.
+
+const (
+	ramlVersion10 = "#%RAML 1.0"
+	ramlVersion08 = "#%RAML 0.8"
+)
+
+// properties of named parameters which are required by default in RAML 0.8.
+// In RAML 1.0, all of them are required by default.
+var optionalByDefault08 = map[string]bool{
+	"queryParameters": true,
+	"headers":         true,
+	"formParameters":  true,
+}
+
+// UpgradeFile reads a RAML 0.8 file and converts it to RAML 1.0 document.
+// All included files are inlined in the result.
+func UpgradeFile(filePath string) ([]byte, error) {
+	return NewParser(nil).Upgrade(filePath)
+}
+
+// Upgrade reads a RAML 0.8 file and converts it to RAML 1.0 document.
+// All included files are inlined in the result.
+func (p *Parser) Upgrade(filePath string) ([]byte, error) {
+	filePos := Position{File: filePath}
+
+	version, _, contents, err := p.readRAMLFile(filePath)
+	if err != nil {
+		return nil, toRAMLError(err, filePos)
+	}
+	if version != ramlVersion08 {
+		return nil, newError(filePos, "%v is not a RAML 0.8 file", filePath)
+	}
+
+	doc, err := p.upgrade08(filePath, contents)
+	if err != nil {
+		return nil, toRAMLError(err, filePos)
+	}
+	upgraded, err := doc.encode()
+	if err != nil {
+		return nil, toRAMLError(err, filePos)
+	}
+	return append([]byte(ramlVersion10+"\n"), upgraded...), nil
+}
+
+// upgrade08 converts the contents of RAML 0.8 file to RAML 1.0 document
+func (p *Parser) upgrade08(filePath string, contents []byte) (*document, error) {
+	doc, err := p.loadDocument(filePath, contents)
+	if err != nil {
+		return nil, err
+	}
+	if err := doc.upgradeRoot08(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// upgradeRoot08 converts the root of RAML 0.8 API definition
+func (doc *document) upgradeRoot08() error {
+	root := doc.root
+	if root == nil {
+		return nil
+	}
+	if root.Kind != yamlv3.MappingNode {
+		return newError(doc.position(root), "RAML 0.8 document must be a map")
+	}
+
+	var content []*yamlv3.Node
+	var types *yamlv3.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		keyNode, val := root.Content[i], root.Content[i+1]
+		key := keyNode.Value
+		switch {
+		case key == "schemas": // schemas are types in RAML 1.0
+			schemas, err := doc.sequenceToMap08(key, val)
+			if err != nil {
+				return err
+			}
+			if types == nil {
+				types = doc.newNode(val, yamlv3.MappingNode, "!!map", "")
+				content = append(content, doc.newNode(keyNode, yamlv3.ScalarNode, "!!str", "types"), types)
+			}
+			types.Content = append(types.Content, schemas.Content...)
+			continue
+		case key == "traits":
+			traits, err := doc.sequenceToMap08(key, val)
+			if err != nil {
+				return err
+			}
+			for j := 1; j < len(traits.Content); j += 2 {
+				doc.upgradeMethod08(traits.Content[j])
+			}
+			val = traits
+		case key == "resourceTypes":
+			rts, err := doc.sequenceToMap08(key, val)
+			if err != nil {
+				return err
+			}
+			for j := 1; j < len(rts.Content); j += 2 {
+				doc.upgradeResource08(rts.Content[j])
+			}
+			val = rts
+		case key == "securitySchemes":
+			schemes, err := doc.sequenceToMap08(key, val)
+			if err != nil {
+				return err
+			}
+			for j := 1; j < len(schemes.Content); j += 2 {
+				doc.upgradeSecurityScheme08(schemes.Content[j])
+			}
+			val = schemes
+		case key == "baseUriParameters":
+			val = doc.upgradeNamedParameters08(val, false)
+		case strings.HasPrefix(key, "/"):
+			doc.upgradeResource08(val)
+		}
+		content = append(content, keyNode, val)
+	}
+	root.Content = content
+	return nil
+}
+
+// sequenceToMap08 converts a RAML 0.8 sequence of single entry maps,
+// e.g. `traits: [ {secured: ...}, {paged: ...} ]`, to a map.
+func (doc *document) sequenceToMap08(key string, n *yamlv3.Node) (*yamlv3.Node, error) {
+	switch {
+	case isNullNode(n):
+		return doc.newNode(n, yamlv3.MappingNode, "!!map", ""), nil
+	case n.Kind == yamlv3.MappingNode:
+		return n, nil
+	case n.Kind == yamlv3.SequenceNode:
+		result := doc.newNode(n, yamlv3.MappingNode, "!!map", "")
+		for _, elem := range n.Content {
+			if elem.Kind != yamlv3.MappingNode {
+				return nil, newError(doc.position(elem), "invalid %v: element must be a map, got %v", key, elem.Value)
+			}
+			result.Content = append(result.Content, elem.Content...)
+		}
+		return result, nil
+	default:
+		return nil, newError(doc.position(n), "invalid %v: must be a sequence of maps, got %v", key, n.Value)
+	}
+}
+
+// upgradeResource08 converts a resource or resource type
+func (doc *document) upgradeResource08(r *yamlv3.Node) {
+	if r.Kind != yamlv3.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(r.Content); i += 2 {
+		key := r.Content[i].Value
+		switch {
+		case key == "uriParameters" || key == "baseUriParameters" ||
+			key == "uriParameters?" || key == "baseUriParameters?":
+			r.Content[i+1] = doc.upgradeNamedParameters08(r.Content[i+1], false)
+		case strings.HasPrefix(key, "/"):
+			doc.upgradeResource08(r.Content[i+1])
+		case isMethodName08(strings.TrimSuffix(key, "?")):
+			doc.upgradeMethod08(r.Content[i+1])
+		}
+	}
+}
+
+// upgradeMethod08 converts a method or trait
+func (doc *document) upgradeMethod08(m *yamlv3.Node) {
+	if m.Kind != yamlv3.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		key := strings.TrimSuffix(m.Content[i].Value, "?")
+		switch key {
+		case "queryParameters", "headers":
+			m.Content[i+1] = doc.upgradeNamedParameters08(m.Content[i+1], optionalByDefault08[key])
+		case "baseUriParameters":
+			m.Content[i+1] = doc.upgradeNamedParameters08(m.Content[i+1], false)
+		case "body":
+			doc.upgradeBodies08(m.Content[i+1])
+		case "responses":
+			doc.upgradeResponses08(m.Content[i+1])
+		}
+	}
+}
+
+// upgradeSecurityScheme08 converts a security scheme
+func (doc *document) upgradeSecurityScheme08(ss *yamlv3.Node) {
+	if describedBy := mappingValue(ss, "describedBy"); describedBy != nil {
+		doc.upgradeMethod08(describedBy)
+	}
+}
+
+// upgradeResponses08 converts the responses of a method
+func (doc *document) upgradeResponses08(responses *yamlv3.Node) {
+	if responses.Kind != yamlv3.MappingNode {
+		return
+	}
+	for i := 1; i < len(responses.Content); i += 2 {
+		r := responses.Content[i]
+		if r.Kind != yamlv3.MappingNode {
+			continue
+		}
+		for j := 0; j+1 < len(r.Content); j += 2 {
+			switch strings.TrimSuffix(r.Content[j].Value, "?") {
+			case "headers":
+				r.Content[j+1] = doc.upgradeNamedParameters08(r.Content[j+1], true)
+			case "body":
+				doc.upgradeBodies08(r.Content[j+1])
+			}
+		}
+	}
+}
+
+// upgradeBodies08 converts a body, which could be keyed by media types
+// or a single body of the default media type
+func (doc *document) upgradeBodies08(bodies *yamlv3.Node) {
+	if bodies.Kind != yamlv3.MappingNode {
+		return
+	}
+	if isBody08(bodies) {
+		doc.upgradeBody08(bodies)
+		return
+	}
+	for i := 1; i < len(bodies.Content); i += 2 {
+		if body := bodies.Content[i]; body.Kind == yamlv3.MappingNode {
+			doc.upgradeBody08(body)
+		}
+	}
+}
+
+// isBody08 returns true if it is a body declaration
+// instead of a map of media type to body declaration
+func isBody08(n *yamlv3.Node) bool {
+	for _, key := range []string{"schema", "formParameters", "example"} {
+		if mappingIndex(n, key) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// upgradeBody08 converts a body declaration:
+// - `schema` becomes `type`
+// - `formParameters` becomes `properties` of an object type
+func (doc *document) upgradeBody08(body *yamlv3.Node) {
+	var content []*yamlv3.Node
+	for i := 0; i+1 < len(body.Content); i += 2 {
+		keyNode, val := body.Content[i], body.Content[i+1]
+		switch keyNode.Value {
+		case "schema":
+			keyNode.Value = "type"
+		case "formParameters":
+			content = append(content,
+				doc.newNode(keyNode, yamlv3.ScalarNode, "!!str", "type"),
+				doc.newNode(keyNode, yamlv3.ScalarNode, "!!str", "object"))
+			keyNode.Value = "properties"
+			val = doc.upgradeNamedParameters08(val, true)
+		}
+		content = append(content, keyNode, val)
+	}
+	body.Content = content
+}
+
+// upgradeNamedParameters08 converts named parameters declaration:
+//   - multiple types declaration becomes union type
+//   - `date` type becomes `datetime` with rfc2616 format
+//   - repeatable parameter becomes array
+//   - `required: false` is added if it is optional by default in RAML 0.8
+//   - the parameter without declaration becomes a string, as it's default type
+//     in RAML 0.8 is string while in RAML 1.0 is any
+func (doc *document) upgradeNamedParameters08(params *yamlv3.Node, optional bool) *yamlv3.Node {
+	if params.Kind != yamlv3.MappingNode {
+		return params
+	}
+	for i := 1; i < len(params.Content); i += 2 {
+		params.Content[i] = doc.upgradeNamedParameter08(params.Content[i], optional)
+	}
+	return params
+}
+
+func (doc *document) upgradeNamedParameter08(n *yamlv3.Node, optional bool) *yamlv3.Node {
+	var np *yamlv3.Node
+	switch {
+	case isNullNode(n):
+	case n.Kind == yamlv3.MappingNode:
+		np = n
+	case n.Kind == yamlv3.SequenceNode: // multiple types
+		var types []string
+		for _, decl := range n.Content {
+			if decl.Kind != yamlv3.MappingNode {
+				continue
+			}
+			if np == nil {
+				np = decl
+			}
+			types = append(types, namedParameterType08(decl))
+		}
+		if len(types) > 1 {
+			doc.setMappingValue(np, "type", "!!str", strings.Join(types, " | "))
+		}
+	default:
+		return n
+	}
+	if np == nil {
+		np = doc.newNode(n, yamlv3.MappingNode, "!!map", "")
+	}
+
+	typ := namedParameterType08(np)
+	if typ == "date" {
+		typ = "datetime"
+		doc.setMappingValue(np, "format", "!!str", "rfc2616")
+	}
+
+	if idx := mappingIndex(np, "repeat"); idx >= 0 {
+		var repeat bool
+		np.Content[idx].Decode(&repeat)
+		np.Content = append(np.Content[:idx-1], np.Content[idx+1:]...)
+		if repeat {
+			if strings.Contains(typ, "|") {
+				typ = "(" + typ + ")"
+			}
+			typ += "[]"
+		}
+	}
+	doc.setMappingValue(np, "type", "!!str", typ)
+
+	if optional && mappingIndex(np, "required") < 0 {
+		doc.setMappingValue(np, "required", "!!bool", "false")
+	}
+
+	return np
+}
+
+// namedParameterType08 returns type of a named parameter, the default is string
+func namedParameterType08(np *yamlv3.Node) string {
+	if t := mappingValue(np, "type"); t != nil && t.Kind == yamlv3.ScalarNode && t.ShortTag() == "!!str" && t.Value != "" {
+		return t.Value
+	}
+	return "string"
+}
+
+// isMethodName08 returns true if the key is an HTTP method name
+func isMethodName08(key string) bool {
+	for _, name := range methodNames {
+		if strings.ToLower(name) == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package raml
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRAML08(t *testing.T) {
+	Convey("RAML 0.8", t, func() {
+		Convey("parse", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/raml08/api.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(apiDef.RAMLVersion, ShouldEqual, ramlVersion08)
+
+			// schemas
+			So(apiDef.Types, ShouldContainKey, "User")
+			userSchema, ok := apiDef.Types["User"].JSONSchema()
+			So(ok, ShouldBeTrue)
+			So(userSchema, ShouldContainSubstring, `"email"`)
+			So(apiDef.Types["Address"].Schema, ShouldContainSubstring, "<xs:schema")
+
+			// securedBy with null
+			So(apiDef.SecuredBy, ShouldHaveLength, 2)
+			So(apiDef.SecuredBy[0].Name, ShouldEqual, "")
+			So(apiDef.SecuredBy[1].Name, ShouldEqual, "oauth_2_0")
+			So(apiDef.SecuritySchemes["oauth_2_0"].Type, ShouldEqual, "OAuth 2.0")
+
+			users := apiDef.Resources["/users"]
+
+			// named parameters
+			qp := users.Get.QueryParameters
+			So(qp["page"].Type, ShouldEqual, "integer") // from trait
+			So(qp["page"].Required, ShouldBeFalse)
+			So(qp["since"].Type, ShouldEqual, "datetime")
+			So(qp["tags"].Type, ShouldEqual, "string[]")
+			So(qp["tags"].Required, ShouldBeFalse)
+
+			user := users.Nested["/{userId}"]
+			So(user.URIParameters["userId"].Type, ShouldEqual, "integer | string")
+			So(user.URIParameters["userId"].Required, ShouldBeTrue) // URI parameters are required
+			So(user.Get.Headers["X-Request-Id"].Required, ShouldBeFalse)
+
+			// bodies
+			So(users.Get.Responses["200"].Bodies.ApplicationJSON.Type, ShouldEqual, "User")
+			So(users.Post.Bodies.ApplicationJSON.Type, ShouldEqual, "User")
+
+			form := users.Post.Bodies.ForMIMEType["application/x-www-form-urlencoded"]
+			So(form.Type, ShouldEqual, "object")
+			So(form.Properties, ShouldContainKey, "name")
+			So(form.Properties, ShouldContainKey, "avatar")
+
+			So(user.Get.Responses["200"].Bodies.Type, ShouldEqual, "User")
+
+			So(HasErrors(Validate(apiDef)), ShouldBeFalse)
+		})
+
+		Convey("upgrade", func() {
+			b, err := UpgradeFile("./samples/raml08/api.raml")
+			So(err, ShouldBeNil)
+
+			doc := string(b)
+			So(strings.HasPrefix(doc, "#%RAML 1.0\n"), ShouldBeTrue)
+			So(doc, ShouldNotContainSubstring, "schemas:")
+			So(doc, ShouldNotContainSubstring, "formParameters:")
+			So(doc, ShouldNotContainSubstring, "!include")
+
+			// the upgraded document is self contained
+			apiDef := new(APIDefinition)
+			err = ParseFS(fstest.MapFS{"api.raml": {Data: b}}, "api.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(apiDef.RAMLVersion, ShouldEqual, ramlVersion10)
+			So(apiDef.Resources["/users"].Get.QueryParameters["tags"].Type, ShouldEqual, "string[]")
+			So(HasErrors(Validate(apiDef)), ShouldBeFalse)
+
+			_, err = UpgradeFile("./samples/validate/valid.raml")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -9,6 +9,8 @@ import (
 
 // A Resource is the conceptual mapping to an entity or set of entities.
 type Resource struct {
+	// position of the resource in the RAML file
+	Position `yaml:"-"`
 
 	// Resources are identified by their relative URI, which MUST begin with
 	// a slash (/).
@@ -24,7 +26,9 @@ type Resource struct {
 	// Its value is a string and MAY be formatted using markdown.
 	Description string `yaml:"description"`
 
-	// TODO : annotationName
+	// Annotations to be applied to this resource.
+	// An annotation is a map having a key that begins with "(" and ends with ")"
+	Annotations Annotations `yaml:",regexp:^\\(.*\\)$"`
 
 	// In a RESTful API, methods are operations that are performed on a
 	// resource. A method MUST be one of the HTTP methods defined in the
@@ -68,18 +72,16 @@ type Resource struct {
 // postProcess doing post processing of a resource after being constructed by the parser.
 // some of the workds:
 // - assign all properties that can't be obtained from RAML document
-// - inherit from resource type
 // - inherit from traits
+// - inherit from resource type
 func (r *Resource) postProcess(uri string, parent *Resource, resourceTypes map[string]ResourceType, traitsMap map[string]Trait) error {
 	r.URI = strings.TrimSpace(uri)
 	r.Parent = parent
 
-	r.setMethods(traitsMap)
-
-	// inherit from resource types
-	if err := r.inheritResourceType(resourceTypes); err != nil {
+	if err := r.inherit(resourceTypes, traitsMap); err != nil {
 		return err
 	}
+	r.postProcessNamedParameters()
 
 	// process nested/child resources
 	for k := range r.Nested {
@@ -92,49 +94,78 @@ func (r *Resource) postProcess(uri string, parent *Resource, resourceTypes map[s
 	return nil
 }
 
-// inherit from a resource type
-func (r *Resource) inheritResourceType(resourceTypes map[string]ResourceType) error {
+// postProcessNamedParameters sets the name and the display name of the URI parameters
+// and of the named parameters of the methods, once they are inherited
+func (r *Resource) postProcessNamedParameters() {
+	r.URIParameters = postProcessNamedParameters(r.URIParameters)
+	for _, m := range r.Methods {
+		m.postProcessNamedParameters()
+	}
+}
+
+// inherit applies the traits and the resource type of this resource,
+// the nested resources are not processed.
+// The inheritance precedence of a method, from the highest:
+// the method itself, the method traits, the resource traits, and the resource type.
+func (r *Resource) inherit(resourceTypes map[string]ResourceType, traitsMap map[string]Trait) error {
 	// get resource type object to inherit
 	rt, err := r.getResourceType(resourceTypes)
-	if rt == nil || err != nil {
+	if err != nil {
 		return err
 	}
 
+	r.setMethods(rt)
+
+	// apply traits
+	for _, m := range r.Methods {
+		is := append(append([]DefinitionChoice{}, m.Is...), r.Is...)
+		if err := m.inheritFromTraits(r, is, traitsMap); err != nil {
+			return err
+		}
+	}
+
+	// inherit from resource types
+	if rt != nil {
+		if err := r.inheritResourceType(rt); err != nil {
+			pos := rt.Position
+			if !pos.IsValid() {
+				pos = r.Position
+			}
+			return newError(pos, "can't apply resource type %v: %v", r.Type.Name, err)
+		}
+	}
+	return nil
+}
+
+// inherit from a resource type
+func (r *Resource) inheritResourceType(rt *ResourceType) error {
 	// initialize dicts
 	dicts := initResourceTypeDicts(r, r.Type.Parameters)
 
-	r.Description = substituteParams(r.Description, rt.Description, dicts)
+	var err error
+	r.Description, err = substituteParams(r.Description, rt.Description, dicts)
+	if err != nil {
+		return err
+	}
 
 	// uri parameters
-	if len(r.URIParameters) == 0 {
-		r.URIParameters = map[string]NamedParameter{}
-	}
-	for name, up := range rt.URIParameters {
-		p, ok := r.URIParameters[name]
-		if !ok {
-			p = NamedParameter{}
-		}
-		p.inherit(up, dicts)
-		r.URIParameters[name] = p
+	r.URIParameters, err = inheritNamedParameters(r.URIParameters, rt.URIParameters, dicts)
+	if err != nil {
+		return err
 	}
 
 	// methods
-	r.inheritMethods(rt)
-
-	return nil
+	return r.inheritMethods(rt, dicts)
 }
 
 // inherit methods inherits all methods based on it's resource type
-func (r *Resource) inheritMethods(rt *ResourceType) {
-	// inherit all methods from resource type
-	// if it doesn't have the methods, we create it
+func (r *Resource) inheritMethods(rt *ResourceType, dicts map[string]interface{}) error {
+	// inherit all methods from resource type,
+	// the methods are already created by setMethods
 	for _, rtm := range rt.methods {
-		m := r.MethodByName(rtm.Name)
-		if m == nil {
-			m = newMethod(rtm.Name)
-			r.assignMethod(m, m.Name)
+		if err := r.MethodByName(rtm.Name).inheritFromResourceType(rtm, dicts); err != nil {
+			return err
 		}
-		m.inheritFromResourceType(r, rtm, rt)
 	}
 
 	// inherit optional methods if only the resource also has the method
@@ -143,9 +174,11 @@ func (r *Resource) inheritMethods(rt *ResourceType) {
 		if m == nil {
 			continue
 		}
-		m.inheritFromResourceType(r, rtm, rt)
+		if err := m.inheritFromResourceType(rtm, dicts); err != nil {
+			return err
+		}
 	}
-
+	return nil
 }
 
 // get resource type from which this resource will inherit
@@ -161,32 +194,28 @@ func (r *Resource) getResourceType(resourceTypes map[string]ResourceType) (*Reso
 			return &rt, nil
 		}
 	}
-	return nil, fmt.Errorf("can't find resource type named :%v", r.Type.Name)
+	return nil, newError(r.Position, "can't find resource type named :%v", r.Type.Name)
 }
 
 // set methods set all methods name
-// and add it to Methods slice
-func (r *Resource) setMethods(traitsMap map[string]Trait) {
-	if r.Get != nil {
-		r.Get.postProcess(r, "GET", traitsMap)
-	}
-	if r.Post != nil {
-		r.Post.postProcess(r, "POST", traitsMap)
-	}
-	if r.Put != nil {
-		r.Put.postProcess(r, "PUT", traitsMap)
-	}
-	if r.Patch != nil {
-		r.Patch.postProcess(r, "PATCH", traitsMap)
-	}
-	if r.Head != nil {
-		r.Head.postProcess(r, "HEAD", traitsMap)
+// and add it to Methods slice.
+// Methods of the resource type are implicitly declared in the resource.
+func (r *Resource) setMethods(rt *ResourceType) {
+	for _, name := range methodNames {
+		if m := r.MethodByName(name); m != nil {
+			m.Name = name
+			r.Methods = append(r.Methods, m)
+		}
 	}
-	if r.Delete != nil {
-		r.Delete.postProcess(r, "DELETE", traitsMap)
+	if rt == nil {
+		return
 	}
-	if r.Options != nil {
-		r.Options.postProcess(r, "OPTIONS", traitsMap)
+	for _, rtm := range rt.methods {
+		if r.MethodByName(rtm.Name) == nil {
+			m := newMethod(rtm.Name)
+			r.assignMethod(m, m.Name)
+			r.Methods = append(r.Methods, m)
+		}
 	}
 }
 
@@ -235,14 +264,11 @@ func (r *Resource) assignMethod(m *Method, name string) {
 
 // substituteParams substitute all params inside double chevron to the correct value
 // param value will be obtained from dicts map
-func substituteParams(toReplace, words string, dicts map[string]interface{}) string {
-	// non empty scalar node remain unchanged
-	// except it has double chevron bracket
-	if toReplace != "" && (strings.Index(toReplace, "<<") < 0 && strings.Index(toReplace, ">>") < 0) {
-		return toReplace
-	}
-	if words == "" {
-		return toReplace
+func substituteParams(toReplace, words string, dicts map[string]interface{}) (string, error) {
+	// non empty scalar node remain unchanged,
+	// it's params are substituted by it's own resource type or trait
+	if toReplace != "" || words == "" {
+		return toReplace, nil
 	}
 
 	removeParamBracket := func(param string) string {
@@ -253,45 +279,48 @@ func substituteParams(toReplace, words string, dicts map[string]interface{}) str
 	// search params
 	params := dcRe.FindAllString(words, -1)
 
-	// substitute the params
+	// substitute the params.
+	// unknown params are kept, e.g. the resource path of a resource type
+	// which inherits from other resource type, they are substituted
+	// when the resource type is applied to a resource.
 	for _, p := range params {
-		pVal := getParamValue(removeParamBracket(p), dicts)
-		words = strings.Replace(words, p, pVal, -1)
+		pVal, ok, err := getParamValue(removeParamBracket(p), dicts)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			words = strings.Replace(words, p, pVal, -1)
+		}
 	}
-	return words
+	return words, nil
 }
 
-// get value of a resource type param
-func getParamValue(param string, dicts map[string]interface{}) string {
-	// split between inflector and real param
-	// real param and inflector is seperated by `|`
-	cleanParam, inflector := func() (string, string) {
-		arr := strings.Split(param, "|")
-		if len(arr) != 2 {
-			return param, ""
-		}
-		return strings.TrimSpace(arr[0]), strings.TrimSpace(arr[1])
-	}()
-
-	// get the value
-	val := func() string {
-		// get from type parameters
-		val, ok := dicts[cleanParam]
-		if !ok {
-			log.Fatalf("getParamValue unknown param:%v, dicts=%v", cleanParam, dicts)
-		}
-		return fmt.Sprintf("%v", val)
-	}()
+// get value of a resource type param,
+// returns false if the param is unknown
+func getParamValue(param string, dicts map[string]interface{}) (string, bool, error) {
+	// split between real param and inflectors,
+	// they are seperated by `|` and applied from left to right,
+	// e.g. `resourcePathName | !singularize | !uppercamelcase`
+	arr := strings.Split(param, "|")
+	cleanParam := strings.TrimSpace(arr[0])
+
+	// get from type parameters
+	rawVal, ok := dicts[cleanParam]
+	if !ok {
+		return "", false, nil
+	}
+	val := fmt.Sprintf("%v", rawVal)
 
 	// inflect the value if needed
-	if inflector != "" {
+	for _, inflector := range arr[1:] {
+		inflector = strings.TrimSpace(inflector)
 		var ok bool
 		val, ok = doInflect(val, inflector)
 		if !ok {
-			log.Fatalf("invalid inflector " + inflector)
+			return "", false, fmt.Errorf("invalid inflector %v of param %v", inflector, cleanParam)
 		}
 	}
-	return val
+	return val, true, nil
 }
 
 // CleanURI returns URI without `/`, `\`', `{`, and `}`
@@ -303,6 +332,19 @@ func (r *Resource) CleanURI() string {
 	return strings.TrimSpace(s)
 }
 
+// PathName returns the rightmost of the non URI parameter containing
+// path fragments of the full URI of this resource,
+// e.g. `users` for `/users/{userId}`
+func (r *Resource) PathName() string {
+	fragments := strings.Split(r.FullURI(), "/")
+	for i := len(fragments) - 1; i >= 0; i-- {
+		if f := fragments[i]; f != "" && !strings.Contains(f, "{") {
+			return f
+		}
+	}
+	return ""
+}
+
 // FullURI returns full/absolute URI of this resource
 func (r *Resource) FullURI() string {
 	return doFullURI(r, "")
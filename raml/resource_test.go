@@ -105,3 +105,146 @@ func TestResourceTypeInheritance(t *testing.T) {
 		})
 	})
 }
+
+func TestResourceTypeChain(t *testing.T) {
+	Convey("resource type inheritance chain", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/resource_type_chain.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		Convey("resource types are flattened", func() {
+			rt := apiDef.ResourceTypes["searchableCollection"]
+			So(rt.Get, ShouldNotBeNil)
+			So(rt.Get.QueryParameters, ShouldContainKey, "q")
+			So(rt.Get.QueryParameters, ShouldContainKey, "limit")
+			So(rt.Post, ShouldNotBeNil)
+			So(rt.OptionalDelete, ShouldNotBeNil)
+		})
+
+		Convey("methods and params from all levels", func() {
+			r := apiDef.Resources["/authors"]
+			So(r.Description, ShouldEqual, "collection of authors")
+			So(r.Methods, ShouldHaveLength, 2)
+
+			So(r.Get, ShouldNotBeNil)
+			So(r.Get.Description, ShouldEqual, "list all authors")
+			So(r.Get.QueryParameters["q"].Description, ShouldEqual, "search author by name")
+			So(r.Get.Responses["500"].Description, ShouldEqual, "internal error of authors")
+
+			So(r.Post, ShouldNotBeNil)
+			So(r.Post.Description, ShouldEqual, "create a author")
+
+			// optional method of the base resource type
+			So(r.Delete, ShouldBeNil)
+		})
+
+		Convey("optional method of a library resource type", func() {
+			r := apiDef.Resources["/books"]
+			So(r.Delete, ShouldNotBeNil)
+			So(r.Delete.Description, ShouldEqual, "delete a book")
+			So(r.Delete.Responses, ShouldContainKey, HTTPCode("204"))
+		})
+
+		Convey("traits precedence", func() {
+			r := apiDef.Resources["/books"]
+			So(r.Get.Description, ShouldEqual, "search the books")
+
+			// method trait of resource type takes precedence over resource type trait
+			So(r.Get.QueryParameters["page"].Description, ShouldEqual, "page of books")
+			So(r.Get.QueryParameters, ShouldContainKey, "limit")
+			So(r.Post.QueryParameters["page"].Description, ShouldEqual, "limited page")
+
+			// trait of the library resource type
+			So(r.Get.Headers["X-Trace-Id"].Description, ShouldEqual, "trace id of the get request")
+
+			// method trait takes precedence over resource trait
+			So(r.Get.Headers["Authorization"].Description, ShouldEqual, "authorized by method")
+			So(r.Post.Headers["Authorization"].Description, ShouldEqual, "authorized by resource")
+		})
+	})
+
+	Convey("cyclic resource type inheritance", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/resource_type_cycle.raml", apiDef)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "cyclic resource type inheritance")
+	})
+}
+
+func TestParameterFunctions(t *testing.T) {
+	Convey("resource type and trait parameters", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/parameter_functions.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		users := apiDef.Resources["/users"]
+		member := users.Nested["/{userId}"]
+		So(member, ShouldNotBeNil)
+
+		Convey("resourcePathName is the rightmost fragment without URI parameter", func() {
+			So(member.PathName(), ShouldEqual, "users")
+			So(member.Get.Description, ShouldEqual, "get user of /users/{userId}")
+			So(member.Put.Description, ShouldEqual, "put the USER")
+		})
+
+		Convey("methodName and chained functions in names", func() {
+			So(member.Get.Headers, ShouldContainKey, HTTPHeader("X-Get-Trace"))
+			So(member.Get.Headers["X-Get-Trace"].Description, ShouldEqual, "trace of the GET request")
+			So(member.Put.Headers, ShouldContainKey, HTTPHeader("X-Users-Version"))
+			So(member.Get.Responses["200"].Bodies.ApplicationJSON.Type, ShouldEqual, "User")
+		})
+
+		Convey("bodies and examples", func() {
+			So(member.Get.Responses["200"].Bodies.ApplicationJSON.Example, ShouldResemble,
+				map[interface{}]interface{}{"name": "a user"})
+
+			props := member.Put.Bodies.ApplicationJSON.Properties
+			So(props, ShouldContainKey, "userName")
+			So(ToProperty("userName", props["userName"]).Example, ShouldEqual, "users")
+
+			// the value of a param keeps it's type
+			version := member.Put.Headers["X-Users-Version"]
+			So(version.Example, ShouldEqual, 2)
+			So(version.Default, ShouldEqual, 1)
+		})
+
+		Convey("query string type", func() {
+			props := users.Get.QueryStringProperties(apiDef.Types)
+			So(props, ShouldContainKey, "user_id")
+			So(props, ShouldContainKey, "LAST_NAME")
+			So(props["LAST_NAME"].(Property).Example, ShouldEqual, "last-name")
+			So(props, ShouldContainKey, "start")
+		})
+
+		Convey("the resource type and trait are not modified", func() {
+			So(apiDef.ResourceTypes["member"].Get.Description, ShouldEqual,
+				"get <<resourcePathName | !singularize>> of <<resourcePath>>")
+			So(apiDef.Traits["filtered"].QueryString.Properties, ShouldContainKey,
+				"<<field | !upperunderscorecase>>?")
+		})
+	})
+
+	Convey("invalid parameter function", t, func() {
+		apiDef := new(APIDefinition)
+
+		Convey("in a resource type", func() {
+			err := ParseFile("./samples/bad_parameter_function.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(ramlErr.Errors[0].Position, ShouldResemble,
+				Position{File: "./samples/bad_parameter_function.raml", Line: 5, Column: 5})
+			So(err.Error(), ShouldContainSubstring, "invalid inflector !singularise")
+		})
+
+		Convey("in a trait", func() {
+			err := ParseFile("./samples/bad_trait_parameter_function.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(ramlErr.Errors[0].Position, ShouldResemble,
+				Position{File: "./samples/bad_trait_parameter_function.raml", Line: 9, Column: 5})
+			So(err.Error(), ShouldContainSubstring, "invalid inflector !capitalize")
+		})
+	})
+}
@@ -24,6 +24,8 @@ var (
 // specify a description and methods and their properties. Resources that use
 // a resource type inherit its properties, such as its methods.
 type ResourceType struct {
+	// position of the resource type in the RAML file
+	Position `yaml:"-"`
 
 	// TODO: Parameters MUST be indicated in resource type and trait definitions
 	// by double angle brackets (double chevrons) enclosing the parameter name;
@@ -62,6 +64,9 @@ type ResourceType struct {
 	// Individual methods can override this declaration.
 	Is []DefinitionChoice `yaml:"is"`
 
+	// The resource type which this resource type inherits.
+	Type *DefinitionChoice `yaml:"type"`
+
 	// In a RESTful API, methods are operations that are performed on a
 	// resource. A method MUST be one of the HTTP methods defined in the
 	// HTTP version 1.1 specification [RFC2616] and its extension,
@@ -97,103 +102,227 @@ type ResourceType struct {
 
 	methods         []*Method // all non-nil methods
 	optionalMethods []*Method // all non-nil optional methods
+
+	traits    map[string]Trait // traits that could be applied to this resource type
+	inherited bool             // true if it already inherits from the parent resource type
 }
 
+// methodNames are names of all methods of a resource or resource type,
+// in the order they are processed.
+var methodNames = []string{"GET", "POST", "PUT", "PATCH", "HEAD", "DELETE", "OPTIONS"}
+
 // postProcess doing post processing of a resource type after being constructed
 // by the .raml parser, some of the works:
 // - assign all properties that can't be obtained from RAML document
-// - inherit from other resource type
 // - apply traits
-func (rt *ResourceType) postProcess(name string, traitsMap map[string]Trait) {
+// Inheritance from other resource type is done by inheritResourceTypes,
+// after all resource types of the document are post processed.
+func (rt *ResourceType) postProcess(name string, traitsMap map[string]Trait) error {
 	rt.Name = name
-	rt.setMethods(traitsMap)
-	rt.setOptionalMethods()
+	rt.traits = traitsMap
+	rt.setMethods()
 
-	// TODO : inherit from other resource type
+	for _, m := range rt.methods {
+		if err := rt.applyTraits(m); err != nil {
+			return err
+		}
+	}
+	for _, m := range rt.optionalMethods {
+		if err := rt.applyTraits(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	// TODO : apply traits
+// applyTraits applies traits of a method and traits of the resource type to the method.
+// The method traits take precedence over the resource type traits.
+// The resource path params are unknown here, they are substituted
+// when the method is inherited by a resource.
+func (rt *ResourceType) applyTraits(m *Method) error {
+	is := append(append([]DefinitionChoice{}, m.Is...), rt.Is...)
+	return m.inheritFromTraits(nil, is, rt.traits)
 }
 
-// set methods set all methods name
-// and add it to methods slice
-func (rt *ResourceType) setMethods(traitsMap map[string]Trait) {
-	if rt.Get != nil {
-		rt.Get.Name = "GET"
-		rt.Get.inheritFromTraits(nil, append(rt.Is, rt.Get.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Get)
-	}
-	if rt.Post != nil {
-		rt.Post.Name = "POST"
-		rt.Post.inheritFromTraits(nil, append(rt.Is, rt.Post.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Post)
+// set methods set name of all methods and optional methods
+// and add it to methods and optionalMethods slice
+func (rt *ResourceType) setMethods() {
+	rt.methods, rt.optionalMethods = nil, nil
+	for _, name := range methodNames {
+		if m := *rt.methodField(name, false); m != nil {
+			m.Name = name
+			rt.methods = append(rt.methods, m)
+		}
+		if m := *rt.methodField(name, true); m != nil {
+			m.Name = name
+			rt.optionalMethods = append(rt.optionalMethods, m)
+		}
 	}
-	if rt.Put != nil {
-		rt.Put.Name = "PUT"
-		rt.Put.inheritFromTraits(nil, append(rt.Is, rt.Put.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Put)
-	}
-	if rt.Patch != nil {
-		rt.Patch.Name = "PATCH"
-		rt.Patch.inheritFromTraits(nil, append(rt.Is, rt.Patch.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Patch)
-	}
-	if rt.Head != nil {
-		rt.Head.Name = "HEAD"
-		rt.Head.inheritFromTraits(nil, append(rt.Is, rt.Head.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Head)
+}
+
+// methodField returns the field of a method by it's name,
+// the name must be one of methodNames
+func (rt *ResourceType) methodField(name string, optional bool) **Method {
+	switch name {
+	case "GET":
+		if optional {
+			return &rt.OptionalGet
+		}
+		return &rt.Get
+	case "POST":
+		if optional {
+			return &rt.OptionalPost
+		}
+		return &rt.Post
+	case "PUT":
+		if optional {
+			return &rt.OptionalPut
+		}
+		return &rt.Put
+	case "PATCH":
+		if optional {
+			return &rt.OptionalPatch
+		}
+		return &rt.Patch
+	case "HEAD":
+		if optional {
+			return &rt.OptionalHead
+		}
+		return &rt.Head
+	case "DELETE":
+		if optional {
+			return &rt.OptionalDelete
+		}
+		return &rt.Delete
+	case "OPTIONS":
+		if optional {
+			return &rt.OptionalOptions
+		}
+		return &rt.Options
+	default:
+		// the name is always one of methodNames
+		panic("invalid method name: " + name)
 	}
-	if rt.Delete != nil {
-		rt.Delete.Name = "DELETE"
-		rt.Delete.inheritFromTraits(nil, append(rt.Is, rt.Delete.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Delete)
+}
+
+// inherit inherits from the parent resource type.
+// Properties of this resource type take precedence over the parent properties.
+// dicts is the parameters given to the parent resource type.
+func (rt *ResourceType) inherit(parent *ResourceType, dicts map[string]interface{}) error {
+	// substitution errors are in the parent declaration
+	pos := parent.Position
+	if !pos.IsValid() {
+		pos = rt.Position
 	}
-	if rt.Options != nil {
-		rt.Options.Name = "OPTIONS"
-		rt.Options.inheritFromTraits(nil, append(rt.Is, rt.Options.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Options)
+	substErr := func(err error) error {
+		return newError(pos, "can't apply resource type %v: %v", parent.Name, err)
 	}
-}
 
-// setOptionalMethods set name of all optional methods
-// and add it to optionalMethods slice
-func (rt *ResourceType) setOptionalMethods() {
-	if rt.OptionalGet != nil {
-		rt.OptionalGet.Name = "GET"
-		rt.optionalMethods = append(rt.optionalMethods, rt.OptionalGet)
+	var err error
+	if rt.Description, err = substituteParams(rt.Description, parent.Description, dicts); err != nil {
+		return substErr(err)
 	}
-	if rt.OptionalPost != nil {
-		rt.OptionalPost.Name = "POST"
-		rt.optionalMethods = append(rt.optionalMethods, rt.OptionalPost)
+	if rt.URIParameters, err = inheritNamedParameters(rt.URIParameters, parent.URIParameters, dicts); err != nil {
+		return substErr(err)
 	}
-	if rt.OptionalPut != nil {
-		rt.OptionalPut.Name = "PUT"
-		rt.optionalMethods = append(rt.optionalMethods, rt.OptionalPut)
+	if rt.BaseURIParameters, err = inheritNamedParameters(rt.BaseURIParameters, parent.BaseURIParameters, dicts); err != nil {
+		return substErr(err)
 	}
-	if rt.OptionalPatch != nil {
-		rt.OptionalPatch.Name = "PATCH"
-		rt.optionalMethods = append(rt.optionalMethods, rt.OptionalPatch)
+
+	for _, pm := range parent.methods {
+		field := rt.methodField(pm.Name, false)
+		if *field == nil {
+			*field = newMethod(pm.Name)
+
+			// traits of this resource type also apply to the inherited method
+			if err := rt.applyTraits(*field); err != nil {
+				return err
+			}
+		}
+		if err := (*field).inheritFromResourceType(pm, dicts); err != nil {
+			return substErr(err)
+		}
 	}
-	if rt.OptionalHead != nil {
-		rt.OptionalHead.Name = "HEAD"
-		rt.optionalMethods = append(rt.optionalMethods, rt.OptionalHead)
+
+	// optional method of the parent is applied to the method
+	// if it exists in this resource type, otherwise it stays optional
+	for _, pm := range parent.optionalMethods {
+		m := *rt.methodField(pm.Name, false)
+		if m == nil {
+			field := rt.methodField(pm.Name, true)
+			if *field == nil {
+				*field = newMethod(pm.Name)
+			}
+			m = *field
+		}
+		if err := m.inheritFromResourceType(pm, dicts); err != nil {
+			return substErr(err)
+		}
 	}
-	if rt.OptionalDelete != nil {
-		rt.OptionalDelete.Name = "DELETE"
-		rt.optionalMethods = append(rt.optionalMethods, rt.OptionalDelete)
+
+	rt.setMethods()
+	return nil
+}
+
+// inheritResourceTypes applies the inheritance between resource types,
+// a resource type inherits from it's parent resource type, which could
+// inherit from another resource type.
+// Resource types from libraries must have been inherited in their own library.
+func inheritResourceTypes(resourceTypes map[string]ResourceType) error {
+	inProgress := map[string]bool{}
+
+	var inherit func(name string) error
+	inherit = func(name string) error {
+		rt := resourceTypes[name]
+		if rt.inherited || rt.Type == nil || rt.Type.Name == "" {
+			return nil
+		}
+		if inProgress[name] {
+			return newError(rt.Position, "cyclic resource type inheritance of %v", name)
+		}
+		inProgress[name] = true
+
+		parentName := rt.Type.Name
+		if _, ok := resourceTypes[parentName]; !ok {
+			return newError(rt.Position, "can't find resource type named :%v", parentName)
+		}
+		if err := inherit(parentName); err != nil {
+			return err
+		}
+		parent := resourceTypes[parentName]
+
+		if err := rt.inherit(&parent, copyDicts(rt.Type.Parameters)); err != nil {
+			return err
+		}
+		rt.inherited = true
+		resourceTypes[name] = rt
+		return nil
 	}
-	if rt.OptionalOptions != nil {
-		rt.OptionalOptions.Name = "OPTIONS"
-		rt.optionalMethods = append(rt.optionalMethods, rt.OptionalOptions)
+
+	for name := range resourceTypes {
+		if err := inherit(name); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
+// initResourceTypeDicts returns copy of the resource type parameters,
+// with the reserved parameters of the resource
 func initResourceTypeDicts(r *Resource, dicts map[string]interface{}) map[string]interface{} {
-	if len(dicts) == 0 {
-		dicts = map[string]interface{}{}
-	}
+	dicts = copyDicts(dicts)
 	if r != nil {
-		dicts["resourcePathName"] = r.CleanURI()
+		dicts["resourcePathName"] = r.PathName()
 		dicts["resourcePath"] = r.FullURI()
 	}
 	return dicts
 }
+
+// copyDicts returns copy of the resource type or trait parameters
+func copyDicts(dicts map[string]interface{}) map[string]interface{} {
+	cp := make(map[string]interface{}, len(dicts))
+	for k, v := range dicts {
+		cp[k] = v
+	}
+	return cp
+}
@@ -5,3 +5,15 @@ package raml
 type Root interface {
 	PostProcess(string) error
 }
+
+// libraryUser is a RAML document which could use libraries,
+// the libraries are parsed by the parser before the document
+// is post processed.
+type libraryUser interface {
+	// libraryFiles returns the library files used by the document,
+	// keyed by the library name
+	libraryFiles() map[string]string
+
+	// setLibraries sets the parsed libraries
+	setLibraries(map[string]*Library)
+}
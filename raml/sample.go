@@ -0,0 +1,400 @@
+package raml
+
+// This file contains the sample values of the types,
+// e.g. the bodies of the responses of a mock server.
+// A sample value is the example of a type, or a value synthesized
+// from the type and it's facets when there is no example.
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/gigforks/yaml"
+)
+
+// sample values of the date and time types
+var sampleTimes = map[string]string{
+	"date-only":     "2017-01-01",
+	"time-only":     "12:00:00",
+	"datetime-only": "2017-01-01T12:00:00",
+	"datetime":      "2017-01-01T12:00:00Z",
+}
+
+// SampleBodyValue returns a sample value of a body of the given type and properties.
+// The value is the example of the body, the first of it's named examples, the example of it's type,
+// or a value synthesized from the type and it's facets.
+// A string example of a non string body, e.g. a JSON document, is decoded.
+// The objects of the value are map[string]interface{}, so it can be encoded in JSON.
+func (apiDef *APIDefinition) SampleBodyValue(typ string, properties map[string]interface{}, example interface{}, examples map[string]interface{}) interface{} {
+	s := apiScope(apiDef)
+	if val, ok := firstExample(example, examples); ok {
+		if str, isString := val.(string); isString && s.exprKind(typ, 0) != "string" {
+			val = decodeDocument(str)
+		}
+		return jsonValue(val)
+	}
+	if len(properties) == 0 {
+		return s.sampleExprValue(typ, 0)
+	}
+
+	// inline object type, which could inherit from the type
+	obj, ok := s.sampleExprValue(typ, 0).(map[string]interface{})
+	if !ok {
+		obj = map[string]interface{}{}
+	}
+	for name, val := range s.samplePropertiesValue(properties, 0) {
+		obj[name] = val
+	}
+	return obj
+}
+
+// SampleParameterValue returns a sample value of a named parameter,
+// e.g. a header of a response
+func (apiDef *APIDefinition) SampleParameterValue(np NamedParameter) interface{} {
+	return apiScope(apiDef).samplePropertyValue(np.ToProperty(), 0)
+}
+
+// firstExample returns the value of the example,
+// or of the first of the named examples sorted by name
+func firstExample(example interface{}, examples map[string]interface{}) (interface{}, bool) {
+	if example != nil {
+		val, _ := exampleValue(example)
+		return val, true
+	}
+	names := sortedKeys(examples)
+	if len(names) == 0 {
+		return nil, false
+	}
+	val, _ := exampleValue(examples[names[0]])
+	return val, true
+}
+
+// decodeDocument decodes a JSON or YAML document,
+// the document is returned as is if it can't be decoded
+func decodeDocument(doc string) interface{} {
+	var val interface{}
+	if err := json.Unmarshal([]byte(doc), &val); err == nil {
+		return val
+	}
+	if err := yaml.Unmarshal([]byte(doc), &val); err == nil && val != nil {
+		return val
+	}
+	return doc
+}
+
+// jsonValue converts the YAML mappings of a value to maps with string keys,
+// which can be encoded in JSON
+func jsonValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case map[interface{}]interface{}, map[string]interface{}:
+		m := toStringMap(v)
+		obj := make(map[string]interface{}, len(m))
+		for k, elem := range m {
+			obj[k] = jsonValue(elem)
+		}
+		return obj
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for i, elem := range v {
+			arr[i] = jsonValue(elem)
+		}
+		return arr
+	}
+	return val
+}
+
+// sampleExprValue returns a sample value of a type expression
+func (s scope) sampleExprValue(expr string, depth int) interface{} {
+	if !isTypeExpr(expr) {
+		return nil
+	}
+	te, err := ParseTypeExpr(expr)
+	if err != nil {
+		return nil
+	}
+	return s.sampleTypeExprValue(te, depth)
+}
+
+// sampleTypeExprValue returns a sample value of a parsed type expression
+func (s scope) sampleTypeExprValue(te *TypeExpr, depth int) interface{} {
+	if depth >= maxTypeDepth {
+		return nil
+	}
+
+	switch te.Kind {
+	case TypeExprUnion:
+		return s.sampleTypeExprValue(te.Members[0], depth)
+	case TypeExprArray:
+		return []interface{}{s.sampleTypeExprValue(te.Items, depth)}
+	case TypeExprMap:
+		return map[string]interface{}{"key": s.sampleTypeExprValue(te.Items, depth)}
+	case TypeExprInheritance:
+		obj := map[string]interface{}{}
+		for _, m := range te.Members {
+			for name, val := range toStringMap(s.sampleTypeExprValue(m, depth)) {
+				obj[name] = val
+			}
+		}
+		return obj
+	case TypeExprInline:
+		return s.sampleTypeValue(*te.Decl, depth+1)
+	}
+
+	if builtinTypes[te.Name] {
+		return sampleBuiltinValue(te.Name, "")
+	}
+	t, ts, ok := s.findType(te.Name)
+	if !ok {
+		return nil
+	}
+	return ts.sampleTypeValue(t, depth+1)
+}
+
+// sampleTypeValue returns a sample value of a type declaration
+func (s scope) sampleTypeValue(t Type, depth int) interface{} {
+	if depth >= maxTypeDepth {
+		return nil
+	}
+	if val, ok := firstExample(t.Example, t.Examples); ok {
+		return jsonValue(val)
+	}
+	if t.Default != nil {
+		return jsonValue(t.Default)
+	}
+	if enum, ok := t.Enum.([]interface{}); ok && len(enum) > 0 {
+		return jsonValue(enum[0])
+	}
+
+	// sample value of the parent type, which is checked against the facets of the type
+	var parent interface{}
+	if t.Type != nil {
+		if te, err := NewTypeExpr(t.Type); err == nil {
+			parent = s.sampleTypeExprValue(te, depth)
+		}
+	}
+
+	kind := s.typeKind(t, depth)
+	switch kind {
+	case "object":
+		return s.samplePropertiesValue(s.allProperties(t, depth), depth)
+	case "array":
+		var item interface{}
+		if items, err := NewTypeExpr(t.Items); t.Items != nil && err == nil {
+			item = s.sampleTypeExprValue(items, depth)
+		} else if arr, ok := parent.([]interface{}); ok && len(arr) > 0 {
+			item = arr[0]
+		}
+		return sampleArray(item, intPtr(t.MinItems))
+	case "string", "number", "integer":
+		if parent != nil && checkScalarFacets(parent, stringPtr(t.Pattern), intPtr(t.MinLength), intPtr(t.MaxLength),
+			t.Minimum, t.Maximum, t.MultipleOf) == nil {
+			return parent
+		}
+		return sampleScalar(kind, stringPtr(t.Pattern), intPtr(t.MinLength), intPtr(t.MaxLength),
+			t.Minimum, t.Maximum, t.MultipleOf)
+	case "datetime":
+		return sampleBuiltinValue(kind, t.Format)
+	}
+	if t.Type != nil {
+		return parent
+	}
+	return sampleBuiltinValue(kind, t.Format)
+}
+
+// samplePropertiesValue returns a sample object of the given properties,
+// the pattern properties are omitted
+func (s scope) samplePropertiesValue(properties map[string]interface{}, depth int) map[string]interface{} {
+	obj := make(map[string]interface{}, len(properties))
+	for _, name := range sortedKeys(properties) {
+		prop := ToProperty(name, properties[name])
+		if prop.IsPattern() {
+			continue
+		}
+		obj[prop.Name] = s.samplePropertyValue(prop, depth+1)
+	}
+	return obj
+}
+
+// samplePropertyValue returns a sample value of a property declaration
+func (s scope) samplePropertyValue(prop Property, depth int) interface{} {
+	if val, ok := firstExample(prop.Example, prop.Examples); ok {
+		return jsonValue(val)
+	}
+	if prop.Default != nil {
+		return jsonValue(prop.Default)
+	}
+	if enum, ok := prop.Enum.([]interface{}); ok && len(enum) > 0 {
+		return jsonValue(enum[0])
+	}
+	if len(prop.Properties) > 0 { // inline object type
+		return s.samplePropertiesValue(prop.Properties, depth)
+	}
+
+	val := s.sampleExprValue(prop.Type, depth)
+	switch kind := s.exprKind(prop.Type, depth); kind {
+	case "string", "number", "integer":
+		if val == nil || checkScalarFacets(val, prop.Pattern, prop.MinLength, prop.MaxLength,
+			prop.Minimum, prop.Maximum, prop.MultipleOf) != nil {
+			val = sampleScalar(kind, prop.Pattern, prop.MinLength, prop.MaxLength, prop.Minimum, prop.Maximum, prop.MultipleOf)
+		}
+	case "array":
+		if arr, ok := val.([]interface{}); ok && len(arr) > 0 {
+			val = sampleArray(arr[0], prop.MinItems)
+		}
+	}
+	return val
+}
+
+// sampleBuiltinValue returns a sample value of a built-in type,
+// format is the format of a datetime
+func sampleBuiltinValue(typeName, format string) interface{} {
+	switch typeName {
+	case "object":
+		return map[string]interface{}{}
+	case "array":
+		return []interface{}{}
+	case "string", "any", "file":
+		return "string"
+	case "number", "integer":
+		return 1
+	case "boolean":
+		return true
+	case "datetime":
+		if strings.ToLower(format) == "rfc2616" {
+			return "Sun, 01 Jan 2017 12:00:00 GMT"
+		}
+	}
+	if t, ok := sampleTimes[typeName]; ok {
+		return t
+	}
+	return nil
+}
+
+// sampleArray returns an array of the item, which has at least minItems items
+func sampleArray(item interface{}, minItems *int) []interface{} {
+	n := 1
+	if minItems != nil && *minItems > n {
+		n = *minItems
+	}
+	arr := make([]interface{}, n)
+	for i := range arr {
+		arr[i] = item
+	}
+	return arr
+}
+
+// sampleScalar returns a sample string, number or integer which satisfies the given facets
+func sampleScalar(kind string, pattern *string, minLength, maxLength *int, minimum, maximum, multipleOf *float64) interface{} {
+	if kind == "string" {
+		return sampleString(pattern, minLength, maxLength)
+	}
+
+	num := 1.0
+	if minimum != nil && num < *minimum {
+		num = *minimum
+	}
+	if maximum != nil && num > *maximum {
+		num = *maximum
+	}
+	if multipleOf != nil && *multipleOf > 0 {
+		num = math.Ceil(num / *multipleOf) * *multipleOf
+		if maximum != nil && num > *maximum {
+			num -= *multipleOf
+		}
+	}
+	if kind == "integer" {
+		num = math.Ceil(num)
+		if maximum != nil && num > *maximum {
+			num = math.Floor(*maximum)
+		}
+		return int(num)
+	}
+	return num
+}
+
+// sampleString returns a sample string which matches the pattern and has the given length
+func sampleString(pattern *string, minLength, maxLength *int) string {
+	str := "string"
+	if pattern != nil {
+		if match, ok := sampleMatch(*pattern); ok {
+			str = match
+		}
+	}
+	if length := len([]rune(str)); minLength != nil && length < *minLength {
+		str += strings.Repeat("s", *minLength-length)
+	}
+	if maxLength != nil && len([]rune(str)) > *maxLength {
+		str = string([]rune(str)[:*maxLength])
+	}
+	return str
+}
+
+// sampleMatch returns a string which matches a regular expression,
+// it returns false if the string doesn't match, e.g. because of a lookahead
+func sampleMatch(pattern string) (string, bool) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+	var buf bytes.Buffer
+	writeMatch(&buf, re.Simplify())
+	match, err := regexp.MatchString(pattern, buf.String())
+	return buf.String(), err == nil && match
+}
+
+// writeMatch writes the shortest string matching a parsed regular expression,
+// using the first alternative and a printable character of the character classes
+func writeMatch(buf *bytes.Buffer, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		buf.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		buf.WriteRune(classRune(re.Rune))
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		buf.WriteRune('a')
+	case syntax.OpCapture, syntax.OpPlus:
+		writeMatch(buf, re.Sub[0])
+	case syntax.OpRepeat:
+		for i := 0; i < re.Min; i++ {
+			writeMatch(buf, re.Sub[0])
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			writeMatch(buf, sub)
+		}
+	case syntax.OpAlternate:
+		writeMatch(buf, re.Sub[0])
+	}
+}
+
+// classRune returns a rune of a character class, given as pairs of ranges,
+// a letter or a digit is preferred to a control character
+func classRune(ranges []rune) rune {
+	for _, r := range "a0A" {
+		for i := 0; i+1 < len(ranges); i += 2 {
+			if ranges[i] <= r && r <= ranges[i+1] {
+				return r
+			}
+		}
+	}
+	for i := 0; i+1 < len(ranges); i += 2 {
+		if ranges[i+1] >= ' ' {
+			return maxRune(ranges[i], ' ')
+		}
+	}
+	if len(ranges) > 0 {
+		return ranges[0]
+	}
+	return 'a'
+}
+
+func maxRune(a, b rune) rune {
+	if a > b {
+		return a
+	}
+	return b
+}
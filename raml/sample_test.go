@@ -0,0 +1,71 @@
+package raml
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSample(t *testing.T) {
+	Convey("sample values", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/sample/api.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		checkBody := func(typ string, val interface{}) error {
+			data, err := json.Marshal(val)
+			So(err, ShouldBeNil)
+			return apiDef.CheckJSONBody(typ, nil, data)
+		}
+
+		Convey("synthesized object satisfies the facets", func() {
+			val := apiDef.SampleBodyValue("Pet", nil, nil, nil)
+			So(checkBody("Pet", val), ShouldBeNil)
+
+			pet := val.(map[string]interface{})
+			So(pet["level"], ShouldEqual, 3)
+			So(pet["born"], ShouldEqual, "2017-01-01")
+			So(pet["tags"], ShouldHaveLength, 2)
+		})
+
+		Convey("recursive types and arrays", func() {
+			val := apiDef.SampleBodyValue("Owner[]", nil, nil, nil)
+			So(checkBody("Owner[]", val), ShouldBeNil)
+		})
+
+		Convey("enum and pattern", func() {
+			So(apiDef.SampleBodyValue("Color", nil, nil, nil), ShouldEqual, "red")
+
+			code := apiDef.SampleBodyValue("Code", nil, nil, nil)
+			So(checkBody("Code", code), ShouldBeNil)
+		})
+
+		Convey("examples", func() {
+			// example of the type
+			So(apiDef.SampleBodyValue("Shape", nil, nil, nil), ShouldResemble, map[string]interface{}{"kind": "square"})
+
+			// the first named example
+			examples := map[string]interface{}{
+				"b": map[interface{}]interface{}{"kind": "circle"},
+				"a": map[interface{}]interface{}{"kind": "triangle"},
+			}
+			So(apiDef.SampleBodyValue("Shape", nil, nil, examples), ShouldResemble, map[string]interface{}{"kind": "triangle"})
+
+			// a JSON document example
+			So(apiDef.SampleBodyValue("Shape", nil, `{"kind": "oval"}`, nil), ShouldResemble, map[string]interface{}{"kind": "oval"})
+		})
+
+		Convey("inline properties", func() {
+			properties := map[string]interface{}{
+				"count": map[interface{}]interface{}{"type": "integer", "maximum": -2},
+			}
+			So(apiDef.SampleBodyValue("", properties, nil, nil), ShouldResemble, map[string]interface{}{"count": -2})
+		})
+
+		Convey("invalid JSON body", func() {
+			So(checkBody("Pet", map[string]interface{}{"name": "rex"}), ShouldNotBeNil)
+			So(apiDef.CheckJSONBody("Pet", nil, []byte("{")), ShouldNotBeNil)
+		})
+	})
+}
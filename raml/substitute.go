@@ -0,0 +1,133 @@
+package raml
+
+import (
+	"reflect"
+	"strings"
+)
+
+// substituteValue returns a deep copy of a value of a resource type or trait,
+// with the params substituted in all of it's strings and map keys,
+// e.g. in the properties, examples and type declarations.
+// The value of the resource type or trait is not modified.
+func substituteValue(v interface{}, dicts map[string]interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if s, ok := v.(string); ok {
+		if val, ok := rawParamValue(s, dicts); ok {
+			return val, nil
+		}
+	}
+	nv, err := substituteReflectValue(reflect.ValueOf(v), dicts)
+	if err != nil {
+		return nil, err
+	}
+	return nv.Interface(), nil
+}
+
+// substituteProperties returns a copy of the properties with the params substituted
+func substituteProperties(props map[string]interface{}, dicts map[string]interface{}) (map[string]interface{}, error) {
+	if props == nil {
+		return nil, nil
+	}
+	v, err := substituteValue(props, dicts)
+	if err != nil {
+		return nil, err
+	}
+	return v.(map[string]interface{}), nil
+}
+
+// rawParamValue returns the value of a param as given to the resource type or trait,
+// if the string is only this param without function,
+// e.g. the integer `2` of `<<version>>` if version is `2`.
+func rawParamValue(s string, dicts map[string]interface{}) (interface{}, bool) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "<<") || !strings.HasSuffix(s, ">>") || strings.Count(s, "<<") != 1 {
+		return nil, false
+	}
+	val, ok := dicts[strings.TrimSpace(s[2:len(s)-2])]
+	if !ok || val == nil {
+		return nil, false
+	}
+	return val, true
+}
+
+func substituteReflectValue(v reflect.Value, dicts map[string]interface{}) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.String:
+		s, err := substituteParams("", v.String(), dicts)
+		if err != nil {
+			return v, err
+		}
+		nv := reflect.New(v.Type()).Elem()
+		nv.SetString(s)
+		return nv, nil
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v, nil
+		}
+		elem, err := substituteReflectValue(v.Elem(), dicts)
+		if err != nil {
+			return v, err
+		}
+		nv := reflect.New(v.Type().Elem())
+		nv.Elem().Set(elem)
+		return nv, nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, nil
+		}
+		elem, err := substituteValue(v.Elem().Interface(), dicts)
+		if err != nil {
+			return v, err
+		}
+		nv := reflect.New(v.Type()).Elem()
+		nv.Set(reflect.ValueOf(elem))
+		return nv, nil
+	case reflect.Struct:
+		// unexported fields are copied as is
+		nv := reflect.New(v.Type()).Elem()
+		nv.Set(v)
+		for i := 0; i < nv.NumField(); i++ {
+			if f := nv.Field(i); f.CanSet() {
+				fv, err := substituteReflectValue(v.Field(i), dicts)
+				if err != nil {
+					return v, err
+				}
+				f.Set(fv)
+			}
+		}
+		return nv, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return v, nil
+		}
+		nv := reflect.MakeMap(v.Type())
+		for _, k := range v.MapKeys() {
+			nk, err := substituteReflectValue(k, dicts)
+			if err != nil {
+				return v, err
+			}
+			elem, err := substituteReflectValue(v.MapIndex(k), dicts)
+			if err != nil {
+				return v, err
+			}
+			nv.SetMapIndex(nk, elem)
+		}
+		return nv, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return v, nil
+		}
+		nv := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, err := substituteReflectValue(v.Index(i), dicts)
+			if err != nil {
+				return v, err
+			}
+			nv.Index(i).Set(elem)
+		}
+		return nv, nil
+	}
+	return v, nil
+}
@@ -30,6 +30,9 @@ type Trait struct {
 	// As in Method.
 	QueryParameters map[string]NamedParameter `yaml:"queryParameters"`
 
+	// As in Method.
+	QueryString *Type `yaml:"queryString"`
+
 	// As in Method.
 	Protocols []string `yaml:"protocols"`
 
@@ -0,0 +1,333 @@
+package raml
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gigforks/yaml"
+)
+
+// TypeExprKind is the kind of a type expression
+type TypeExprKind int
+
+const (
+	// TypeExprName is a reference to a built-in or user defined type,
+	// e.g. `string`, `Person` or `lib.Person`
+	TypeExprName TypeExprKind = iota
+
+	// TypeExprArray is an array of the Items type, e.g. `Person[]`
+	TypeExprArray
+
+	// TypeExprMap is a map of string to the Items type, e.g. `Person{}`.
+	// It is a go-raml extension, not part of the RAML specification.
+	TypeExprMap
+
+	// TypeExprUnion is a union of the Members types, e.g. `Cat | Dog`
+	TypeExprUnion
+
+	// TypeExprInheritance is a multiple inheritance of the Members types,
+	// e.g. `type: [ Person, Employee ]`
+	TypeExprInheritance
+
+	// TypeExprInline is an inline type declaration under `type`
+	TypeExprInline
+)
+
+// TypeExpr is a parsed RAML type expression
+// see http://docs.raml.org/specs/1.0/#raml-10-spec-type-expressions
+type TypeExpr struct {
+	Kind TypeExprKind
+
+	// Name of the type, only for TypeExprName
+	Name string
+
+	// Type of the items, only for TypeExprArray and TypeExprMap
+	Items *TypeExpr
+
+	// Member types of TypeExprUnion and TypeExprInheritance
+	Members []*TypeExpr
+
+	// The declaration of TypeExprInline
+	Decl *Type
+}
+
+// ParseTypeExpr parses a type expression string, e.g. `(Cat | Dog)[]`
+func ParseTypeExpr(expr string) (*TypeExpr, error) {
+	p := typeExprParser{expr: expr}
+	te, err := p.parseUnion()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.expr) {
+		return nil, p.errorf("unexpected `%c`", p.expr[p.pos])
+	}
+	return te, nil
+}
+
+// NewTypeExpr creates type expression from the value of a `type` node, which could be:
+// - a type expression string
+// - array of type expressions, for multiple inheritance
+// - an inline type declaration
+func NewTypeExpr(v interface{}) (*TypeExpr, error) {
+	switch val := v.(type) {
+	case string:
+		return ParseTypeExpr(val)
+	case []interface{}:
+		if len(val) == 1 {
+			return NewTypeExpr(val[0])
+		}
+		te := &TypeExpr{Kind: TypeExprInheritance}
+		for _, parent := range val {
+			member, err := NewTypeExpr(parent)
+			if err != nil {
+				return nil, err
+			}
+			te.Members = append(te.Members, member)
+		}
+		return te, nil
+	case map[interface{}]interface{}, map[string]interface{}:
+		b, err := yaml.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		var decl Type
+		if err := yaml.Unmarshal(b, &decl); err != nil {
+			return nil, err
+		}
+		return &TypeExpr{Kind: TypeExprInline, Decl: &decl}, nil
+	case Type:
+		return &TypeExpr{Kind: TypeExprInline, Decl: &val}, nil
+	case *Type:
+		return &TypeExpr{Kind: TypeExprInline, Decl: val}, nil
+	default:
+		return nil, fmt.Errorf("invalid type expression:%v", v)
+	}
+}
+
+// TypeExpr returns the type expression of this type.
+// If the type is not declared, the default type is returned:
+// - `object` if it has properties
+// - array of the items if it has items
+// - `string` otherwise
+func (t Type) TypeExpr() (*TypeExpr, error) {
+	if s, ok := t.Type.(string); t.Type == nil || (ok && strings.TrimSpace(s) == "") {
+		switch {
+		case len(t.Properties) > 0:
+			return &TypeExpr{Name: "object"}, nil
+		case t.Items != nil:
+			return t.arrayExpr()
+		default:
+			return &TypeExpr{Name: "string"}, nil
+		}
+	}
+
+	te, err := NewTypeExpr(t.Type)
+	if err != nil {
+		return nil, err
+	}
+	if te.Kind == TypeExprName && te.Name == "array" && t.Items != nil {
+		return t.arrayExpr()
+	}
+	return te, nil
+}
+
+// arrayExpr returns array type expression of the `items` facet
+func (t Type) arrayExpr() (*TypeExpr, error) {
+	items, err := NewTypeExpr(t.Items)
+	if err != nil {
+		return nil, err
+	}
+	return &TypeExpr{Kind: TypeExprArray, Items: items}, nil
+}
+
+// IsBuiltin returns true if it is a name of RAML built-in type
+func (te *TypeExpr) IsBuiltin() bool {
+	return te.Kind == TypeExprName && builtinTypes[te.Name]
+}
+
+// Names returns names of all types referenced by the expression,
+// without duplicates and in order of appearance.
+// Inline type declarations are not traversed.
+func (te *TypeExpr) Names() []string {
+	var names []string
+	seen := map[string]bool{}
+
+	var walk func(*TypeExpr)
+	walk = func(e *TypeExpr) {
+		switch e.Kind {
+		case TypeExprName:
+			if !seen[e.Name] {
+				seen[e.Name] = true
+				names = append(names, e.Name)
+			}
+		case TypeExprArray, TypeExprMap:
+			walk(e.Items)
+		case TypeExprUnion, TypeExprInheritance:
+			for _, m := range e.Members {
+				walk(m)
+			}
+		}
+	}
+	walk(te)
+	return names
+}
+
+// rename renames the referenced types using the given function,
+// it returns true if any type is renamed.
+// Inline type declarations are not traversed.
+func (te *TypeExpr) rename(f func(string) string) bool {
+	switch te.Kind {
+	case TypeExprName:
+		name := f(te.Name)
+		renamed := name != te.Name
+		te.Name = name
+		return renamed
+	case TypeExprArray, TypeExprMap:
+		return te.Items.rename(f)
+	case TypeExprUnion, TypeExprInheritance:
+		renamed := false
+		for _, m := range te.Members {
+			if m.rename(f) {
+				renamed = true
+			}
+		}
+		return renamed
+	}
+	return false
+}
+
+// String returns the type expression in RAML syntax
+func (te *TypeExpr) String() string {
+	switch te.Kind {
+	case TypeExprArray:
+		return te.Items.operand() + "[]"
+	case TypeExprMap:
+		return te.Items.operand() + "{}"
+	case TypeExprUnion:
+		members := make([]string, 0, len(te.Members))
+		for _, m := range te.Members {
+			members = append(members, m.String())
+		}
+		return strings.Join(members, " | ")
+	case TypeExprInheritance:
+		members := make([]string, 0, len(te.Members))
+		for _, m := range te.Members {
+			members = append(members, m.String())
+		}
+		return "[ " + strings.Join(members, ", ") + " ]"
+	case TypeExprInline:
+		return "object"
+	default:
+		return te.Name
+	}
+}
+
+// operand returns the expression string as the operand of `[]` or `{}`
+func (te *TypeExpr) operand() string {
+	if te.Kind == TypeExprUnion {
+		return "(" + te.String() + ")"
+	}
+	return te.String()
+}
+
+// typeExprParser is a recursive descent parser of type expression.
+// The grammar:
+//
+//	union   = postfix { "|" postfix }
+//	postfix = primary { "[]" | "{}" }
+//	primary = "(" union ")" | name
+type typeExprParser struct {
+	expr string
+	pos  int
+}
+
+func (p *typeExprParser) parseUnion() (*TypeExpr, error) {
+	te, err := p.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+	members := []*TypeExpr{te}
+	for p.consume('|') {
+		member, err := p.parsePostfix()
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	if len(members) == 1 {
+		return te, nil
+	}
+	return &TypeExpr{Kind: TypeExprUnion, Members: members}, nil
+}
+
+func (p *typeExprParser) parsePostfix() (*TypeExpr, error) {
+	te, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.consume('['):
+			if !p.consume(']') {
+				return nil, p.errorf("expected `]`")
+			}
+			te = &TypeExpr{Kind: TypeExprArray, Items: te}
+		case p.consume('{'):
+			if !p.consume('}') {
+				return nil, p.errorf("expected `}`")
+			}
+			te = &TypeExpr{Kind: TypeExprMap, Items: te}
+		default:
+			return te, nil
+		}
+	}
+}
+
+func (p *typeExprParser) parsePrimary() (*TypeExpr, error) {
+	if p.consume('(') {
+		te, err := p.parseUnion()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(')') {
+			return nil, p.errorf("expected `)`")
+		}
+		return te, nil
+	}
+
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.expr) && !strings.ContainsRune("|()[]{} \t\r\n", rune(p.expr[p.pos])) {
+		p.pos++
+	}
+	if start == p.pos {
+		if p.pos == len(p.expr) {
+			return nil, p.errorf("expected type name")
+		}
+		return nil, p.errorf("unexpected `%c`", p.expr[p.pos])
+	}
+	return &TypeExpr{Kind: TypeExprName, Name: p.expr[start:p.pos]}, nil
+}
+
+// consume skips the spaces and the given character,
+// it returns false if the next character is not the given one.
+func (p *typeExprParser) consume(c byte) bool {
+	p.skipSpaces()
+	if p.pos < len(p.expr) && p.expr[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *typeExprParser) skipSpaces() {
+	for p.pos < len(p.expr) && strings.ContainsRune(" \t\r\n", rune(p.expr[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *typeExprParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid type expression `%v`: %v at offset %v",
+		p.expr, fmt.Sprintf(format, args...), p.pos)
+}
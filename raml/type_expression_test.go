@@ -0,0 +1,85 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTypeExpression(t *testing.T) {
+	Convey("type expression", t, func() {
+		Convey("parse", func() {
+			checks := []struct {
+				expr  string
+				str   string
+				names []string
+			}{
+				{"string", "string", []string{"string"}},
+				{"lib.Person", "lib.Person", []string{"lib.Person"}},
+				{"Person[]", "Person[]", []string{"Person"}},
+				{"string[][][]", "string[][][]", []string{"string"}},
+				{"Cat|Dog", "Cat | Dog", []string{"Cat", "Dog"}},
+				{" ( Cat | Dog ) [] ", "(Cat | Dog)[]", []string{"Cat", "Dog"}},
+				{"((Cat | Dog)[] | nil)[][]", "((Cat | Dog)[] | nil)[][]", []string{"Cat", "Dog", "nil"}},
+				{"Cat[] | Cat", "Cat[] | Cat", []string{"Cat"}},
+				{"string{}", "string{}", []string{"string"}},
+			}
+			for _, check := range checks {
+				te, err := ParseTypeExpr(check.expr)
+				So(err, ShouldBeNil)
+				So(te.String(), ShouldEqual, check.str)
+				So(te.Names(), ShouldResemble, check.names)
+			}
+		})
+
+		Convey("tree", func() {
+			te, err := ParseTypeExpr("(Cat | Dog)[]")
+			So(err, ShouldBeNil)
+			So(te.Kind, ShouldEqual, TypeExprArray)
+			So(te.Items.Kind, ShouldEqual, TypeExprUnion)
+			So(te.Items.Members, ShouldHaveLength, 2)
+			So(te.Items.Members[1].Name, ShouldEqual, "Dog")
+		})
+
+		Convey("invalid", func() {
+			for _, expr := range []string{"", "Cat |", "(Cat | Dog", "Cat[", "Cat)", "Cat Dog", "|Cat"} {
+				_, err := ParseTypeExpr(expr)
+				So(err, ShouldNotBeNil)
+			}
+		})
+
+		Convey("type declaration", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/type_expressions.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			te, err := apiDef.Types["Manager"].TypeExpr()
+			So(err, ShouldBeNil)
+			So(te.Kind, ShouldEqual, TypeExprInheritance)
+			So(te.Names(), ShouldResemble, []string{"Person", "Employee"})
+			So(apiDef.Types["Manager"].IsMultipleInheritance(), ShouldBeTrue)
+
+			te, err = apiDef.Types["Pets"].TypeExpr()
+			So(err, ShouldBeNil)
+			So(te.String(), ShouldEqual, "(Cat | Dog)[]")
+			So(apiDef.Types["Pets"].IsArray(), ShouldBeTrue)
+			So(apiDef.Types["Pets"].IsUnion(), ShouldBeFalse)
+
+			te, err = apiDef.Types["Names"].TypeExpr()
+			So(err, ShouldBeNil)
+			So(te.String(), ShouldEqual, "string[]")
+
+			te, err = apiDef.Types["Employee"].TypeExpr()
+			So(err, ShouldBeNil)
+			So(te.Kind, ShouldEqual, TypeExprInline)
+			So(te.Decl.Type, ShouldEqual, "Person")
+			So(te.Decl.Properties, ShouldContainKey, "salary")
+
+			te, err = apiDef.Types["Person"].TypeExpr()
+			So(err, ShouldBeNil)
+			So(te.String(), ShouldEqual, "object")
+
+			So(Validate(apiDef), ShouldBeEmpty)
+		})
+	})
+}
@@ -32,10 +32,9 @@ package raml
 
 // This file contains all of the RAML types.
 
-import "strings"
-
-// TODO: We don't support !include of non-text files. RAML supports including
-//       of many file types.
+import (
+	"strings"
+)
 
 // Any type, for our convenience
 type Any interface{}
@@ -123,9 +122,23 @@ type Property struct {
 	MaxItems    *int
 	UniqueItems bool
 
+	// properties of an inline object type
+	Properties map[string]interface{}
+
 	// Capnp extension
 	CapnpFieldNumber int
 	CapnpType        string
+
+	// XML serialization of the property
+	XML *XML
+
+	// examples and default value
+	Example  interface{}
+	Examples map[string]interface{}
+	Default  interface{}
+
+	// Annotations to be applied to this property.
+	Annotations Annotations
 }
 
 // ToProperty creates a property from an interface
@@ -182,12 +195,23 @@ func ToProperty(name string, p interface{}) Property {
 				*p.MaxItems = v.(int)
 			case "uniqueItems":
 				p.UniqueItems = v.(bool)
+			case "properties":
+				p.Properties = toStringMap(v)
 			case "capnpFieldNumber":
 				p.CapnpFieldNumber = v.(int)
 			case "capnpType":
 				p.CapnpType = v.(string)
+			case "xml":
+				p.XML = newXML(v)
+			case "example":
+				p.Example = v
+			case "examples":
+				p.Examples = toStringMap(v)
+			case "default":
+				p.Default = v
 			}
 		}
+		p.Annotations = newAnnotations(val)
 		return p
 	}
 
@@ -221,8 +245,27 @@ func (p Property) IsEnum() bool {
 	return p.Enum != nil
 }
 
+// IsPattern returns true if a property is a pattern property, e.g. `/^x-/`.
+// It applies to all the properties whose name matches the regular expression.
+func (p Property) IsPattern() bool {
+	return len(p.Name) >= 2 && strings.HasPrefix(p.Name, "/") && strings.HasSuffix(p.Name, "/")
+}
+
+// NamePattern returns the regular expression of a pattern property
+func (p Property) NamePattern() string {
+	if !p.IsPattern() {
+		return ""
+	}
+	return p.Name[1 : len(p.Name)-1]
+}
+
 // Type defines an RAML data type
 type Type struct {
+	// position of the type declaration in the RAML file
+	Position `yaml:"-" json:"-"`
+	// positions of the examples, which are reported by the validator
+	examplePositions `yaml:"-" json:"-"`
+
 	// A default value for a type
 	Default interface{} `yaml:"default"`
 
@@ -264,9 +307,21 @@ type Type struct {
 	// Its value is a string and MAY be formatted using markdown.
 	Description string `yaml:"description" json:"description"`
 
-	// TODO : annotation names
+	// Annotations to be applied to this type.
+	Annotations Annotations `yaml:",regexp:^\\(.*\\)$" json:"-"`
+
+	// User defined facets declared by this type, they could be set by the types inheriting from it.
+	// The key is the facet name, the name of an optional facet ends with `?`.
+	// The value is the type declaration of the facet value.
+	Facets map[string]interface{} `yaml:"facets" json:"-"`
 
-	// TODO : facets
+	// Values of the user defined facets declared by the parent types.
+	// It must be declared after Annotations, otherwise the annotations
+	// will be parsed as facet values.
+	FacetValues map[string]interface{} `yaml:",regexp:^[^(].*$" json:"-"`
+
+	// XML serialization of the type instances
+	XML *XML `yaml:"xml" json:"-"`
 
 	// The properties that instances of this type may or must have.
 	// we use `interface{}` as property type to support syntactic sugar & shortcut
@@ -281,8 +336,8 @@ type Type struct {
 	MaxProperties int `yaml:"maxProperties" json:"maxProperties"`
 
 	// A Boolean that indicates if an object instance has additional properties.
-	// TODO: Default : true
-	AdditionalProperties string `yaml:"additionalProperties" json:"additionalProperties"`
+	// nil means the default value, which is true. See AllowsAdditionalProperties.
+	AdditionalProperties *bool `yaml:"additionalProperties" json:"additionalProperties"`
 
 	// Determines the concrete type of an individual object at runtime when,
 	// for example, payloads contain ambiguous types due to unions or inheritance.
@@ -330,10 +385,10 @@ type Type struct {
 
 	// ----------- facets for Number -------------------------- //
 	// The minimum value of the parameter. Applicable only to parameters of type number or integer.
-	Minimum int `yaml:"minimum" json:"minimum"`
+	Minimum *float64 `yaml:"minimum" json:"minimum"`
 
 	// The maximum value of the parameter. Applicable only to parameters of type number or integer.
-	Maximum int `yaml:"maximum" json:"maximum"`
+	Maximum *float64 `yaml:"maximum" json:"maximum"`
 
 	// The format of the value. The value MUST be one of the following:
 	// int32, int64, int, long, float, double, int16, int8
@@ -341,17 +396,62 @@ type Type struct {
 
 	// A numeric instance is valid against "multipleOf"
 	// if the result of dividing the instance by this keyword's value is an integer.
-	MultipleOf int `yaml:"multipleOf" json:"multipleOf"`
+	MultipleOf *float64 `yaml:"multipleOf" json:"multipleOf"`
 
 	// ---------- facets for file --------------------------------//
 	// A list of valid content-type strings for the file. The file type */* MUST be a valid value.
 	FileTypes string `yaml:"fileTypes" json:"fileTypes"`
 }
 
+// UnmarshalYAML unmarshals a type declaration which might be:
+// - a type expression, e.g. `string` or `Person[]`
+// - an included JSON schema, which is kept as is in the Type field, see JSONSchema
+// - an included XML schema, which is kept in the Schema field
+// - a map of the type facets
+func (t *Type) UnmarshalYAML(unmarshaler func(interface{}) error) error {
+	type rawType Type // to avoid recursion
+
+	var expr string
+	if err := unmarshaler(&expr); err == nil {
+		if strings.HasPrefix(strings.TrimSpace(expr), "<") {
+			t.Schema = expr
+			return nil
+		}
+		t.Type = expr
+		return nil
+	}
+
+	var rt rawType
+	if err := unmarshaler(&rt); err != nil {
+		return err
+	}
+	*t = Type(rt)
+	return nil
+}
+
+// AllowsAdditionalProperties returns false if the `additionalProperties` facet is false,
+// the instances of the type can't have other properties than the declared ones.
+func (t Type) AllowsAdditionalProperties() bool {
+	return t.AdditionalProperties == nil || *t.AdditionalProperties
+}
+
+// PatternProperties returns the pattern properties of this type, e.g. `/^x-/`
+// see http://docs.raml.org/specs/1.0/#raml-10-spec-property-declarations
+func (t Type) PatternProperties() []Property {
+	var props []Property
+	for _, name := range sortedKeys(t.Properties) {
+		if prop := ToProperty(name, t.Properties[name]); prop.IsPattern() {
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
 // IsArray checks if this type is an Array
 // see specs at http://docs.raml.org/specs/1.0/#raml-10-spec-array-types
 func (t Type) IsArray() bool {
-	return strings.HasSuffix(t.Type.(string), "[]")
+	te, err := t.TypeExpr()
+	return err == nil && te.Kind == TypeExprArray
 }
 
 // IsEnum type check if this type is an enum
@@ -363,13 +463,33 @@ func (t Type) IsEnum() bool {
 // IsUnion checks if a type is Union type
 // see http://docs.raml.org/specs/1.0/#raml-10-spec-union-types
 func (t Type) IsUnion() bool {
-	return strings.Index(t.Type.(string), "|") > 0
+	te, err := t.TypeExpr()
+	return err == nil && te.Kind == TypeExprUnion
+}
+
+// IsMultipleInheritance checks if a type inherits from multiple types
+// see http://docs.raml.org/specs/1.0/#raml-10-spec-multiple-inheritance
+func (t Type) IsMultipleInheritance() bool {
+	te, err := t.TypeExpr()
+	return err == nil && te.Kind == TypeExprInheritance
 }
 
 // BodiesProperty defines a Body's property
 type BodiesProperty struct {
+	// positions of the examples, which are reported by the validator
+	examplePositions `yaml:"-" json:"-"`
+
 	// we use `interface{}` as property type to support syntactic sugar & shortcut
 	Properties map[string]interface{} `yaml:"properties"`
 
 	Type string
+
+	// An example of the body, it could also be a JSON string
+	Example interface{} `yaml:"example"`
+
+	// Named examples of the body
+	Examples map[string]interface{} `yaml:"examples"`
+
+	// Annotations to be applied to this body.
+	Annotations Annotations `yaml:",regexp:^\\(.*\\)$"`
 }
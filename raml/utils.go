@@ -1,5 +1,7 @@
 package raml
 
+import "fmt"
+
 // append `str` to `arr` if `str` not exist in `arr`
 func appendStrNotExist(str string, arr []string) []string {
 	// check if a `str` exist in `arr`
@@ -17,3 +19,23 @@ func appendStrNotExist(str string, arr []string) []string {
 	}
 	return arr
 }
+
+// toStringMap converts a YAML mapping to a map with string keys,
+// it returns nil if v is not a mapping
+func toStringMap(v interface{}) map[string]interface{} {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		return m
+	case map[interface{}]interface{}:
+		sm := make(map[string]interface{}, len(m))
+		for k, val := range m {
+			sm[fmt.Sprint(k)] = val
+		}
+		return sm
+	}
+	return nil
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
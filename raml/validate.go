@@ -0,0 +1,1389 @@
+package raml
+
+// This file contains the semantic validation of an API definition.
+// It checks the things that the YAML parser can't check,
+// e.g. references to undefined types, traits, or security schemes.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gigforks/yaml"
+)
+
+// Severity is the severity of a Diagnostic
+type Severity int
+
+const (
+	// SeverityError is a problem that makes the API definition invalid
+	SeverityError Severity = iota
+
+	// SeverityWarning is a problem that doesn't make the API definition invalid,
+	// but is likely a mistake
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// Diagnostic is a problem found by Validate
+type Diagnostic struct {
+	Position
+	Severity Severity
+	Message  string
+}
+
+func (d Diagnostic) String() string {
+	msg := d.Severity.String() + ": " + d.Message
+	if pos := d.Position.String(); pos != "" {
+		return pos + ": " + msg
+	}
+	return msg
+}
+
+// HasErrors returns true if one of the diagnostics is an error
+func HasErrors(diags []Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// the RAML built-in types
+	builtinTypes = map[string]bool{
+		"any":           true,
+		"object":        true,
+		"array":         true,
+		"string":        true,
+		"number":        true,
+		"integer":       true,
+		"boolean":       true,
+		"date-only":     true,
+		"time-only":     true,
+		"datetime-only": true,
+		"datetime":      true,
+		"file":          true,
+		"nil":           true,
+	}
+
+	// the built-in types to which the facets are applicable
+	stringFacetKinds = []string{"string"}
+	lengthFacetKinds = []string{"string", "file"}
+	numberFacetKinds = []string{"number", "integer"}
+	formatFacetKinds = []string{"number", "integer", "datetime"}
+	arrayFacetKinds  = []string{"array"}
+	objectFacetKinds = []string{"object"}
+	fileFacetKinds   = []string{"file"}
+	scalarFacetKinds = []string{"string", "number", "integer", "boolean",
+		"date-only", "time-only", "datetime-only", "datetime", "file", "nil"}
+
+	// the facets of the built-in types, which can't be declared as user defined facets
+	builtinFacets = map[string]bool{
+		"type": true, "schema": true, "default": true, "example": true, "examples": true,
+		"displayName": true, "description": true, "facets": true, "xml": true, "enum": true,
+		"properties": true, "minProperties": true, "maxProperties": true, "additionalProperties": true,
+		"discriminator": true, "discriminatorValue": true,
+		"items": true, "minItems": true, "maxItems": true, "uniqueItems": true,
+		"pattern": true, "minLength": true, "maxLength": true,
+		"minimum": true, "maximum": true, "format": true, "multipleOf": true, "fileTypes": true,
+	}
+
+	// URI parameters in a URI template, e.g. `{userId}`
+	uriParamsRegex = regexp.MustCompile(`{([^}]+)}`)
+)
+
+// max depth of type inheritance followed by the validator,
+// to stop on recursive types
+const maxTypeDepth = 32
+
+// Validate checks the semantic of a parsed API definition:
+// - type, trait, resource type, and security scheme references resolve
+// - library prefix of the references resolve
+// - URI parameters are declared for every `{param}` in the URI
+// - facets are applicable to the base type
+// - example and default values match their type
+// It returns all the problems found, sorted by position.
+func Validate(apiDef *APIDefinition) []Diagnostic {
+	v := validator{libraries: map[*Library]bool{}}
+	v.validateAPIDefinition(apiDef)
+	sort.Sort(diagnostics(v.diags))
+	return v.diags
+}
+
+// ValidateExamples only checks that the example, examples, and default values
+// of the types, properties, named parameters, and bodies match their type.
+// It is used by the code generators, which don't require a fully valid API definition.
+// It returns all the problems found, sorted by position.
+func ValidateExamples(apiDef *APIDefinition) []Diagnostic {
+	v := validator{libraries: map[*Library]bool{}, examplesOnly: true}
+	v.validateAPIDefinition(apiDef)
+	sort.Sort(diagnostics(v.diags))
+	return v.diags
+}
+
+// scope is the declarations visible in a document,
+// which is an API definition or a library
+type scope struct {
+	types           map[string]Type
+	schemas         []map[string]string
+	traits          map[string]Trait
+	resourceTypes   map[string]ResourceType
+	securitySchemes map[string]SecurityScheme
+	libraries       map[string]*Library
+	pos             Position
+
+	// scopes of the libraries from which the node inherits
+	// resource types or traits. The declarations inherited from a library
+	// refer to the library declarations.
+	inherited []scope
+}
+
+func libraryScope(l *Library) scope {
+	return scope{
+		types:           l.Types,
+		traits:          l.Traits,
+		resourceTypes:   l.ResourceTypes,
+		securitySchemes: l.SecuritySchemes,
+		libraries:       l.Libraries,
+		pos:             Position{File: l.Filename},
+	}
+}
+
+// resolve finds the scope where a reference is declared.
+// A reference prefixed with a library name, e.g. `lib.Name`,
+// is declared in the library.
+func (s scope) resolve(ref string) (scope, string, error) {
+	idx := strings.Index(ref, ".")
+	if idx < 0 {
+		return s, ref, nil
+	}
+	libName := ref[:idx]
+	l, ok := s.libraries[libName]
+	if !ok {
+		return s, ref, fmt.Errorf("unknown library `%v` in `%v`", libName, ref)
+	}
+	return libraryScope(l), ref[idx+1:], nil
+}
+
+// lookup finds the scope where a reference is declared,
+// using declared to check the declaration in a scope.
+// kind is the kind of the declaration, used in the error message.
+func (s scope) lookup(kind, ref string, declared func(scope, string) bool) (scope, string, error) {
+	ds, name, err := s.resolve(ref)
+	if err == nil && declared(ds, name) {
+		return ds, name, nil
+	}
+	for _, is := range s.inherited {
+		if ids, iname, ierr := is.lookup(kind, ref, declared); ierr == nil {
+			return ids, iname, nil
+		}
+	}
+	if err == nil {
+		err = fmt.Errorf("undefined %v `%v`", kind, ref)
+	}
+	return ds, name, err
+}
+
+// inheriting returns the scope of a node that inherits from
+// the given resource types or traits.
+func (s scope) inheriting(refs ...DefinitionChoice) scope {
+	for _, ref := range refs {
+		idx := strings.Index(ref.Name, ".")
+		if idx < 0 {
+			continue
+		}
+		if l, ok := s.libraries[ref.Name[:idx]]; ok {
+			s.inherited = append(append([]scope{}, s.inherited...), libraryScope(l))
+		}
+	}
+	return s
+}
+
+// findType finds a user defined type and the scope where it is declared
+func (s scope) findType(ref string) (Type, scope, bool) {
+	ts, name, err := s.lookup("type", ref, func(ds scope, n string) bool {
+		_, ok := ds.types[n]
+		return ok
+	})
+	if err != nil {
+		return Type{}, ts, false
+	}
+	return ts.types[name], ts, true
+}
+
+// hasType returns true if a name is declared as a type or a schema
+func (s scope) hasType(name string) bool {
+	if _, ok := s.types[name]; ok {
+		return true
+	}
+	for _, schemas := range s.schemas {
+		if _, ok := schemas[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+type validator struct {
+	diags        []Diagnostic
+	libraries    map[*Library]bool // validated libraries
+	examplesOnly bool              // only report the invalid examples
+}
+
+func (v *validator) errorf(pos Position, format string, args ...interface{}) {
+	if v.examplesOnly {
+		return
+	}
+	v.diags = append(v.diags, Diagnostic{Position: pos, Severity: SeverityError, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) warnf(pos Position, format string, args ...interface{}) {
+	if v.examplesOnly {
+		return
+	}
+	v.diags = append(v.diags, Diagnostic{Position: pos, Severity: SeverityWarning, Message: fmt.Sprintf(format, args...)})
+}
+
+// exampleErrorf reports an invalid example
+func (v *validator) exampleErrorf(pos Position, format string, args ...interface{}) {
+	v.diags = append(v.diags, Diagnostic{Position: pos, Severity: SeverityError, Message: fmt.Sprintf(format, args...)})
+}
+
+func apiScope(apiDef *APIDefinition) scope {
+	return scope{
+		types:           apiDef.Types,
+		schemas:         apiDef.Schemas,
+		traits:          apiDef.Traits,
+		resourceTypes:   apiDef.ResourceTypes,
+		securitySchemes: apiDef.SecuritySchemes,
+		libraries:       apiDef.Libraries,
+		pos:             Position{File: apiDef.Filename},
+	}
+}
+
+func (v *validator) validateAPIDefinition(apiDef *APIDefinition) {
+	s := apiScope(apiDef)
+
+	for _, param := range uriParams(apiDef.BaseURI) {
+		if _, ok := apiDef.BaseURIParameters[param]; !ok && param != "version" {
+			v.warnf(s.pos, "base URI parameter `%v` is not declared", param)
+		}
+	}
+	v.validateNamedParameters(s, "base URI parameter", apiDef.BaseURIParameters)
+	v.validateSecuredBy(s, s.pos, apiDef.SecuredBy)
+	v.validateTypes(s)
+	v.validateAnnotationTypes(s, apiDef.AnnotationTypes)
+
+	for _, l := range apiDef.Libraries {
+		v.validateLibrary(l)
+	}
+
+	for uri := range apiDef.Resources {
+		r := apiDef.Resources[uri]
+		v.validateResource(s, &r)
+	}
+}
+
+func (v *validator) validateLibrary(l *Library) {
+	if v.libraries[l] {
+		return
+	}
+	v.libraries[l] = true
+
+	s := libraryScope(l)
+	v.validateTypes(s)
+	v.validateAnnotationTypes(s, l.AnnotationTypes)
+	for _, nested := range l.Libraries {
+		v.validateLibrary(nested)
+	}
+}
+
+// validateTypes validates all types declared in a scope
+func (v *validator) validateTypes(s scope) {
+	for name, t := range s.types {
+		pos := t.Position
+		if !pos.IsValid() {
+			pos = s.pos
+		}
+		v.validateType(s, pos, name, t)
+	}
+}
+
+// validateType validates a type declaration
+func (v *validator) validateType(s scope, pos Position, name string, t Type) {
+	if schema, ok := t.JSONSchema(); ok {
+		v.validateJSONSchema(pos, name, schema)
+		return
+	}
+	if schema, ok := t.XMLSchema(); ok {
+		v.validateXMLSchema(pos, schema)
+		return
+	}
+	switch base := t.Type.(type) {
+	case string:
+		v.validateTypeExpr(s, pos, base)
+	case []interface{}: // multiple inheritance
+		for _, parent := range base {
+			if expr, ok := parent.(string); ok {
+				v.validateTypeExpr(s, pos, expr)
+			}
+		}
+	}
+	if items, ok := t.Items.(string); ok {
+		v.validateTypeExpr(s, pos, items)
+	}
+	v.validateProperties(s, pos, t.Properties, t.examplePositions)
+
+	kind := s.typeKind(t, 0)
+	v.validateFacets(pos, "type `"+name+"`", kind, []facet{
+		{"pattern", t.Pattern != "", stringFacetKinds},
+		{"minLength", t.MinLength != 0, lengthFacetKinds},
+		{"maxLength", t.MaxLength != 0, lengthFacetKinds},
+		{"minimum", t.Minimum != nil, numberFacetKinds},
+		{"maximum", t.Maximum != nil, numberFacetKinds},
+		{"multipleOf", t.MultipleOf != nil, numberFacetKinds},
+		{"format", t.Format != "", formatFacetKinds},
+		{"items", t.Items != nil, arrayFacetKinds},
+		{"minItems", t.MinItems != 0, arrayFacetKinds},
+		{"maxItems", t.MaxItems != 0, arrayFacetKinds},
+		{"uniqueItems", t.UniqueItems, arrayFacetKinds},
+		{"properties", len(t.Properties) > 0, objectFacetKinds},
+		{"minProperties", t.MinProperties != 0, objectFacetKinds},
+		{"maxProperties", t.MaxProperties != 0, objectFacetKinds},
+		{"additionalProperties", t.AdditionalProperties != nil, objectFacetKinds},
+		{"discriminator", t.Discriminator != "", objectFacetKinds},
+		{"discriminatorValue", t.DiscriminatorValue != "", objectFacetKinds},
+		{"fileTypes", t.FileTypes != "", fileFacetKinds},
+	})
+
+	v.validateUserFacets(s, pos, name, t)
+
+	v.validateExamples(pos, t.examplePositions, "type `"+name+"`", t.Example, t.Examples, t.Default, func(val interface{}) error {
+		return s.checkTypeValue(t, val, 0)
+	})
+}
+
+// validateUserFacets checks the user defined facets declared by a type,
+// and the values of the facets declared by it's parent types
+func (v *validator) validateUserFacets(s scope, pos Position, name string, t Type) {
+	for _, facetName := range sortedKeys(t.Facets) {
+		decl := t.Facets[facetName]
+		facetName = strings.TrimSuffix(facetName, "?")
+		if builtinFacets[facetName] {
+			v.errorf(pos, "facet `%v` of type `%v` overrides a built-in facet", facetName, name)
+		}
+		if expr, ok := decl.(string); ok {
+			v.validateTypeExpr(s, pos, expr)
+		}
+	}
+
+	declared := s.inheritedFacets(t, 0)
+	for _, facetName := range sortedKeys(t.FacetValues) {
+		f, ok := declared[facetName]
+		if !ok {
+			v.errorf(pos, "unknown facet `%v` of type `%v`", facetName, name)
+			continue
+		}
+		if te, err := NewTypeExpr(f.decl); err == nil {
+			if err := s.checkTypeExprValue(te, t.FacetValues[facetName], 0); err != nil {
+				v.errorf(pos, "invalid value of facet `%v` of type `%v`: %v", facetName, name, err)
+			}
+		}
+	}
+	for _, facetName := range sortedKeys(declared) {
+		if declared[facetName].required && !s.hasFacetValue(t, facetName, 0) {
+			v.errorf(pos, "required facet `%v` of type `%v` is not set", facetName, name)
+		}
+	}
+}
+
+// validateProperties validates properties of an object type
+func (v *validator) validateProperties(s scope, pos Position, properties map[string]interface{}, ep examplePositions) {
+	for name, p := range properties {
+		prop := ToProperty(name, p)
+		if prop.IsPattern() {
+			if _, err := regexp.Compile(prop.NamePattern()); err != nil {
+				v.errorf(pos, "invalid pattern property `%v`: %v", prop.Name, err)
+			}
+		}
+		v.validateTypeExpr(s, pos, prop.Type)
+
+		kind := s.exprKind(prop.Type, 0)
+		v.validateFacets(pos, "property `"+prop.Name+"`", kind, []facet{
+			{"pattern", prop.Pattern != nil, stringFacetKinds},
+			{"minLength", prop.MinLength != nil, lengthFacetKinds},
+			{"maxLength", prop.MaxLength != nil, lengthFacetKinds},
+			{"minimum", prop.Minimum != nil, numberFacetKinds},
+			{"maximum", prop.Maximum != nil, numberFacetKinds},
+			{"multipleOf", prop.MultipleOf != nil, numberFacetKinds},
+			{"minItems", prop.MinItems != nil, arrayFacetKinds},
+			{"maxItems", prop.MaxItems != nil, arrayFacetKinds},
+			{"uniqueItems", prop.UniqueItems, arrayFacetKinds},
+			{"xml.attribute", prop.XML != nil && prop.XML.Attribute, scalarFacetKinds},
+			{"xml.wrapped", prop.XML != nil && prop.XML.Wrapped, arrayFacetKinds},
+		})
+
+		v.validateExamples(pos, ep.property(prop.Name), "property `"+prop.Name+"`", prop.Example, prop.Examples, prop.Default, func(val interface{}) error {
+			return s.checkPropertyValue(prop, val, 0)
+		})
+	}
+}
+
+// validateAnnotationTypes checks that the annotation value types are declared
+func (v *validator) validateAnnotationTypes(s scope, annotationTypes map[string]AnnotationType) {
+	for _, at := range annotationTypes {
+		v.validateTypeExpr(s, s.pos, at.TypeName())
+		v.validateProperties(s, s.pos, at.Properties, examplePositions{})
+	}
+}
+
+// validateTypeExpr checks that all types in a type expression are declared
+func (v *validator) validateTypeExpr(s scope, pos Position, expr string) {
+	if !isTypeExpr(expr) {
+		return
+	}
+	te, err := ParseTypeExpr(expr)
+	if err != nil {
+		v.errorf(pos, "%v", err)
+		return
+	}
+	for _, name := range te.Names() {
+		if builtinTypes[name] {
+			continue
+		}
+		if _, _, err := s.lookup("type", name, scope.hasType); err != nil {
+			v.errorf(pos, "%v", err)
+		}
+	}
+}
+
+// validateXMLSchema checks that an XML schema is a well formed XML document
+func (v *validator) validateXMLSchema(pos Position, schema string) {
+	if err := checkXML(schema); err != nil {
+		v.errorf(pos, "%v", err)
+	}
+}
+
+// validateJSONSchema checks that a JSON schema could be converted to RAML types
+func (v *validator) validateJSONSchema(pos Position, name, schema string) {
+	if _, err := JSONSchemaTypes(name, schema); err != nil {
+		v.errorf(pos, "%v", err)
+	}
+}
+
+// validateNamedParameters validates named parameters.
+// kind is the kind of the parameters, e.g. `query parameter`
+func (v *validator) validateNamedParameters(s scope, kind string, params map[string]NamedParameter) {
+	for name, np := range params {
+		pos := np.Position
+		if !pos.IsValid() {
+			pos = s.pos
+		}
+		v.validateNamedParameter(s, pos, kind+" `"+name+"`", np)
+	}
+}
+
+// validateHeaders validates headers, which are named parameters
+func (v *validator) validateHeaders(s scope, headers map[HTTPHeader]Header) {
+	params := make(map[string]NamedParameter, len(headers))
+	for name, h := range headers {
+		params[string(name)] = NamedParameter(h)
+	}
+	v.validateNamedParameters(s, "header", params)
+}
+
+func (v *validator) validateNamedParameter(s scope, pos Position, desc string, np NamedParameter) {
+	// the items of an array are validated as part of the type expression
+	typeName := np.TypeExpr()
+	v.validateTypeExpr(s, pos, typeName)
+
+	kind := s.exprKind(np.Type, 0)
+	if np.Type == "" {
+		kind = "string"
+	}
+	v.validateFacets(pos, desc, kind, []facet{
+		{"pattern", np.Pattern != nil, stringFacetKinds},
+		{"minLength", np.MinLength != nil, lengthFacetKinds},
+		{"maxLength", np.MaxLength != nil, lengthFacetKinds},
+		{"minimum", np.Minimum != nil, numberFacetKinds},
+		{"maximum", np.Maximum != nil, numberFacetKinds},
+		{"multipleOf", np.MultipleOf != nil, numberFacetKinds},
+		{"format", np.Format != "", formatFacetKinds},
+		{"items", np.Items != "", arrayFacetKinds},
+		{"minItems", np.MinItems != nil, arrayFacetKinds},
+		{"maxItems", np.MaxItems != nil, arrayFacetKinds},
+		{"uniqueItems", np.UniqueItems, arrayFacetKinds},
+	})
+
+	for _, val := range np.EnumValues() {
+		if err := s.checkExprValue(typeName, val, 0); err != nil {
+			v.errorf(pos, "invalid enum value of %v: %v", desc, err)
+		}
+	}
+
+	prop := np.ToProperty()
+	v.validateExamples(pos, np.examplePositions, desc, np.Example, np.Examples, np.Default, func(val interface{}) error {
+		return s.checkPropertyValue(prop, val, 0)
+	})
+}
+
+// validateExamples checks the example, the named examples, and the default value
+// of the declaration described by desc, using check to check a value against the type.
+// The examples declared with `strict: false` are not checked. The invalid values are reported
+// at their position in ep, or at pos if it is unknown.
+func (v *validator) validateExamples(pos Position, ep examplePositions, desc string, example interface{}, examples map[string]interface{},
+	def interface{}, check func(interface{}) error) {
+	if example != nil {
+		if val, strict := exampleValue(example); strict {
+			if err := check(val); err != nil {
+				v.exampleErrorf(ep.example(pos), "invalid example of %v: %v", desc, err)
+			}
+		}
+	}
+	for _, name := range sortedKeys(examples) {
+		if val, strict := exampleValue(examples[name]); strict {
+			if err := check(val); err != nil {
+				v.exampleErrorf(ep.namedExample(name, pos), "invalid example `%v` of %v: %v", name, desc, err)
+			}
+		}
+	}
+	if def != nil {
+		if err := check(def); err != nil {
+			v.exampleErrorf(ep.defaultValue(pos), "invalid default value of %v: %v", desc, err)
+		}
+	}
+}
+
+// validateBodyExamples checks the examples of a body,
+// an example of an XML body must be a well formed XML document.
+func (v *validator) validateBodyExamples(s scope, pos Position, ep examplePositions, desc, mediaType, typ string,
+	properties map[string]interface{}, example interface{}, examples map[string]interface{}) {
+	v.validateExamples(pos, ep, desc, example, examples, nil, func(val interface{}) error {
+		if IsXMLMediaType(mediaType) {
+			if str, ok := val.(string); ok {
+				return checkXML(str)
+			}
+			return nil
+		}
+		if IsJSONSchema(typ) {
+			return nil
+		}
+		return s.checkBodyValue(typ, properties, val)
+	})
+}
+
+// facet is a facet of a type declaration.
+type facet struct {
+	name  string
+	set   bool     // true if the facet is declared
+	kinds []string // built-in types to which the facet is applicable
+}
+
+// validateFacets checks that the declared facets are applicable to kind,
+// which is the built-in type the declaration is based on.
+func (v *validator) validateFacets(pos Position, desc, kind string, facets []facet) {
+	if kind == "" || kind == "any" {
+		return
+	}
+	for _, f := range facets {
+		if !f.set {
+			continue
+		}
+		applicable := false
+		for _, k := range f.kinds {
+			if k == kind {
+				applicable = true
+				break
+			}
+		}
+		if !applicable {
+			v.errorf(pos, "facet `%v` of %v is not applicable to %v type", f.name, desc, kind)
+		}
+	}
+}
+
+// validateSecuredBy checks that the security schemes are declared
+func (v *validator) validateSecuredBy(s scope, pos Position, securedBy []DefinitionChoice) {
+	for _, dc := range securedBy {
+		if dc.Name == "" || dc.Name == "null" { // unsecured
+			continue
+		}
+		_, _, err := s.lookup("security scheme", dc.Name, func(ds scope, n string) bool {
+			_, ok := ds.securitySchemes[n]
+			return ok
+		})
+		if err != nil {
+			v.errorf(pos, "%v", err)
+		}
+	}
+}
+
+// validateTraits checks that the applied traits are declared
+func (v *validator) validateTraits(s scope, pos Position, is []DefinitionChoice) {
+	for _, dc := range is {
+		_, _, err := s.lookup("trait", dc.Name, func(ds scope, n string) bool {
+			_, ok := ds.traits[n]
+			return ok
+		})
+		if err != nil {
+			v.errorf(pos, "%v", err)
+		}
+	}
+}
+
+// validateResource validates a resource, it's methods, and it's nested resources
+func (v *validator) validateResource(parent scope, r *Resource) {
+	pos := r.Position
+	if !pos.IsValid() {
+		pos = parent.pos
+	}
+
+	s := parent.inheriting(r.Is...)
+	if r.Type != nil && r.Type.Name != "" {
+		_, _, err := s.lookup("resource type", r.Type.Name, func(ds scope, n string) bool {
+			_, ok := ds.resourceTypes[n]
+			return ok
+		})
+		if err != nil {
+			v.errorf(pos, "%v", err)
+		}
+		s = s.inheriting(*r.Type)
+	}
+	v.validateTraits(s, pos, r.Is)
+	v.validateSecuredBy(s, pos, r.SecuredBy)
+
+	// URI parameters of the relative URI
+	for _, param := range uriParams(r.URI) {
+		if param == "mediaTypeExtension" {
+			continue
+		}
+		if _, ok := r.URIParameters[param]; !ok {
+			v.warnf(pos, "URI parameter `%v` of `%v` is not declared", param, r.URI)
+		}
+	}
+	v.validateNamedParameters(s, "URI parameter", r.URIParameters)
+
+	for _, m := range r.Methods {
+		v.validateMethod(s, pos, m)
+	}
+
+	for _, n := range r.Nested {
+		v.validateResource(parent, n)
+	}
+}
+
+// validateMethod validates a method of a resource in resPos
+func (v *validator) validateMethod(s scope, resPos Position, m *Method) {
+	pos := m.Position
+	if !pos.IsValid() {
+		pos = resPos
+	}
+
+	s = s.inheriting(m.Is...)
+	v.validateTraits(s, pos, m.Is)
+	v.validateSecuredBy(s, pos, m.SecuredBy)
+	v.validateNamedParameters(s, "query parameter", m.QueryParameters)
+	if m.QueryString != nil {
+		if len(m.QueryParameters) > 0 {
+			v.errorf(pos, "queryParameters and queryString are mutually exclusive")
+		}
+		if kind := s.typeKind(*m.QueryString, 0); kind != "" && kind != "object" {
+			v.errorf(pos, "queryString must be an object type, not `%v`", kind)
+		}
+		v.validateType(s, pos, "queryString", *m.QueryString)
+	}
+	v.validateHeaders(s, m.Headers)
+	v.validateBodies(s, pos, "request body", m.Bodies)
+
+	for code, resp := range m.Responses {
+		respPos := resp.Position
+		if !respPos.IsValid() {
+			respPos = pos
+		}
+		v.validateHeaders(s, resp.Headers)
+		v.validateBodies(s, respPos, fmt.Sprintf("response body `%v`", code), resp.Bodies)
+	}
+}
+
+// validateBodies checks the types and the examples of the bodies,
+// desc describes the bodies, e.g. `request body`
+func (v *validator) validateBodies(s scope, pos Position, desc string, b Bodies) {
+	v.validateTypeExpr(s, pos, b.Type)
+	if b.Example != "" {
+		v.validateBodyExamples(s, pos, b.examplePositions, desc, "", b.Type, nil, b.Example, nil)
+	}
+	if b.ApplicationJSON != nil {
+		if IsJSONSchema(b.ApplicationJSON.Type) {
+			v.validateJSONSchema(pos, "body", b.ApplicationJSON.Type)
+		}
+		v.validateTypeExpr(s, pos, b.ApplicationJSON.Type)
+		v.validateProperties(s, pos, b.ApplicationJSON.Properties, b.ApplicationJSON.examplePositions)
+		v.validateBodyExamples(s, pos, b.ApplicationJSON.examplePositions, desc, "application/json", b.ApplicationJSON.Type, b.ApplicationJSON.Properties,
+			b.ApplicationJSON.Example, b.ApplicationJSON.Examples)
+	}
+	if b.ApplicationXML != nil {
+		v.validateBody(s, pos, desc, "application/xml", *b.ApplicationXML)
+	}
+	v.validateProperties(s, pos, b.Properties, b.examplePositions)
+	for _, mediaType := range sortedKeys(b.ForMIMEType) {
+		switch {
+		case IsXMLMediaType(mediaType):
+			v.validateBody(s, pos, desc, mediaType, b.ForMIMEType[mediaType])
+		case IsFormMediaType(mediaType):
+			v.validateFormBody(s, pos, desc, mediaType, b.ForMIMEType[mediaType])
+		}
+	}
+}
+
+// validateFormBody checks the declaration of an application/x-www-form-urlencoded
+// or multipart/form-data body, it warns about the files which are not sent in a multipart/form-data body.
+func (v *validator) validateFormBody(s scope, pos Position, desc, mediaType string, b Body) {
+	if b.Schema != "" {
+		v.errorf(pos, "%v of media type %v can't be declared using a schema", desc, mediaType)
+	}
+	v.validateTypeExpr(s, pos, b.Type)
+	v.validateProperties(s, pos, b.Properties, b.examplePositions)
+	if cleanMediaType(mediaType) == MediaTypeMultipart {
+		return
+	}
+	for _, name := range sortedKeys(b.Properties) {
+		prop := ToProperty(name, b.Properties[name])
+		if s.isFileExpr(prop.Type) {
+			v.warnf(pos, "file property `%v` of %v is only allowed in a multipart/form-data body", prop.Name, desc)
+		}
+	}
+}
+
+// isFileExpr returns true if a type expression is a file or an array of files
+func (s scope) isFileExpr(expr string) bool {
+	if !isTypeExpr(expr) {
+		return false
+	}
+	te, err := ParseTypeExpr(expr)
+	if err != nil {
+		return false
+	}
+	for te.Kind == TypeExprArray {
+		te = te.Items
+	}
+	return s.typeExprKind(te, 0) == "file"
+}
+
+// validateBody checks the type and the example of a body,
+// the type could be declared using an XML schema
+func (v *validator) validateBody(s scope, pos Position, desc, mediaType string, b Body) {
+	if b.Example != "" {
+		v.validateBodyExamples(s, pos, b.examplePositions, desc, mediaType, b.Type, b.Properties, b.Example, nil)
+	}
+	if schema, ok := b.XMLSchema(); ok {
+		v.validateXMLSchema(pos, schema)
+		return
+	}
+	v.validateTypeExpr(s, pos, b.Type)
+	v.validateTypeExpr(s, pos, b.Schema)
+	v.validateProperties(s, pos, b.Properties, b.examplePositions)
+}
+
+// typeKind returns the built-in type a type declaration is based on.
+// It returns empty string if it can't be determined, e.g. for union types.
+func (s scope) typeKind(t Type, depth int) string {
+	if t.Type == nil && len(t.Properties) == 0 && t.Schema != nil {
+		return ""
+	}
+	te, err := t.TypeExpr()
+	if err != nil {
+		return ""
+	}
+	return s.typeExprKind(te, depth)
+}
+
+// exprKind returns the built-in type a type expression is based on.
+// It returns empty string if it can't be determined.
+func (s scope) exprKind(expr string, depth int) string {
+	if !isTypeExpr(expr) {
+		return ""
+	}
+	te, err := ParseTypeExpr(expr)
+	if err != nil {
+		return ""
+	}
+	return s.typeExprKind(te, depth)
+}
+
+// typeExprKind returns the built-in type a parsed type expression is based on.
+// It returns empty string if it can't be determined.
+func (s scope) typeExprKind(te *TypeExpr, depth int) string {
+	switch te.Kind {
+	case TypeExprArray:
+		return "array"
+	case TypeExprInheritance: // multiple inheritance is only allowed for objects
+		return "object"
+	case TypeExprName:
+	default: // union, map and inline type declaration
+		return ""
+	}
+	if builtinTypes[te.Name] {
+		return te.Name
+	}
+	t, ts, ok := s.findType(te.Name)
+	if !ok || depth >= maxTypeDepth {
+		return ""
+	}
+	return ts.typeKind(t, depth+1)
+}
+
+// checkTypeValue checks that a value is a valid instance of a type declaration
+func (s scope) checkTypeValue(t Type, val interface{}, depth int) error {
+	if depth >= maxTypeDepth {
+		return nil
+	}
+	if enum, ok := t.Enum.([]interface{}); ok && !inInterfaceSlice(val, enum) {
+		return fmt.Errorf("value %v is not one of %v", val, enum)
+	}
+
+	kind := s.typeKind(t, depth)
+
+	// the properties of the parent types of an object are checked with the own properties
+	if t.Type != nil && kind != "object" {
+		if te, err := NewTypeExpr(t.Type); err == nil {
+			if err := s.checkTypeExprValue(te, val, depth); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch kind {
+	case "object":
+		return s.checkObjectValue(t, val, depth)
+	case "string", "number", "integer":
+		return checkScalarFacets(val, stringPtr(t.Pattern), intPtr(t.MinLength), intPtr(t.MaxLength),
+			t.Minimum, t.Maximum, t.MultipleOf)
+	case "array":
+		arr, ok := val.([]interface{})
+		if !ok {
+			return fmt.Errorf("value %v is not an array", val)
+		}
+		if items, err := NewTypeExpr(t.Items); t.Items != nil && err == nil {
+			for i, elem := range arr {
+				if err := s.checkTypeExprValue(items, elem, depth); err != nil {
+					return fmt.Errorf("item %v: %v", i, err)
+				}
+			}
+		}
+		return checkArrayFacets(arr, intPtr(t.MinItems), intPtr(t.MaxItems), t.UniqueItems)
+	}
+	return nil
+}
+
+// checkPropertiesValue checks that an object value has all required properties
+// and the properties value match their type
+func (s scope) checkPropertiesValue(properties map[string]interface{}, val interface{}, depth int) error {
+	obj, ok := val.(map[interface{}]interface{})
+	if !ok {
+		return fmt.Errorf("value %v is not an object", val)
+	}
+	// sorted, to report the same problem on every run
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prop := ToProperty(name, properties[name])
+		if prop.IsPattern() {
+			continue
+		}
+		pVal, ok := obj[prop.Name]
+		if !ok {
+			if prop.Required {
+				return fmt.Errorf("missing required property `%v`", prop.Name)
+			}
+			continue
+		}
+		if err := s.checkPropertyValue(prop, pVal, depth+1); err != nil {
+			return fmt.Errorf("property `%v`: %v", prop.Name, err)
+		}
+	}
+	return nil
+}
+
+// checkObjectValue checks that an object value is a valid instance of an object type.
+// The properties which are not declared by the type or it's parents must match
+// a pattern property, unless additional properties are allowed.
+func (s scope) checkObjectValue(t Type, val interface{}, depth int) error {
+	props := s.allProperties(t, depth)
+	if err := s.checkPropertiesValue(props, val, depth); err != nil {
+		return err
+	}
+	obj := toStringMap(val)
+	if t.MinProperties > 0 && len(obj) < t.MinProperties {
+		return fmt.Errorf("object has less than %v properties", t.MinProperties)
+	}
+	if t.MaxProperties > 0 && len(obj) > t.MaxProperties {
+		return fmt.Errorf("object has more than %v properties", t.MaxProperties)
+	}
+
+	declared := map[string]bool{}
+	var patterns []Property
+	for _, name := range sortedKeys(props) {
+		prop := ToProperty(name, props[name])
+		if prop.IsPattern() {
+			patterns = append(patterns, prop)
+		} else {
+			declared[prop.Name] = true
+		}
+	}
+	for _, name := range sortedKeys(obj) {
+		if declared[name] {
+			continue
+		}
+		prop, ok := matchPatternProperty(patterns, name)
+		switch {
+		case ok:
+			if err := s.checkPropertyValue(prop, obj[name], depth+1); err != nil {
+				return fmt.Errorf("property `%v`: %v", name, err)
+			}
+		case !s.allowsAdditionalProperties(t, depth):
+			return fmt.Errorf("unknown property `%v`", name)
+		}
+	}
+	return nil
+}
+
+// allProperties returns the properties of a type, including the properties of the parent types
+func (s scope) allProperties(t Type, depth int) map[string]interface{} {
+	props := map[string]interface{}{}
+	if depth >= maxTypeDepth {
+		return props
+	}
+	for _, parent := range s.parentTypes(t) {
+		for name, p := range parent.scope.allProperties(parent.t, depth+1) {
+			props[name] = p
+		}
+	}
+	for name, p := range t.Properties {
+		props[name] = p
+	}
+	return props
+}
+
+// allowsAdditionalProperties returns the `additionalProperties` facet of a type,
+// which is inherited from the parent types if it is not declared
+func (s scope) allowsAdditionalProperties(t Type, depth int) bool {
+	if t.AdditionalProperties != nil || depth >= maxTypeDepth {
+		return t.AllowsAdditionalProperties()
+	}
+	for _, parent := range s.parentTypes(t) {
+		if !parent.scope.allowsAdditionalProperties(parent.t, depth+1) {
+			return false
+		}
+	}
+	return true
+}
+
+// userFacet is a user defined facet declared by a type
+type userFacet struct {
+	decl     interface{} // type declaration of the facet value
+	required bool
+}
+
+// inheritedFacets returns the user defined facets declared by the parent types of a type,
+// which could be set by the type
+func (s scope) inheritedFacets(t Type, depth int) map[string]userFacet {
+	facets := map[string]userFacet{}
+	if depth >= maxTypeDepth {
+		return facets
+	}
+	for _, parent := range s.parentTypes(t) {
+		for name, f := range parent.scope.inheritedFacets(parent.t, depth+1) {
+			facets[name] = f
+		}
+		for name, decl := range parent.t.Facets {
+			if builtinFacets[strings.TrimSuffix(name, "?")] { // reported by validateUserFacets
+				continue
+			}
+			facets[strings.TrimSuffix(name, "?")] = userFacet{
+				decl:     decl,
+				required: !strings.HasSuffix(name, "?"),
+			}
+		}
+	}
+	return facets
+}
+
+// hasFacetValue returns true if the value of a user defined facet
+// is set by a type or by one of it's parent types
+func (s scope) hasFacetValue(t Type, name string, depth int) bool {
+	if _, ok := t.FacetValues[name]; ok {
+		return true
+	}
+	if depth >= maxTypeDepth {
+		return false
+	}
+	for _, parent := range s.parentTypes(t) {
+		if parent.scope.hasFacetValue(parent.t, name, depth+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// scopedType is a type declaration and the scope where it is declared
+type scopedType struct {
+	t     Type
+	scope scope
+}
+
+// parentTypes returns the parent types of a type declaration,
+// which are the user defined types and the inline declarations it inherits from
+func (s scope) parentTypes(t Type) []scopedType {
+	if t.Type == nil {
+		return nil
+	}
+	te, err := NewTypeExpr(t.Type)
+	if err != nil {
+		return nil
+	}
+	members := []*TypeExpr{te}
+	if te.Kind == TypeExprInheritance {
+		members = te.Members
+	}
+	var parents []scopedType
+	for _, m := range members {
+		switch m.Kind {
+		case TypeExprInline:
+			parents = append(parents, scopedType{*m.Decl, s})
+		case TypeExprName:
+			if pt, ps, ok := s.findType(m.Name); ok {
+				parents = append(parents, scopedType{pt, ps})
+			}
+		}
+	}
+	return parents
+}
+
+// matchPatternProperty returns the first pattern property whose pattern matches
+// the name of a property
+func matchPatternProperty(patterns []Property, name string) (Property, bool) {
+	for _, p := range patterns {
+		re, err := regexp.Compile(p.NamePattern())
+		if err == nil && re.MatchString(name) {
+			return p, true
+		}
+	}
+	return Property{}, false
+}
+
+// checkPropertyValue checks that a value is a valid instance of a property declaration
+func (s scope) checkPropertyValue(prop Property, val interface{}, depth int) error {
+	if enum, ok := prop.Enum.([]interface{}); ok && !inInterfaceSlice(val, enum) {
+		return fmt.Errorf("value %v is not one of %v", val, enum)
+	}
+	if len(prop.Properties) > 0 { // inline object type
+		return s.checkPropertiesValue(prop.Properties, val, depth)
+	}
+	if err := s.checkExprValue(prop.Type, val, depth); err != nil {
+		return err
+	}
+	if err := checkScalarFacets(val, prop.Pattern, prop.MinLength, prop.MaxLength,
+		prop.Minimum, prop.Maximum, prop.MultipleOf); err != nil {
+		return err
+	}
+	if arr, ok := val.([]interface{}); ok {
+		return checkArrayFacets(arr, prop.MinItems, prop.MaxItems, prop.UniqueItems)
+	}
+	return nil
+}
+
+// checkBodyValue checks that a value is a valid instance of a body
+// of the given type and properties.
+// A string value of a non string body, e.g. a JSON document, is decoded first.
+func (s scope) checkBodyValue(typ string, properties map[string]interface{}, val interface{}) error {
+	if str, ok := val.(string); ok && s.exprKind(typ, 0) != "string" {
+		var decoded interface{}
+		if err := yaml.Unmarshal([]byte(str), &decoded); err == nil {
+			val = decoded
+		}
+	}
+	if err := s.checkExprValue(typ, val, 0); err != nil {
+		return err
+	}
+	if len(properties) > 0 {
+		return s.checkPropertiesValue(properties, val, 0)
+	}
+	return nil
+}
+
+// CheckJSONBody checks that a JSON document is a valid instance of a body
+// of the given type and properties, e.g. the body of a request.
+// The types are resolved in the API definition and it's libraries.
+func (apiDef *APIDefinition) CheckJSONBody(typ string, properties map[string]interface{}, data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var val interface{}
+	if err := dec.Decode(&val); err != nil {
+		return fmt.Errorf("invalid JSON document: %v", err)
+	}
+	val = fromJSON(val)
+
+	s := apiScope(apiDef)
+	if err := s.checkExprValue(typ, val, 0); err != nil {
+		return err
+	}
+	if len(properties) > 0 {
+		return s.checkPropertiesValue(properties, val, 0)
+	}
+	return nil
+}
+
+// fromJSON converts a decoded JSON value to the values of the YAML documents,
+// i.e. the objects are map[interface{}]interface{} and the integers are int
+func fromJSON(val interface{}) interface{} {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		m := make(map[interface{}]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = fromJSON(elem)
+		}
+		return m
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for i, elem := range v {
+			arr[i] = fromJSON(elem)
+		}
+		return arr
+	case json.Number:
+		if i, err := strconv.Atoi(string(v)); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return val
+}
+
+// checkExprValue checks that a value is a valid instance of a type expression
+func (s scope) checkExprValue(expr string, val interface{}, depth int) error {
+	if !isTypeExpr(expr) {
+		return nil
+	}
+	te, err := ParseTypeExpr(expr)
+	if err != nil { // invalid type expression is reported by validateTypeExpr
+		return nil
+	}
+	return s.checkTypeExprValue(te, val, depth)
+}
+
+// checkTypeExprValue checks that a value is a valid instance of a parsed type expression
+func (s scope) checkTypeExprValue(te *TypeExpr, val interface{}, depth int) error {
+	if depth >= maxTypeDepth {
+		return nil
+	}
+
+	switch te.Kind {
+	case TypeExprUnion:
+		for _, m := range te.Members {
+			if err := s.checkTypeExprValue(m, val, depth); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %v is not of type %v", val, te)
+	case TypeExprArray:
+		arr, ok := val.([]interface{})
+		if !ok {
+			return fmt.Errorf("value %v is not an array", val)
+		}
+		for i, elem := range arr {
+			if err := s.checkTypeExprValue(te.Items, elem, depth); err != nil {
+				return fmt.Errorf("item %v: %v", i, err)
+			}
+		}
+		return nil
+	case TypeExprMap:
+		obj, ok := val.(map[interface{}]interface{})
+		if !ok {
+			return fmt.Errorf("value %v is not a map", val)
+		}
+		for _, elem := range obj {
+			if err := s.checkTypeExprValue(te.Items, elem, depth); err != nil {
+				return err
+			}
+		}
+		return nil
+	case TypeExprInheritance:
+		for _, m := range te.Members {
+			if err := s.checkTypeExprValue(m, val, depth); err != nil {
+				return err
+			}
+		}
+		return nil
+	case TypeExprInline:
+		return s.checkTypeValue(*te.Decl, val, depth+1)
+	}
+
+	if builtinTypes[te.Name] {
+		return checkBuiltinValue(te.Name, val)
+	}
+	t, ts, ok := s.findType(te.Name)
+	if !ok { // undefined type is reported by validateTypeExpr
+		return nil
+	}
+	return ts.checkTypeValue(t, val, depth+1)
+}
+
+// checkScalarFacets checks a scalar value against the string and number facets
+func checkScalarFacets(val interface{}, pattern *string, minLength, maxLength *int, minimum, maximum, multipleOf *float64) error {
+	switch v := val.(type) {
+	case string:
+		if pattern != nil {
+			re, err := regexp.Compile(*pattern)
+			if err == nil && !re.MatchString(v) {
+				return fmt.Errorf("value `%v` doesn't match pattern `%v`", v, *pattern)
+			}
+		}
+		length := utf8.RuneCountInString(v)
+		if minLength != nil && length < *minLength {
+			return fmt.Errorf("value `%v` is shorter than %v", v, *minLength)
+		}
+		if maxLength != nil && length > *maxLength {
+			return fmt.Errorf("value `%v` is longer than %v", v, *maxLength)
+		}
+	case int, int64, uint64, float64:
+		num := toFloat64(v)
+		if minimum != nil && num < *minimum {
+			return fmt.Errorf("value %v is less than %v", v, *minimum)
+		}
+		if maximum != nil && num > *maximum {
+			return fmt.Errorf("value %v is greater than %v", v, *maximum)
+		}
+		if multipleOf != nil && *multipleOf != 0 {
+			if q := num / *multipleOf; q != math.Trunc(q) {
+				return fmt.Errorf("value %v is not a multiple of %v", v, *multipleOf)
+			}
+		}
+	}
+	return nil
+}
+
+// checkArrayFacets checks an array value against the array facets
+func checkArrayFacets(arr []interface{}, minItems, maxItems *int, uniqueItems bool) error {
+	if minItems != nil && len(arr) < *minItems {
+		return fmt.Errorf("array has less than %v items", *minItems)
+	}
+	if maxItems != nil && len(arr) > *maxItems {
+		return fmt.Errorf("array has more than %v items", *maxItems)
+	}
+	if uniqueItems {
+		for i := range arr {
+			for j := 0; j < i; j++ {
+				if reflect.DeepEqual(arr[i], arr[j]) {
+					return fmt.Errorf("item %v is a duplicate of item %v", i, j)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// exampleValue returns the value of an example and whether it must be validated.
+// An example could be declared as a map with the `value` facet,
+// which is not validated if it's `strict` facet is false.
+// see https://github.com/raml-org/raml-spec/blob/master/versions/raml-10/raml-10.md#example
+func exampleValue(example interface{}) (interface{}, bool) {
+	m := toStringMap(example)
+	val, ok := m["value"]
+	if !ok {
+		return example, true
+	}
+	for k := range m {
+		switch {
+		case k == "value", k == "strict", k == "displayName", k == "description":
+		case strings.HasPrefix(k, "("): // annotation
+		default: // an object which has a `value` property
+			return example, true
+		}
+	}
+	strict, ok := m["strict"].(bool)
+	return val, !ok || strict
+}
+
+// uriParams returns the parameters of a URI template
+func uriParams(uri string) []string {
+	var params []string
+	for _, match := range uriParamsRegex.FindAllStringSubmatch(uri, -1) {
+		params = append(params, strings.TrimSpace(match[1]))
+	}
+	return params
+}
+
+// isTypeExpr returns false if a type string is not a type expression
+// but an inline schema or a resource type/trait parameter
+func isTypeExpr(expr string) bool {
+	expr = strings.TrimSpace(expr)
+	return expr != "" && !strings.HasPrefix(expr, "{") && !strings.HasPrefix(expr, "<") &&
+		!strings.Contains(expr, "<<")
+}
+
+func toFloat64(number interface{}) float64 {
+	switch v := number.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return 0
+}
+
+// stringPtr and intPtr returns pointer to a non-zero facet value,
+// or nil for zero value, which means the facet is not declared
+func stringPtr(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func intPtr(v int) *int {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+// diagnostics sorts diagnostics by their position
+type diagnostics []Diagnostic
+
+func (d diagnostics) Len() int      { return len(d) }
+func (d diagnostics) Swap(i, j int) { d[i], d[j] = d[j], d[i] }
+func (d diagnostics) Less(i, j int) bool {
+	a, b := d[i], d[j]
+	switch {
+	case a.File != b.File:
+		return a.File < b.File
+	case a.Line != b.Line:
+		return a.Line < b.Line
+	case a.Column != b.Column:
+		return a.Column < b.Column
+	}
+	return a.Message < b.Message
+}
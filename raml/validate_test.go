@@ -0,0 +1,90 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidate(t *testing.T) {
+	Convey("semantic validation", t, func() {
+		Convey("valid API definition", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/validate/valid.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			So(Validate(apiDef), ShouldBeEmpty)
+		})
+
+		Convey("invalid API definition", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/validate/invalid.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			diags := Validate(apiDef)
+			So(HasErrors(diags), ShouldBeTrue)
+
+			messages := map[string]Diagnostic{}
+			for _, d := range diags {
+				messages[d.Message] = d
+			}
+
+			Convey("type references", func() {
+				So(messages, ShouldContainKey, "undefined type `Address`")
+				So(messages, ShouldContainKey, "undefined type `common.Identifier`")
+				So(messages["undefined type `Address`"].Line, ShouldEqual, 7)
+			})
+
+			Convey("security schemes and library prefix", func() {
+				So(messages, ShouldContainKey, "undefined security scheme `oauth_2_0`")
+				So(messages, ShouldContainKey, "unknown library `auth` in `auth.basic`")
+			})
+
+			Convey("undeclared URI parameter", func() {
+				d := messages["URI parameter `userId` of `/{userId}` is not declared"]
+				So(d.Severity, ShouldEqual, SeverityWarning)
+				So(d.Line, ShouldEqual, 51)
+			})
+
+			Convey("facets", func() {
+				So(messages, ShouldContainKey, "facet `pattern` of type `Level` is not applicable to integer type")
+				So(messages, ShouldContainKey, "facet `minimum` of query parameter `page` is not applicable to string type")
+			})
+
+			// the examples are reported at the position of their key
+			checkExample := func(msg string, line, column int) {
+				So(messages, ShouldContainKey, msg)
+				So(messages[msg].Line, ShouldEqual, line)
+				So(messages[msg].Column, ShouldEqual, column)
+			}
+
+			Convey("example and default values", func() {
+				checkExample("invalid example of type `User`: missing required property `address`", 10, 5)
+				checkExample("invalid default value of type `Level`: value high is not of type integer", 15, 5)
+				checkExample("invalid example of type `Tag`: value `golang` is longer than 3", 19, 5)
+				checkExample("invalid example of query parameter `page`: value 1 is not of type string", 44, 9)
+			})
+
+			Convey("named examples, properties, and bodies", func() {
+				checkExample("invalid example `future` of type `Color`: value purple is not one of [red green blue]", 24, 7)
+				checkExample("invalid example of property `name`: value `Warm` doesn't match pattern `^[a-z]+$`", 30, 9)
+				checkExample("invalid example of type `Palette`: property `colors`: item 1 is a duplicate of item 0", 34, 5)
+				checkExample("invalid example of request body: property `colors`: item 1: value black is not one of [red green blue]", 62, 9)
+			})
+
+			Convey("only examples", func() {
+				diags := ValidateExamples(apiDef)
+				So(diags, ShouldHaveLength, 8)
+				for _, d := range diags {
+					So(d.Message, ShouldContainSubstring, "invalid ")
+				}
+			})
+
+			Convey("sorted by position", func() {
+				for i := 1; i < len(diags); i++ {
+					So(diags[i-1].Line, ShouldBeLessThanOrEqualTo, diags[i].Line)
+				}
+			})
+		})
+	})
+}
@@ -0,0 +1,121 @@
+package raml
+
+// This file contains the support of XML bodies,
+// the `xml` facet of the types, and the types declared using XML Schema.
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// XML is the `xml` facet of a type or property,
+// it configures the XML serialization of the type instances.
+// see http://docs.raml.org/specs/1.0/#raml-10-spec-xml-serialization-of-type-instances
+type XML struct {
+	// If true, the property is serialized as an XML attribute instead of an element.
+	// Only applicable to scalar types.
+	Attribute bool `yaml:"attribute,omitempty"`
+
+	// If true, the items of an array are wrapped by an element,
+	// which is named by the property.
+	Wrapped bool `yaml:"wrapped,omitempty"`
+
+	// Name of the XML element or attribute,
+	// the default is the property name or the type name.
+	Name string `yaml:"name,omitempty"`
+
+	// Namespace of the XML element or attribute
+	Namespace string `yaml:"namespace,omitempty"`
+
+	// Prefix of the namespace
+	Prefix string `yaml:"prefix,omitempty"`
+}
+
+// newXML creates the xml facet from the value of an `xml` node
+func newXML(v interface{}) *XML {
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil
+	}
+	var x XML
+	for k, val := range m {
+		switch k {
+		case "attribute":
+			x.Attribute, _ = val.(bool)
+		case "wrapped":
+			x.Wrapped, _ = val.(bool)
+		case "name":
+			x.Name = fmt.Sprint(val)
+		case "namespace":
+			x.Namespace = fmt.Sprint(val)
+		case "prefix":
+			x.Prefix = fmt.Sprint(val)
+		}
+	}
+	return &x
+}
+
+// IsXMLMediaType returns true if it is an XML media type,
+// e.g. `application/xml`, `text/xml`, or `application/atom+xml`
+func IsXMLMediaType(mediaType string) bool {
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	if i := strings.Index(mediaType, ";"); i >= 0 {
+		mediaType = strings.TrimSpace(mediaType[:i])
+	}
+	return mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml")
+}
+
+// IsXMLSchema returns true if the string is an XML document, e.g. an included XSD file
+func IsXMLSchema(s string) bool {
+	return strings.HasPrefix(strings.TrimSpace(s), "<")
+}
+
+// XMLSchema returns the XML schema of a type declared using an XML schema
+func (t Type) XMLSchema() (string, bool) {
+	for _, v := range []interface{}{t.Type, t.Schema} {
+		if s, ok := v.(string); ok && IsXMLSchema(s) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// XMLSchema returns the XML schema of a body declared using an XML schema
+func (b Body) XMLSchema() (string, bool) {
+	for _, s := range []string{b.Type, b.Schema} {
+		if IsXMLSchema(s) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// IsXML returns true if the bodies are only exchanged as XML,
+// i.e. it has `application/xml` body but no `application/json` body.
+func (b Bodies) IsXML() bool {
+	return b.ApplicationXML != nil && b.ApplicationJSON == nil
+}
+
+// checkXML checks that a string is a well formed XML document
+func checkXML(doc string) error {
+	d := xml.NewDecoder(strings.NewReader(doc))
+	hasRoot := false
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("invalid XML schema: %v", err)
+		}
+		if _, ok := tok.(xml.StartElement); ok {
+			hasRoot = true
+		}
+	}
+	if !hasRoot {
+		return fmt.Errorf("invalid XML schema: no root element")
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestXML(t *testing.T) {
+	Convey("XML bodies and types", t, func() {
+		apiDef := new(APIDefinition)
+		So(ParseFile("./samples/xml/api.raml", apiDef), ShouldBeNil)
+		So(Validate(apiDef), ShouldBeEmpty)
+
+		Convey("xml facet of type", func() {
+			book := apiDef.Types["Book"]
+			So(book.XML, ShouldResemble, &XML{Name: "book", Namespace: "http://example.com/library"})
+		})
+
+		Convey("xml facet of properties", func() {
+			book := apiDef.Types["Book"]
+			So(ToProperty("isbn", book.Properties["isbn"]).XML, ShouldResemble, &XML{Attribute: true})
+			So(ToProperty("authors", book.Properties["authors"]).XML, ShouldResemble, &XML{Name: "authors", Wrapped: true})
+			So(ToProperty("title", book.Properties["title"]).XML, ShouldBeNil)
+		})
+
+		Convey("XML schema type", func() {
+			schema, ok := apiDef.Types["Order"].XMLSchema()
+			So(ok, ShouldBeTrue)
+			So(schema, ShouldContainSubstring, `<xs:element name="order">`)
+
+			_, ok = apiDef.Types["Book"].XMLSchema()
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("application/xml bodies", func() {
+			books := apiDef.Resources["/books"]
+			So(books.Get.Responses["200"].Bodies.ApplicationXML.Type, ShouldEqual, "Book")
+			So(books.Get.Responses["200"].Bodies.IsXML(), ShouldBeTrue)
+
+			// both JSON and XML
+			put := books.Nested["/{isbn}"].Put
+			So(put.Bodies.ApplicationXML.Type, ShouldEqual, "Book")
+			So(put.Bodies.IsXML(), ShouldBeFalse)
+
+			// inherited from resource type
+			So(books.Post.Bodies.ApplicationXML.Type, ShouldEqual, "Book")
+			So(books.Post.Responses["201"].Bodies.ApplicationXML.Type, ShouldEqual, "Book")
+		})
+
+		Convey("XML schema body", func() {
+			orders := apiDef.Resources["/orders"]
+			schema, ok := orders.Post.Bodies.ApplicationXML.XMLSchema()
+			So(ok, ShouldBeTrue)
+			So(schema, ShouldStartWith, "<?xml")
+			So(orders.Post.Bodies.ApplicationXML.Example, ShouldStartWith, "<order>")
+
+			// other XML media types
+			So(IsXMLMediaType("text/xml"), ShouldBeTrue)
+			So(IsXMLMediaType("application/atom+xml; charset=utf-8"), ShouldBeTrue)
+			So(IsXMLMediaType("application/json"), ShouldBeFalse)
+			So(orders.Post.Responses["200"].Bodies.ForMIMEType["text/xml"].Schema, ShouldEqual, "Order")
+		})
+
+		Convey("invalid xml facets and schemas", func() {
+			apiDef.Types["Invalid"] = Type{
+				Properties: map[string]interface{}{
+					"author": map[interface{}]interface{}{
+						"type": "Author",
+						"xml":  map[interface{}]interface{}{"attribute": true},
+					},
+					"name": map[interface{}]interface{}{
+						"type": "string",
+						"xml":  map[interface{}]interface{}{"wrapped": true},
+					},
+				},
+			}
+			apiDef.Types["InvalidSchema"] = Type{Schema: "<xs:schema><xs:element></xs:schema>"}
+
+			messages := map[string]bool{}
+			for _, d := range Validate(apiDef) {
+				messages[d.Message] = true
+			}
+			So(messages, ShouldContainKey, "facet `xml.attribute` of property `author` is not applicable to object type")
+			So(messages, ShouldContainKey, "facet `xml.wrapped` of property `name` is not applicable to string type")
+			So(len(messages), ShouldEqual, 3)
+		})
+	})
+}